@@ -1,3 +1,7 @@
 package lib
 
 const DateFormat = "2006-01-02"
+
+// MaxMessageSize is the size limit of gRPC messages between gateway and api,
+// it limits the size of imported files.
+const MaxMessageSize = 32 << 20
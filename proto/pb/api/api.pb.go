@@ -7,12 +7,14 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	descriptor "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "github.com/mwitkow/go-proto-validators"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -61,6 +63,70 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
 }
 
+// Granularity splits report into buckets. Week buckets start from the report
+// from_date, ISO week buckets start on Monday.
+type Granularity int32
+
+const (
+	Granularity_GRANULARITY_UNSPECIFIED Granularity = 0
+	Granularity_GRANULARITY_DAY         Granularity = 1
+	Granularity_GRANULARITY_WEEK        Granularity = 2
+	Granularity_GRANULARITY_MONTH       Granularity = 3
+	Granularity_GRANULARITY_ISO_WEEK    Granularity = 4
+)
+
+var Granularity_name = map[int32]string{
+	0: "GRANULARITY_UNSPECIFIED",
+	1: "GRANULARITY_DAY",
+	2: "GRANULARITY_WEEK",
+	3: "GRANULARITY_MONTH",
+	4: "GRANULARITY_ISO_WEEK",
+}
+
+var Granularity_value = map[string]int32{
+	"GRANULARITY_UNSPECIFIED": 0,
+	"GRANULARITY_DAY":         1,
+	"GRANULARITY_WEEK":        2,
+	"GRANULARITY_MONTH":       3,
+	"GRANULARITY_ISO_WEEK":    4,
+}
+
+func (x Granularity) String() string {
+	return proto.EnumName(Granularity_name, int32(x))
+}
+
+func (Granularity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_CSV    ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_GPX    ExportFormat = 2
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "EXPORT_FORMAT_CSV",
+	1: "EXPORT_FORMAT_NDJSON",
+	2: "EXPORT_FORMAT_GPX",
+}
+
+var ExportFormat_value = map[string]int32{
+	"EXPORT_FORMAT_CSV":    0,
+	"EXPORT_FORMAT_NDJSON": 1,
+	"EXPORT_FORMAT_GPX":    2,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
 type Scope int32
 
 const (
@@ -89,7 +155,63 @@ func (x Scope) String() string {
 }
 
 func (Scope) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+type TeamRole int32
+
+const (
+	TeamRole_TEAM_ROLE_UNSPECIFIED TeamRole = 0
+	TeamRole_TEAM_ROLE_COACH       TeamRole = 1
+	TeamRole_TEAM_ROLE_MEMBER      TeamRole = 2
+)
+
+var TeamRole_name = map[int32]string{
+	0: "TEAM_ROLE_UNSPECIFIED",
+	1: "TEAM_ROLE_COACH",
+	2: "TEAM_ROLE_MEMBER",
+}
+
+var TeamRole_value = map[string]int32{
+	"TEAM_ROLE_UNSPECIFIED": 0,
+	"TEAM_ROLE_COACH":       1,
+	"TEAM_ROLE_MEMBER":      2,
+}
+
+func (x TeamRole) String() string {
+	return proto.EnumName(TeamRole_name, int32(x))
+}
+
+func (TeamRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+type PermissionSource int32
+
+const (
+	PermissionSource_PERMISSION_SOURCE_UNSPECIFIED PermissionSource = 0
+	PermissionSource_PERMISSION_SOURCE_ROLE        PermissionSource = 1
+	PermissionSource_PERMISSION_SOURCE_ACL         PermissionSource = 2
+)
+
+var PermissionSource_name = map[int32]string{
+	0: "PERMISSION_SOURCE_UNSPECIFIED",
+	1: "PERMISSION_SOURCE_ROLE",
+	2: "PERMISSION_SOURCE_ACL",
+}
+
+var PermissionSource_value = map[string]int32{
+	"PERMISSION_SOURCE_UNSPECIFIED": 0,
+	"PERMISSION_SOURCE_ROLE":        1,
+	"PERMISSION_SOURCE_ACL":         2,
+}
+
+func (x PermissionSource) String() string {
+	return proto.EnumName(PermissionSource_name, int32(x))
+}
+
+func (PermissionSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 type Action int32
@@ -120,7 +242,87 @@ func (x Action) String() string {
 }
 
 func (Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+// AuthRule describes who could call the method. Every method must declare
+// it, methods without the rule are rejected.
+type AuthRule struct {
+	// Public methods are called without authorization.
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	// Permission of the caller to check. Without action any signed in user
+	// could call the method.
+	Action Action `protobuf:"varint,2,opt,name=action,proto3,enum=api.Action" json:"action,omitempty"`
+	Scope  Scope  `protobuf:"varint,3,opt,name=scope,proto3,enum=api.Scope" json:"scope,omitempty"`
+	// Request field with id of the item to check the permission for. The
+	// permission for all items is checked if it's empty.
+	ItemField string `protobuf:"bytes,4,opt,name=item_field,json=itemField,proto3" json:"item_field,omitempty"`
+	// Method could be called by user with unverified email, when unverified
+	// users are restricted.
+	AllowUnverified      bool     `protobuf:"varint,5,opt,name=allow_unverified,json=allowUnverified,proto3" json:"allow_unverified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthRule) Reset()         { *m = AuthRule{} }
+func (m *AuthRule) String() string { return proto.CompactTextString(m) }
+func (*AuthRule) ProtoMessage()    {}
+func (*AuthRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+}
+
+func (m *AuthRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthRule.Unmarshal(m, b)
+}
+func (m *AuthRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthRule.Marshal(b, m, deterministic)
+}
+func (m *AuthRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRule.Merge(m, src)
+}
+func (m *AuthRule) XXX_Size() int {
+	return xxx_messageInfo_AuthRule.Size(m)
+}
+func (m *AuthRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRule proto.InternalMessageInfo
+
+func (m *AuthRule) GetPublic() bool {
+	if m != nil {
+		return m.Public
+	}
+	return false
+}
+
+func (m *AuthRule) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (m *AuthRule) GetScope() Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (m *AuthRule) GetItemField() string {
+	if m != nil {
+		return m.ItemField
+	}
+	return ""
+}
+
+func (m *AuthRule) GetAllowUnverified() bool {
+	if m != nil {
+		return m.AllowUnverified
+	}
+	return false
 }
 
 type CreateAdminRequest struct {
@@ -135,7 +337,7 @@ func (m *CreateAdminRequest) Reset()         { *m = CreateAdminRequest{} }
 func (m *CreateAdminRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAdminRequest) ProtoMessage()    {}
 func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
 }
 
 func (m *CreateAdminRequest) XXX_Unmarshal(b []byte) error {
@@ -181,7 +383,7 @@ func (m *CreateAdminResponse) Reset()         { *m = CreateAdminResponse{} }
 func (m *CreateAdminResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateAdminResponse) ProtoMessage()    {}
 func (*CreateAdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{1}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
 }
 
 func (m *CreateAdminResponse) XXX_Unmarshal(b []byte) error {
@@ -223,7 +425,7 @@ func (m *AddPermissionRequest) Reset()         { *m = AddPermissionRequest{} }
 func (m *AddPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*AddPermissionRequest) ProtoMessage()    {}
 func (*AddPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 func (m *AddPermissionRequest) XXX_Unmarshal(b []byte) error {
@@ -272,9 +474,152 @@ func (m *AddPermissionRequest) GetItem() string {
 	return ""
 }
 
+type RemovePermissionRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Scope                Scope    `protobuf:"varint,2,opt,name=scope,proto3,enum=api.Scope" json:"scope,omitempty"`
+	Action               Action   `protobuf:"varint,3,opt,name=action,proto3,enum=api.Action" json:"action,omitempty"`
+	Item                 string   `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePermissionRequest) Reset()         { *m = RemovePermissionRequest{} }
+func (m *RemovePermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePermissionRequest) ProtoMessage()    {}
+func (*RemovePermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+}
+
+func (m *RemovePermissionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePermissionRequest.Unmarshal(m, b)
+}
+func (m *RemovePermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePermissionRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePermissionRequest.Merge(m, src)
+}
+func (m *RemovePermissionRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePermissionRequest.Size(m)
+}
+func (m *RemovePermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePermissionRequest proto.InternalMessageInfo
+
+func (m *RemovePermissionRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RemovePermissionRequest) GetScope() Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (m *RemovePermissionRequest) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (m *RemovePermissionRequest) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+type ListEffectivePermissionsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEffectivePermissionsRequest) Reset()         { *m = ListEffectivePermissionsRequest{} }
+func (m *ListEffectivePermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEffectivePermissionsRequest) ProtoMessage()    {}
+func (*ListEffectivePermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+}
+
+func (m *ListEffectivePermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEffectivePermissionsRequest.Unmarshal(m, b)
+}
+func (m *ListEffectivePermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEffectivePermissionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEffectivePermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEffectivePermissionsRequest.Merge(m, src)
+}
+func (m *ListEffectivePermissionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEffectivePermissionsRequest.Size(m)
+}
+func (m *ListEffectivePermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEffectivePermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEffectivePermissionsRequest proto.InternalMessageInfo
+
+func (m *ListEffectivePermissionsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ListEffectivePermissionsResponse struct {
+	Permissions          []*EffectivePermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ListEffectivePermissionsResponse) Reset()         { *m = ListEffectivePermissionsResponse{} }
+func (m *ListEffectivePermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEffectivePermissionsResponse) ProtoMessage()    {}
+func (*ListEffectivePermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+}
+
+func (m *ListEffectivePermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEffectivePermissionsResponse.Unmarshal(m, b)
+}
+func (m *ListEffectivePermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEffectivePermissionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEffectivePermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEffectivePermissionsResponse.Merge(m, src)
+}
+func (m *ListEffectivePermissionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEffectivePermissionsResponse.Size(m)
+}
+func (m *ListEffectivePermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEffectivePermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEffectivePermissionsResponse proto.InternalMessageInfo
+
+func (m *ListEffectivePermissionsResponse) GetPermissions() []*EffectivePermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// Either role_id or built-in role is set.
 type AddRoleRequest struct {
 	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
 	Role                 Role     `protobuf:"varint,2,opt,name=role,proto3,enum=api.Role" json:"role,omitempty"`
+	RoleId               string   `protobuf:"bytes,3,opt,name=role_id,proto3" json:"role_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -284,7 +629,7 @@ func (m *AddRoleRequest) Reset()         { *m = AddRoleRequest{} }
 func (m *AddRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*AddRoleRequest) ProtoMessage()    {}
 func (*AddRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *AddRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -319,9 +664,18 @@ func (m *AddRoleRequest) GetRole() Role {
 	return Role_ROLE_UNSPECIFIED
 }
 
+func (m *AddRoleRequest) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+// Either role_id or built-in role is set.
 type RemoveRoleRequest struct {
 	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
 	Role                 Role     `protobuf:"varint,2,opt,name=role,proto3,enum=api.Role" json:"role,omitempty"`
+	RoleId               string   `protobuf:"bytes,3,opt,name=role_id,proto3" json:"role_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -331,7 +685,7 @@ func (m *RemoveRoleRequest) Reset()         { *m = RemoveRoleRequest{} }
 func (m *RemoveRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveRoleRequest) ProtoMessage()    {}
 func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
 }
 
 func (m *RemoveRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -366,790 +720,933 @@ func (m *RemoveRoleRequest) GetRole() Role {
 	return Role_ROLE_UNSPECIFIED
 }
 
-type SignUpRequest struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RemoveRoleRequest) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
 }
 
-func (m *SignUpRequest) Reset()         { *m = SignUpRequest{} }
-func (m *SignUpRequest) String() string { return proto.CompactTextString(m) }
-func (*SignUpRequest) ProtoMessage()    {}
-func (*SignUpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+type CreateRoleRequest struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions          []*Permission `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SignUpRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignUpRequest.Unmarshal(m, b)
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
 }
-func (m *SignUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignUpRequest.Marshal(b, m, deterministic)
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
 }
-func (m *SignUpRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignUpRequest.Merge(m, src)
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *SignUpRequest) XXX_Size() int {
-	return xxx_messageInfo_SignUpRequest.Size(m)
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
 }
-func (m *SignUpRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignUpRequest.DiscardUnknown(m)
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignUpRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
 
-func (m *SignUpRequest) GetEmail() string {
+func (m *CreateRoleRequest) GetName() string {
 	if m != nil {
-		return m.Email
+		return m.Name
 	}
 	return ""
 }
 
-func (m *SignUpRequest) GetPassword() string {
+func (m *CreateRoleRequest) GetPermissions() []*Permission {
 	if m != nil {
-		return m.Password
+		return m.Permissions
 	}
-	return ""
+	return nil
 }
 
-type SignUpResponse struct {
+type CreateRoleResponse struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignUpResponse) Reset()         { *m = SignUpResponse{} }
-func (m *SignUpResponse) String() string { return proto.CompactTextString(m) }
-func (*SignUpResponse) ProtoMessage()    {}
-func (*SignUpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+func (m *CreateRoleResponse) Reset()         { *m = CreateRoleResponse{} }
+func (m *CreateRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleResponse) ProtoMessage()    {}
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
 }
 
-func (m *SignUpResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignUpResponse.Unmarshal(m, b)
+func (m *CreateRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleResponse.Unmarshal(m, b)
 }
-func (m *SignUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignUpResponse.Marshal(b, m, deterministic)
+func (m *CreateRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleResponse.Marshal(b, m, deterministic)
 }
-func (m *SignUpResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignUpResponse.Merge(m, src)
+func (m *CreateRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleResponse.Merge(m, src)
 }
-func (m *SignUpResponse) XXX_Size() int {
-	return xxx_messageInfo_SignUpResponse.Size(m)
+func (m *CreateRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleResponse.Size(m)
 }
-func (m *SignUpResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignUpResponse.DiscardUnknown(m)
+func (m *CreateRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignUpResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateRoleResponse proto.InternalMessageInfo
 
-func (m *SignUpResponse) GetId() string {
+func (m *CreateRoleResponse) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type SignInRequest struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UpdateRoleRequest struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions          []*Permission `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *SignInRequest) Reset()         { *m = SignInRequest{} }
-func (m *SignInRequest) String() string { return proto.CompactTextString(m) }
-func (*SignInRequest) ProtoMessage()    {}
-func (*SignInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
+func (m *UpdateRoleRequest) Reset()         { *m = UpdateRoleRequest{} }
+func (m *UpdateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRoleRequest) ProtoMessage()    {}
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
 }
 
-func (m *SignInRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignInRequest.Unmarshal(m, b)
+func (m *UpdateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRoleRequest.Unmarshal(m, b)
 }
-func (m *SignInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignInRequest.Marshal(b, m, deterministic)
+func (m *UpdateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *SignInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignInRequest.Merge(m, src)
+func (m *UpdateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRoleRequest.Merge(m, src)
 }
-func (m *SignInRequest) XXX_Size() int {
-	return xxx_messageInfo_SignInRequest.Size(m)
+func (m *UpdateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRoleRequest.Size(m)
 }
-func (m *SignInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignInRequest.DiscardUnknown(m)
+func (m *UpdateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignInRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateRoleRequest proto.InternalMessageInfo
 
-func (m *SignInRequest) GetEmail() string {
+func (m *UpdateRoleRequest) GetId() string {
 	if m != nil {
-		return m.Email
+		return m.Id
 	}
 	return ""
 }
 
-func (m *SignInRequest) GetPassword() string {
+func (m *UpdateRoleRequest) GetName() string {
 	if m != nil {
-		return m.Password
+		return m.Name
 	}
 	return ""
 }
 
-type SignInResponse struct {
-	RefreshToken         string               `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
-	AccessToken          string               `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`
-	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_at,proto3" json:"expire_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *UpdateRoleRequest) GetPermissions() []*Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
 }
 
-func (m *SignInResponse) Reset()         { *m = SignInResponse{} }
-func (m *SignInResponse) String() string { return proto.CompactTextString(m) }
-func (*SignInResponse) ProtoMessage()    {}
-func (*SignInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{8}
+type DeleteRoleRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignInResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignInResponse.Unmarshal(m, b)
+func (m *DeleteRoleRequest) Reset()         { *m = DeleteRoleRequest{} }
+func (m *DeleteRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRoleRequest) ProtoMessage()    {}
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
 }
-func (m *SignInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignInResponse.Marshal(b, m, deterministic)
+
+func (m *DeleteRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRoleRequest.Unmarshal(m, b)
 }
-func (m *SignInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignInResponse.Merge(m, src)
+func (m *DeleteRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *SignInResponse) XXX_Size() int {
-	return xxx_messageInfo_SignInResponse.Size(m)
+func (m *DeleteRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRoleRequest.Merge(m, src)
 }
-func (m *SignInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignInResponse.DiscardUnknown(m)
+func (m *DeleteRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRoleRequest.Size(m)
 }
-
-var xxx_messageInfo_SignInResponse proto.InternalMessageInfo
-
-func (m *SignInResponse) GetRefreshToken() string {
-	if m != nil {
-		return m.RefreshToken
-	}
-	return ""
+func (m *DeleteRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRoleRequest.DiscardUnknown(m)
 }
 
-func (m *SignInResponse) GetAccessToken() string {
-	if m != nil {
-		return m.AccessToken
-	}
-	return ""
-}
+var xxx_messageInfo_DeleteRoleRequest proto.InternalMessageInfo
 
-func (m *SignInResponse) GetExpireAt() *timestamp.Timestamp {
+func (m *DeleteRoleRequest) GetId() string {
 	if m != nil {
-		return m.ExpireAt
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-type GetUserRequest struct {
+type GetRoleRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetUserRequest) Reset()         { *m = GetUserRequest{} }
-func (m *GetUserRequest) String() string { return proto.CompactTextString(m) }
-func (*GetUserRequest) ProtoMessage()    {}
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{9}
+func (m *GetRoleRequest) Reset()         { *m = GetRoleRequest{} }
+func (m *GetRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoleRequest) ProtoMessage()    {}
+func (*GetRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 
-func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserRequest.Unmarshal(m, b)
+func (m *GetRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoleRequest.Unmarshal(m, b)
 }
-func (m *GetUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserRequest.Marshal(b, m, deterministic)
+func (m *GetRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *GetUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserRequest.Merge(m, src)
+func (m *GetRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoleRequest.Merge(m, src)
 }
-func (m *GetUserRequest) XXX_Size() int {
-	return xxx_messageInfo_GetUserRequest.Size(m)
+func (m *GetRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRoleRequest.Size(m)
 }
-func (m *GetUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserRequest.DiscardUnknown(m)
+func (m *GetRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRoleRequest proto.InternalMessageInfo
 
-func (m *GetUserRequest) GetId() string {
+func (m *GetRoleRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type GetUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetRoleResponse struct {
+	Role                 *RoleDefinition `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetUserResponse) Reset()         { *m = GetUserResponse{} }
-func (m *GetUserResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUserResponse) ProtoMessage()    {}
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{10}
+func (m *GetRoleResponse) Reset()         { *m = GetRoleResponse{} }
+func (m *GetRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoleResponse) ProtoMessage()    {}
+func (*GetRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 
-func (m *GetUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetUserResponse.Unmarshal(m, b)
+func (m *GetRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoleResponse.Unmarshal(m, b)
 }
-func (m *GetUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetUserResponse.Marshal(b, m, deterministic)
+func (m *GetRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoleResponse.Marshal(b, m, deterministic)
 }
-func (m *GetUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserResponse.Merge(m, src)
+func (m *GetRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoleResponse.Merge(m, src)
 }
-func (m *GetUserResponse) XXX_Size() int {
-	return xxx_messageInfo_GetUserResponse.Size(m)
+func (m *GetRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRoleResponse.Size(m)
 }
-func (m *GetUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserResponse.DiscardUnknown(m)
+func (m *GetRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRoleResponse proto.InternalMessageInfo
 
-func (m *GetUserResponse) GetUser() *User {
+func (m *GetRoleResponse) GetRole() *RoleDefinition {
 	if m != nil {
-		return m.User
+		return m.Role
 	}
 	return nil
 }
 
-type ListUsersRequest struct {
-	PerReq               int64    `protobuf:"varint,1,opt,name=per_req,proto3" json:"per_req,omitempty"`
-	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	Query                string   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListRolesResponse struct {
+	Roles                []*RoleDefinition `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
-func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
-func (*ListUsersRequest) ProtoMessage()    {}
-func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{11}
+func (m *ListRolesResponse) Reset()         { *m = ListRolesResponse{} }
+func (m *ListRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRolesResponse) ProtoMessage()    {}
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 
-func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
-}
-func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+func (m *ListRolesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRolesResponse.Unmarshal(m, b)
 }
-func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+func (m *ListRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRolesResponse.Marshal(b, m, deterministic)
 }
-func (m *ListUsersRequest) XXX_Size() int {
-	return xxx_messageInfo_ListUsersRequest.Size(m)
+func (m *ListRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRolesResponse.Merge(m, src)
 }
-func (m *ListUsersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+func (m *ListRolesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRolesResponse.Size(m)
 }
-
-var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
-
-func (m *ListUsersRequest) GetPerReq() int64 {
-	if m != nil {
-		return m.PerReq
-	}
-	return 0
+func (m *ListRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRolesResponse.DiscardUnknown(m)
 }
 
-func (m *ListUsersRequest) GetCursor() string {
-	if m != nil {
-		return m.Cursor
-	}
-	return ""
-}
+var xxx_messageInfo_ListRolesResponse proto.InternalMessageInfo
 
-func (m *ListUsersRequest) GetQuery() string {
+func (m *ListRolesResponse) GetRoles() []*RoleDefinition {
 	if m != nil {
-		return m.Query
+		return m.Roles
 	}
-	return ""
+	return nil
 }
 
-type ListUsersResponse struct {
-	Cursor               string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Users                []*User  `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+type ListAuditEventsRequest struct {
+	PerReq int64  `protobuf:"varint,1,opt,name=per_req,proto3" json:"per_req,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// List only events of the actor.
+	ActorId string `protobuf:"bytes,3,opt,name=actor_id,proto3" json:"actor_id,omitempty"`
+	// List only events with the target item.
+	TargetId             string   `protobuf:"bytes,4,opt,name=target_id,proto3" json:"target_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
-func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
-func (*ListUsersResponse) ProtoMessage()    {}
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 
-func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
 }
-func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
 }
-func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUsersResponse.Merge(m, src)
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
 }
-func (m *ListUsersResponse) XXX_Size() int {
-	return xxx_messageInfo_ListUsersResponse.Size(m)
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
 }
-func (m *ListUsersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
 
-func (m *ListUsersResponse) GetCursor() string {
+func (m *ListAuditEventsRequest) GetPerReq() int64 {
+	if m != nil {
+		return m.PerReq
+	}
+	return 0
+}
+
+func (m *ListAuditEventsRequest) GetCursor() string {
 	if m != nil {
 		return m.Cursor
 	}
 	return ""
 }
 
-func (m *ListUsersResponse) GetTotal() int64 {
+func (m *ListAuditEventsRequest) GetActorId() string {
 	if m != nil {
-		return m.Total
+		return m.ActorId
 	}
-	return 0
+	return ""
 }
 
-func (m *ListUsersResponse) GetUsers() []*User {
+func (m *ListAuditEventsRequest) GetTargetId() string {
 	if m != nil {
-		return m.Users
+		return m.TargetId
 	}
-	return nil
+	return ""
 }
 
-type ListUsersDetailedResponse struct {
-	Cursor               string          `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	Total                int64           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Users                []*DetailedUser `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ListAuditEventsResponse struct {
+	Cursor               string        `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Total                int64         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Events               []*AuditEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ListUsersDetailedResponse) Reset()         { *m = ListUsersDetailedResponse{} }
-func (m *ListUsersDetailedResponse) String() string { return proto.CompactTextString(m) }
-func (*ListUsersDetailedResponse) ProtoMessage()    {}
-func (*ListUsersDetailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 
-func (m *ListUsersDetailedResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListUsersDetailedResponse.Unmarshal(m, b)
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
 }
-func (m *ListUsersDetailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListUsersDetailedResponse.Marshal(b, m, deterministic)
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
 }
-func (m *ListUsersDetailedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUsersDetailedResponse.Merge(m, src)
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
 }
-func (m *ListUsersDetailedResponse) XXX_Size() int {
-	return xxx_messageInfo_ListUsersDetailedResponse.Size(m)
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
 }
-func (m *ListUsersDetailedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUsersDetailedResponse.DiscardUnknown(m)
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListUsersDetailedResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
 
-func (m *ListUsersDetailedResponse) GetCursor() string {
+func (m *ListAuditEventsResponse) GetCursor() string {
 	if m != nil {
 		return m.Cursor
 	}
 	return ""
 }
 
-func (m *ListUsersDetailedResponse) GetTotal() int64 {
+func (m *ListAuditEventsResponse) GetTotal() int64 {
 	if m != nil {
 		return m.Total
 	}
 	return 0
 }
 
-func (m *ListUsersDetailedResponse) GetUsers() []*DetailedUser {
+func (m *ListAuditEventsResponse) GetEvents() []*AuditEvent {
 	if m != nil {
-		return m.Users
+		return m.Events
 	}
 	return nil
 }
 
-type DeleteUserRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type SignUpRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
-func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteUserRequest) ProtoMessage()    {}
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+func (m *SignUpRequest) Reset()         { *m = SignUpRequest{} }
+func (m *SignUpRequest) String() string { return proto.CompactTextString(m) }
+func (*SignUpRequest) ProtoMessage()    {}
+func (*SignUpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 
-func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
+func (m *SignUpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignUpRequest.Unmarshal(m, b)
 }
-func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
+func (m *SignUpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignUpRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
+func (m *SignUpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignUpRequest.Merge(m, src)
 }
-func (m *DeleteUserRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteUserRequest.Size(m)
+func (m *SignUpRequest) XXX_Size() int {
+	return xxx_messageInfo_SignUpRequest.Size(m)
 }
-func (m *DeleteUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
+func (m *SignUpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignUpRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_SignUpRequest proto.InternalMessageInfo
 
-func (m *DeleteUserRequest) GetId() string {
+func (m *SignUpRequest) GetEmail() string {
 	if m != nil {
-		return m.Id
+		return m.Email
 	}
 	return ""
 }
 
-type RefreshTokenRequest struct {
-	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+func (m *SignUpRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type SignUpResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
-func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
-func (*RefreshTokenRequest) ProtoMessage()    {}
-func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+func (m *SignUpResponse) Reset()         { *m = SignUpResponse{} }
+func (m *SignUpResponse) String() string { return proto.CompactTextString(m) }
+func (*SignUpResponse) ProtoMessage()    {}
+func (*SignUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
-func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
+func (m *SignUpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignUpResponse.Unmarshal(m, b)
 }
-func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+func (m *SignUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignUpResponse.Marshal(b, m, deterministic)
 }
-func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+func (m *SignUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignUpResponse.Merge(m, src)
 }
-func (m *RefreshTokenRequest) XXX_Size() int {
-	return xxx_messageInfo_RefreshTokenRequest.Size(m)
+func (m *SignUpResponse) XXX_Size() int {
+	return xxx_messageInfo_SignUpResponse.Size(m)
 }
-func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+func (m *SignUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignUpResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+var xxx_messageInfo_SignUpResponse proto.InternalMessageInfo
 
-func (m *RefreshTokenRequest) GetRefreshToken() string {
+func (m *SignUpResponse) GetId() string {
 	if m != nil {
-		return m.RefreshToken
+		return m.Id
 	}
 	return ""
 }
 
-type RefreshTokenResponse struct {
-	RefreshToken         string               `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
-	AccessToken          string               `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`
-	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_at,proto3" json:"expire_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type UnlockSignInRequest struct {
+	// At least one of user_id and ip is required.
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
-func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
-func (*RefreshTokenResponse) ProtoMessage()    {}
-func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+func (m *UnlockSignInRequest) Reset()         { *m = UnlockSignInRequest{} }
+func (m *UnlockSignInRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockSignInRequest) ProtoMessage()    {}
+func (*UnlockSignInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
-func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
+func (m *UnlockSignInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockSignInRequest.Unmarshal(m, b)
 }
-func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
+func (m *UnlockSignInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockSignInRequest.Marshal(b, m, deterministic)
 }
-func (m *RefreshTokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefreshTokenResponse.Merge(m, src)
+func (m *UnlockSignInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockSignInRequest.Merge(m, src)
 }
-func (m *RefreshTokenResponse) XXX_Size() int {
-	return xxx_messageInfo_RefreshTokenResponse.Size(m)
+func (m *UnlockSignInRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockSignInRequest.Size(m)
 }
-func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
+func (m *UnlockSignInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockSignInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnlockSignInRequest proto.InternalMessageInfo
 
-func (m *RefreshTokenResponse) GetRefreshToken() string {
+func (m *UnlockSignInRequest) GetUserId() string {
 	if m != nil {
-		return m.RefreshToken
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *RefreshTokenResponse) GetAccessToken() string {
+func (m *UnlockSignInRequest) GetIp() string {
 	if m != nil {
-		return m.AccessToken
+		return m.Ip
 	}
 	return ""
 }
 
-func (m *RefreshTokenResponse) GetExpireAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.ExpireAt
-	}
-	return nil
-}
-
-type CreateTrackingRequest struct {
-	Date                 string             `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Time                 *duration.Duration `protobuf:"bytes,2,opt,name=time,json=duration,proto3" json:"time,omitempty"`
-	Distance             float32            `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
-	Location             *Location          `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type ChangePasswordRequest struct {
+	CurrentPassword      string   `protobuf:"bytes,1,opt,name=current_password,proto3" json:"current_password,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTrackingRequest) Reset()         { *m = CreateTrackingRequest{} }
-func (m *CreateTrackingRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateTrackingRequest) ProtoMessage()    {}
-func (*CreateTrackingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 
-func (m *CreateTrackingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTrackingRequest.Unmarshal(m, b)
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
 }
-func (m *CreateTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTrackingRequest.Marshal(b, m, deterministic)
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateTrackingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTrackingRequest.Merge(m, src)
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
 }
-func (m *CreateTrackingRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateTrackingRequest.Size(m)
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
 }
-func (m *CreateTrackingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTrackingRequest.DiscardUnknown(m)
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTrackingRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
 
-func (m *CreateTrackingRequest) GetDate() string {
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
 	if m != nil {
-		return m.Date
+		return m.CurrentPassword
 	}
 	return ""
 }
 
-func (m *CreateTrackingRequest) GetTime() *duration.Duration {
+func (m *ChangePasswordRequest) GetNewPassword() string {
 	if m != nil {
-		return m.Time
+		return m.NewPassword
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateTrackingRequest) GetDistance() float32 {
+type ChangeEmailRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	CurrentPassword      string   `protobuf:"bytes,2,opt,name=current_password,proto3" json:"current_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeEmailRequest) Reset()         { *m = ChangeEmailRequest{} }
+func (m *ChangeEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeEmailRequest) ProtoMessage()    {}
+func (*ChangeEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+func (m *ChangeEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEmailRequest.Unmarshal(m, b)
+}
+func (m *ChangeEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEmailRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangeEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEmailRequest.Merge(m, src)
+}
+func (m *ChangeEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeEmailRequest.Size(m)
+}
+func (m *ChangeEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEmailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeEmailRequest proto.InternalMessageInfo
+
+func (m *ChangeEmailRequest) GetEmail() string {
 	if m != nil {
-		return m.Distance
+		return m.Email
 	}
-	return 0
+	return ""
 }
 
-func (m *CreateTrackingRequest) GetLocation() *Location {
+func (m *ChangeEmailRequest) GetCurrentPassword() string {
 	if m != nil {
-		return m.Location
+		return m.CurrentPassword
 	}
-	return nil
+	return ""
 }
 
-type CreateTrackingResponse struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type ChangeUserPasswordRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,proto3" json:"new_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTrackingResponse) Reset()         { *m = CreateTrackingResponse{} }
-func (m *CreateTrackingResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateTrackingResponse) ProtoMessage()    {}
-func (*CreateTrackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+func (m *ChangeUserPasswordRequest) Reset()         { *m = ChangeUserPasswordRequest{} }
+func (m *ChangeUserPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeUserPasswordRequest) ProtoMessage()    {}
+func (*ChangeUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
-func (m *CreateTrackingResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTrackingResponse.Unmarshal(m, b)
+func (m *ChangeUserPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeUserPasswordRequest.Unmarshal(m, b)
 }
-func (m *CreateTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTrackingResponse.Marshal(b, m, deterministic)
+func (m *ChangeUserPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeUserPasswordRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateTrackingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTrackingResponse.Merge(m, src)
+func (m *ChangeUserPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeUserPasswordRequest.Merge(m, src)
 }
-func (m *CreateTrackingResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateTrackingResponse.Size(m)
+func (m *ChangeUserPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeUserPasswordRequest.Size(m)
 }
-func (m *CreateTrackingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTrackingResponse.DiscardUnknown(m)
+func (m *ChangeUserPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeUserPasswordRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTrackingResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChangeUserPasswordRequest proto.InternalMessageInfo
 
-func (m *CreateTrackingResponse) GetId() string {
+func (m *ChangeUserPasswordRequest) GetUserId() string {
 	if m != nil {
-		return m.Id
+		return m.UserId
 	}
 	return ""
 }
 
-type DeleteTrackingRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *ChangeUserPasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type ChangeUserEmailRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTrackingRequest) Reset()         { *m = DeleteTrackingRequest{} }
-func (m *DeleteTrackingRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteTrackingRequest) ProtoMessage()    {}
-func (*DeleteTrackingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+func (m *ChangeUserEmailRequest) Reset()         { *m = ChangeUserEmailRequest{} }
+func (m *ChangeUserEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeUserEmailRequest) ProtoMessage()    {}
+func (*ChangeUserEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 
-func (m *DeleteTrackingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteTrackingRequest.Unmarshal(m, b)
+func (m *ChangeUserEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeUserEmailRequest.Unmarshal(m, b)
 }
-func (m *DeleteTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteTrackingRequest.Marshal(b, m, deterministic)
+func (m *ChangeUserEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeUserEmailRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteTrackingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTrackingRequest.Merge(m, src)
+func (m *ChangeUserEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeUserEmailRequest.Merge(m, src)
 }
-func (m *DeleteTrackingRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteTrackingRequest.Size(m)
+func (m *ChangeUserEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeUserEmailRequest.Size(m)
 }
-func (m *DeleteTrackingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTrackingRequest.DiscardUnknown(m)
+func (m *ChangeUserEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeUserEmailRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTrackingRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangeUserEmailRequest proto.InternalMessageInfo
 
-func (m *DeleteTrackingRequest) GetId() string {
+func (m *ChangeUserEmailRequest) GetUserId() string {
 	if m != nil {
-		return m.Id
+		return m.UserId
 	}
 	return ""
 }
 
-type GetTrackingRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *ChangeUserEmailRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type SignInRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTrackingRequest) Reset()         { *m = GetTrackingRequest{} }
-func (m *GetTrackingRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTrackingRequest) ProtoMessage()    {}
-func (*GetTrackingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+func (m *SignInRequest) Reset()         { *m = SignInRequest{} }
+func (m *SignInRequest) String() string { return proto.CompactTextString(m) }
+func (*SignInRequest) ProtoMessage()    {}
+func (*SignInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 
-func (m *GetTrackingRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTrackingRequest.Unmarshal(m, b)
+func (m *SignInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignInRequest.Unmarshal(m, b)
 }
-func (m *GetTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTrackingRequest.Marshal(b, m, deterministic)
+func (m *SignInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignInRequest.Marshal(b, m, deterministic)
 }
-func (m *GetTrackingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTrackingRequest.Merge(m, src)
+func (m *SignInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignInRequest.Merge(m, src)
 }
-func (m *GetTrackingRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTrackingRequest.Size(m)
+func (m *SignInRequest) XXX_Size() int {
+	return xxx_messageInfo_SignInRequest.Size(m)
 }
-func (m *GetTrackingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTrackingRequest.DiscardUnknown(m)
+func (m *SignInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTrackingRequest proto.InternalMessageInfo
+var xxx_messageInfo_SignInRequest proto.InternalMessageInfo
 
-func (m *GetTrackingRequest) GetId() string {
+func (m *SignInRequest) GetEmail() string {
 	if m != nil {
-		return m.Id
+		return m.Email
 	}
 	return ""
 }
 
-type GetTrackingResponse struct {
-	Tracking             *Tracking `protobuf:"bytes,1,opt,name=tracking,proto3" json:"tracking,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *SignInRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
 }
 
-func (m *GetTrackingResponse) Reset()         { *m = GetTrackingResponse{} }
-func (m *GetTrackingResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTrackingResponse) ProtoMessage()    {}
-func (*GetTrackingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+type SignInResponse struct {
+	RefreshToken         string               `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	AccessToken          string               `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`
+	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_at,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetTrackingResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTrackingResponse.Unmarshal(m, b)
+func (m *SignInResponse) Reset()         { *m = SignInResponse{} }
+func (m *SignInResponse) String() string { return proto.CompactTextString(m) }
+func (*SignInResponse) ProtoMessage()    {}
+func (*SignInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
-func (m *GetTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTrackingResponse.Marshal(b, m, deterministic)
+
+func (m *SignInResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignInResponse.Unmarshal(m, b)
 }
-func (m *GetTrackingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTrackingResponse.Merge(m, src)
+func (m *SignInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignInResponse.Marshal(b, m, deterministic)
 }
-func (m *GetTrackingResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTrackingResponse.Size(m)
+func (m *SignInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignInResponse.Merge(m, src)
 }
-func (m *GetTrackingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTrackingResponse.DiscardUnknown(m)
+func (m *SignInResponse) XXX_Size() int {
+	return xxx_messageInfo_SignInResponse.Size(m)
+}
+func (m *SignInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignInResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTrackingResponse proto.InternalMessageInfo
+var xxx_messageInfo_SignInResponse proto.InternalMessageInfo
 
-func (m *GetTrackingResponse) GetTracking() *Tracking {
+func (m *SignInResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.Tracking
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *SignInResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *SignInResponse) GetExpireAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpireAt
 	}
 	return nil
 }
 
-type ListTrackingsRequest struct {
+type GetUserRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserRequest) Reset()         { *m = GetUserRequest{} }
+func (m *GetUserRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserRequest) ProtoMessage()    {}
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *GetUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserRequest.Unmarshal(m, b)
+}
+func (m *GetUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserRequest.Merge(m, src)
+}
+func (m *GetUserRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserRequest.Size(m)
+}
+func (m *GetUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserRequest proto.InternalMessageInfo
+
+func (m *GetUserRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUserResponse) Reset()         { *m = GetUserResponse{} }
+func (m *GetUserResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserResponse) ProtoMessage()    {}
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+}
+
+func (m *GetUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserResponse.Unmarshal(m, b)
+}
+func (m *GetUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserResponse.Marshal(b, m, deterministic)
+}
+func (m *GetUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserResponse.Merge(m, src)
+}
+func (m *GetUserResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUserResponse.Size(m)
+}
+func (m *GetUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserResponse proto.InternalMessageInfo
+
+func (m *GetUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
 	PerReq               int64    `protobuf:"varint,1,opt,name=per_req,proto3" json:"per_req,omitempty"`
 	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	Query                string   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
@@ -1158,1371 +1655,5418 @@ type ListTrackingsRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListTrackingsRequest) Reset()         { *m = ListTrackingsRequest{} }
-func (m *ListTrackingsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListTrackingsRequest) ProtoMessage()    {}
-func (*ListTrackingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 
-func (m *ListTrackingsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListTrackingsRequest.Unmarshal(m, b)
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
 }
-func (m *ListTrackingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListTrackingsRequest.Marshal(b, m, deterministic)
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
 }
-func (m *ListTrackingsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTrackingsRequest.Merge(m, src)
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
 }
-func (m *ListTrackingsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListTrackingsRequest.Size(m)
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
 }
-func (m *ListTrackingsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTrackingsRequest.DiscardUnknown(m)
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTrackingsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
 
-func (m *ListTrackingsRequest) GetPerReq() int64 {
+func (m *ListUsersRequest) GetPerReq() int64 {
 	if m != nil {
 		return m.PerReq
 	}
 	return 0
 }
 
-func (m *ListTrackingsRequest) GetCursor() string {
+func (m *ListUsersRequest) GetCursor() string {
 	if m != nil {
 		return m.Cursor
 	}
 	return ""
 }
 
-func (m *ListTrackingsRequest) GetQuery() string {
+func (m *ListUsersRequest) GetQuery() string {
 	if m != nil {
 		return m.Query
 	}
 	return ""
 }
 
-type ListTrackingsResponse struct {
-	Cursor               string      `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	Total                int64       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Trackings            []*Tracking `protobuf:"bytes,3,rep,name=trackings,json=tracking,proto3" json:"trackings,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type ListUsersResponse struct {
+	Cursor               string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Users                []*User  `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListTrackingsResponse) Reset()         { *m = ListTrackingsResponse{} }
-func (m *ListTrackingsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListTrackingsResponse) ProtoMessage()    {}
-func (*ListTrackingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 
-func (m *ListTrackingsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListTrackingsResponse.Unmarshal(m, b)
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
 }
-func (m *ListTrackingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListTrackingsResponse.Marshal(b, m, deterministic)
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
 }
-func (m *ListTrackingsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListTrackingsResponse.Merge(m, src)
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
 }
-func (m *ListTrackingsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListTrackingsResponse.Size(m)
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
 }
-func (m *ListTrackingsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListTrackingsResponse.DiscardUnknown(m)
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListTrackingsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
 
-func (m *ListTrackingsResponse) GetCursor() string {
+func (m *ListUsersResponse) GetCursor() string {
 	if m != nil {
 		return m.Cursor
 	}
 	return ""
 }
 
-func (m *ListTrackingsResponse) GetTotal() int64 {
+func (m *ListUsersResponse) GetTotal() int64 {
 	if m != nil {
 		return m.Total
 	}
 	return 0
 }
 
-func (m *ListTrackingsResponse) GetTrackings() []*Tracking {
+func (m *ListUsersResponse) GetUsers() []*User {
 	if m != nil {
-		return m.Trackings
+		return m.Users
 	}
 	return nil
 }
 
-type ReportRequest struct {
-	FromDate             string             `protobuf:"bytes,1,opt,name=from_date,proto3" json:"from_date,omitempty"`
-	Duration             *duration.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type ListUsersDetailedResponse struct {
+	Cursor               string          `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Total                int64           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Users                []*DetailedUser `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ReportRequest) Reset()         { *m = ReportRequest{} }
-func (m *ReportRequest) String() string { return proto.CompactTextString(m) }
-func (*ReportRequest) ProtoMessage()    {}
-func (*ReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
-}
+func (m *ListUsersDetailedResponse) Reset()         { *m = ListUsersDetailedResponse{} }
+func (m *ListUsersDetailedResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersDetailedResponse) ProtoMessage()    {}
+func (*ListUsersDetailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+}
 
-func (m *ReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReportRequest.Unmarshal(m, b)
+func (m *ListUsersDetailedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersDetailedResponse.Unmarshal(m, b)
 }
-func (m *ReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReportRequest.Marshal(b, m, deterministic)
+func (m *ListUsersDetailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersDetailedResponse.Marshal(b, m, deterministic)
 }
-func (m *ReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReportRequest.Merge(m, src)
+func (m *ListUsersDetailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersDetailedResponse.Merge(m, src)
 }
-func (m *ReportRequest) XXX_Size() int {
-	return xxx_messageInfo_ReportRequest.Size(m)
+func (m *ListUsersDetailedResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersDetailedResponse.Size(m)
 }
-func (m *ReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReportRequest.DiscardUnknown(m)
+func (m *ListUsersDetailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersDetailedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersDetailedResponse proto.InternalMessageInfo
 
-func (m *ReportRequest) GetFromDate() string {
+func (m *ListUsersDetailedResponse) GetCursor() string {
 	if m != nil {
-		return m.FromDate
+		return m.Cursor
 	}
 	return ""
 }
 
-func (m *ReportRequest) GetDuration() *duration.Duration {
+func (m *ListUsersDetailedResponse) GetTotal() int64 {
 	if m != nil {
-		return m.Duration
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListUsersDetailedResponse) GetUsers() []*DetailedUser {
+	if m != nil {
+		return m.Users
 	}
 	return nil
 }
 
-type ReportResponse struct {
-	AverageSpeed         float32  `protobuf:"fixed32,1,opt,name=average_speed,proto3" json:"average_speed,omitempty"`
-	Distance             float32  `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+type DeleteUserRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReportResponse) Reset()         { *m = ReportResponse{} }
-func (m *ReportResponse) String() string { return proto.CompactTextString(m) }
-func (*ReportResponse) ProtoMessage()    {}
-func (*ReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *ReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReportResponse.Unmarshal(m, b)
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
 }
-func (m *ReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReportResponse.Marshal(b, m, deterministic)
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
 }
-func (m *ReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReportResponse.Merge(m, src)
+func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
 }
-func (m *ReportResponse) XXX_Size() int {
-	return xxx_messageInfo_ReportResponse.Size(m)
+func (m *DeleteUserRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserRequest.Size(m)
 }
-func (m *ReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReportResponse.DiscardUnknown(m)
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReportResponse proto.InternalMessageInfo
-
-func (m *ReportResponse) GetAverageSpeed() float32 {
-	if m != nil {
-		return m.AverageSpeed
-	}
-	return 0
-}
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
 
-func (m *ReportResponse) GetDistance() float32 {
+func (m *DeleteUserRequest) GetId() string {
 	if m != nil {
-		return m.Distance
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-type User struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *User) Reset()         { *m = User{} }
-func (m *User) String() string { return proto.CompactTextString(m) }
-func (*User) ProtoMessage()    {}
-func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *User) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_User.Unmarshal(m, b)
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
 }
-func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
 }
-func (m *User) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_User.Merge(m, src)
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
 }
-func (m *User) XXX_Size() int {
-	return xxx_messageInfo_User.Size(m)
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
 }
-func (m *User) XXX_DiscardUnknown() {
-	xxx_messageInfo_User.DiscardUnknown(m)
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_User proto.InternalMessageInfo
-
-func (m *User) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
 
-func (m *User) GetEmail() string {
+func (m *RefreshTokenRequest) GetRefreshToken() string {
 	if m != nil {
-		return m.Email
+		return m.RefreshToken
 	}
 	return ""
 }
 
-type DetailedUser struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	Permissions          []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RefreshTokenResponse struct {
+	RefreshToken         string               `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	AccessToken          string               `protobuf:"bytes,2,opt,name=access_token,proto3" json:"access_token,omitempty"`
+	ExpireAt             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expire_at,proto3" json:"expire_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *DetailedUser) Reset()         { *m = DetailedUser{} }
-func (m *DetailedUser) String() string { return proto.CompactTextString(m) }
-func (*DetailedUser) ProtoMessage()    {}
-func (*DetailedUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()    {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *DetailedUser) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DetailedUser.Unmarshal(m, b)
+func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
 }
-func (m *DetailedUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DetailedUser.Marshal(b, m, deterministic)
+func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
 }
-func (m *DetailedUser) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DetailedUser.Merge(m, src)
+func (m *RefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenResponse.Merge(m, src)
 }
-func (m *DetailedUser) XXX_Size() int {
-	return xxx_messageInfo_DetailedUser.Size(m)
+func (m *RefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenResponse.Size(m)
 }
-func (m *DetailedUser) XXX_DiscardUnknown() {
-	xxx_messageInfo_DetailedUser.DiscardUnknown(m)
+func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DetailedUser proto.InternalMessageInfo
+var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
 
-func (m *DetailedUser) GetId() string {
+func (m *RefreshTokenResponse) GetRefreshToken() string {
 	if m != nil {
-		return m.Id
+		return m.RefreshToken
 	}
 	return ""
 }
 
-func (m *DetailedUser) GetEmail() string {
+func (m *RefreshTokenResponse) GetAccessToken() string {
 	if m != nil {
-		return m.Email
+		return m.AccessToken
 	}
 	return ""
 }
 
-func (m *DetailedUser) GetRoles() []string {
-	if m != nil {
-		return m.Roles
-	}
-	return nil
-}
-
-func (m *DetailedUser) GetPermissions() []string {
+func (m *RefreshTokenResponse) GetExpireAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Permissions
+		return m.ExpireAt
 	}
 	return nil
 }
 
-type Tracking struct {
-	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId               string             `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
-	Date                 string             `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Time                 *duration.Duration `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	Distance             float32            `protobuf:"fixed32,5,opt,name=distance,proto3" json:"distance,omitempty"`
-	Location             *Location          `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Weather              *Weather           `protobuf:"bytes,7,opt,name=weather,proto3" json:"weather,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type LogoutRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tracking) Reset()         { *m = Tracking{} }
-func (m *Tracking) String() string { return proto.CompactTextString(m) }
-func (*Tracking) ProtoMessage()    {}
-func (*Tracking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *Tracking) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Tracking.Unmarshal(m, b)
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
 }
-func (m *Tracking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Tracking.Marshal(b, m, deterministic)
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
 }
-func (m *Tracking) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Tracking.Merge(m, src)
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
 }
-func (m *Tracking) XXX_Size() int {
-	return xxx_messageInfo_Tracking.Size(m)
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
 }
-func (m *Tracking) XXX_DiscardUnknown() {
-	xxx_messageInfo_Tracking.DiscardUnknown(m)
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Tracking proto.InternalMessageInfo
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
 
-func (m *Tracking) GetId() string {
+func (m *LogoutRequest) GetRefreshToken() string {
 	if m != nil {
-		return m.Id
+		return m.RefreshToken
 	}
 	return ""
 }
 
-func (m *Tracking) GetUserId() string {
-	if m != nil {
-		return m.UserId
-	}
-	return ""
+type RequestPasswordResetRequest struct {
+	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tracking) GetDate() string {
-	if m != nil {
-		return m.Date
-	}
-	return ""
+func (m *RequestPasswordResetRequest) Reset()         { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()    {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 
-func (m *Tracking) GetTime() *duration.Duration {
-	if m != nil {
-		return m.Time
-	}
-	return nil
+func (m *RequestPasswordResetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestPasswordResetRequest.Unmarshal(m, b)
+}
+func (m *RequestPasswordResetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestPasswordResetRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestPasswordResetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestPasswordResetRequest.Merge(m, src)
+}
+func (m *RequestPasswordResetRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestPasswordResetRequest.Size(m)
+}
+func (m *RequestPasswordResetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestPasswordResetRequest.DiscardUnknown(m)
 }
 
-func (m *Tracking) GetDistance() float32 {
+var xxx_messageInfo_RequestPasswordResetRequest proto.InternalMessageInfo
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
 	if m != nil {
-		return m.Distance
+		return m.Email
 	}
-	return 0
+	return ""
 }
 
-func (m *Tracking) GetLocation() *Location {
+type ResetPasswordRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetPasswordRequest) Reset()         { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()    {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+}
+
+func (m *ResetPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetPasswordRequest.Merge(m, src)
+}
+func (m *ResetPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetPasswordRequest.Size(m)
+}
+func (m *ResetPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetPasswordRequest) GetToken() string {
 	if m != nil {
-		return m.Location
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-func (m *Tracking) GetWeather() *Weather {
+func (m *ResetPasswordRequest) GetPassword() string {
 	if m != nil {
-		return m.Weather
+		return m.Password
 	}
-	return nil
+	return ""
 }
 
-type Location struct {
-	Longitude            float64  `protobuf:"fixed64,1,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude             float64  `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+type VerifyEmailRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Location) Reset()         { *m = Location{} }
-func (m *Location) String() string { return proto.CompactTextString(m) }
-func (*Location) ProtoMessage()    {}
-func (*Location) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+func (m *VerifyEmailRequest) Reset()         { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()    {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (m *Location) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Location.Unmarshal(m, b)
+func (m *VerifyEmailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyEmailRequest.Unmarshal(m, b)
 }
-func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Location.Marshal(b, m, deterministic)
+func (m *VerifyEmailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyEmailRequest.Marshal(b, m, deterministic)
 }
-func (m *Location) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Location.Merge(m, src)
+func (m *VerifyEmailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyEmailRequest.Merge(m, src)
 }
-func (m *Location) XXX_Size() int {
-	return xxx_messageInfo_Location.Size(m)
+func (m *VerifyEmailRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyEmailRequest.Size(m)
 }
-func (m *Location) XXX_DiscardUnknown() {
-	xxx_messageInfo_Location.DiscardUnknown(m)
+func (m *VerifyEmailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyEmailRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Location proto.InternalMessageInfo
+var xxx_messageInfo_VerifyEmailRequest proto.InternalMessageInfo
 
-func (m *Location) GetLongitude() float64 {
+func (m *VerifyEmailRequest) GetToken() string {
 	if m != nil {
-		return m.Longitude
+		return m.Token
 	}
-	return 0
+	return ""
 }
 
-func (m *Location) GetLatitude() float64 {
+type GetJWKSResponse struct {
+	Keys                 []*JSONWebKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetJWKSResponse) Reset()         { *m = GetJWKSResponse{} }
+func (m *GetJWKSResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJWKSResponse) ProtoMessage()    {}
+func (*GetJWKSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+}
+
+func (m *GetJWKSResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJWKSResponse.Unmarshal(m, b)
+}
+func (m *GetJWKSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJWKSResponse.Marshal(b, m, deterministic)
+}
+func (m *GetJWKSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJWKSResponse.Merge(m, src)
+}
+func (m *GetJWKSResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJWKSResponse.Size(m)
+}
+func (m *GetJWKSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJWKSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJWKSResponse proto.InternalMessageInfo
+
+func (m *GetJWKSResponse) GetKeys() []*JSONWebKey {
 	if m != nil {
-		return m.Latitude
+		return m.Keys
 	}
-	return 0
+	return nil
 }
 
-type Weather struct {
-	Temperature          float32  `protobuf:"fixed32,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
-	TemperatureMin       float32  `protobuf:"fixed32,2,opt,name=temperature_min,proto3" json:"temperature_min,omitempty"`
-	TemperatureMax       float32  `protobuf:"fixed32,3,opt,name=temperature_max,proto3" json:"temperature_max,omitempty"`
-	Snowdepth            float32  `protobuf:"fixed32,4,opt,name=snowdepth,proto3" json:"snowdepth,omitempty"`
-	Winddirection        float32  `protobuf:"fixed32,5,opt,name=winddirection,proto3" json:"winddirection,omitempty"`
-	Windspeed            float32  `protobuf:"fixed32,6,opt,name=windspeed,proto3" json:"windspeed,omitempty"`
-	Pressure             float32  `protobuf:"fixed32,7,opt,name=pressure,proto3" json:"pressure,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateTrackingRequest struct {
+	Date     string             `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Time     *duration.Duration `protobuf:"bytes,2,opt,name=time,json=duration,proto3" json:"time,omitempty"`
+	Distance float32            `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Location *Location          `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// Optional GPS samples of the run in time order. When at least two samples
+	// are set, duration and distance are derived from the route.
+	Route                []*RoutePoint `protobuf:"bytes,5,rep,name=route,proto3" json:"route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Weather) Reset()         { *m = Weather{} }
-func (m *Weather) String() string { return proto.CompactTextString(m) }
-func (*Weather) ProtoMessage()    {}
-func (*Weather) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+func (m *CreateTrackingRequest) Reset()         { *m = CreateTrackingRequest{} }
+func (m *CreateTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTrackingRequest) ProtoMessage()    {}
+func (*CreateTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *Weather) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Weather.Unmarshal(m, b)
+func (m *CreateTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTrackingRequest.Unmarshal(m, b)
 }
-func (m *Weather) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Weather.Marshal(b, m, deterministic)
+func (m *CreateTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTrackingRequest.Marshal(b, m, deterministic)
 }
-func (m *Weather) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Weather.Merge(m, src)
+func (m *CreateTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTrackingRequest.Merge(m, src)
 }
-func (m *Weather) XXX_Size() int {
-	return xxx_messageInfo_Weather.Size(m)
+func (m *CreateTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTrackingRequest.Size(m)
 }
-func (m *Weather) XXX_DiscardUnknown() {
-	xxx_messageInfo_Weather.DiscardUnknown(m)
+func (m *CreateTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTrackingRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Weather proto.InternalMessageInfo
+var xxx_messageInfo_CreateTrackingRequest proto.InternalMessageInfo
 
-func (m *Weather) GetTemperature() float32 {
+func (m *CreateTrackingRequest) GetDate() string {
 	if m != nil {
-		return m.Temperature
+		return m.Date
 	}
-	return 0
+	return ""
 }
 
-func (m *Weather) GetTemperatureMin() float32 {
+func (m *CreateTrackingRequest) GetTime() *duration.Duration {
 	if m != nil {
-		return m.TemperatureMin
+		return m.Time
 	}
-	return 0
+	return nil
 }
 
-func (m *Weather) GetTemperatureMax() float32 {
+func (m *CreateTrackingRequest) GetDistance() float32 {
 	if m != nil {
-		return m.TemperatureMax
+		return m.Distance
 	}
 	return 0
 }
 
-func (m *Weather) GetSnowdepth() float32 {
+func (m *CreateTrackingRequest) GetLocation() *Location {
 	if m != nil {
-		return m.Snowdepth
+		return m.Location
 	}
-	return 0
+	return nil
 }
 
-func (m *Weather) GetWinddirection() float32 {
+func (m *CreateTrackingRequest) GetRoute() []*RoutePoint {
 	if m != nil {
-		return m.Winddirection
+		return m.Route
 	}
-	return 0
+	return nil
 }
 
-func (m *Weather) GetWindspeed() float32 {
+type CreateTrackingResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTrackingResponse) Reset()         { *m = CreateTrackingResponse{} }
+func (m *CreateTrackingResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTrackingResponse) ProtoMessage()    {}
+func (*CreateTrackingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+}
+
+func (m *CreateTrackingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTrackingResponse.Unmarshal(m, b)
+}
+func (m *CreateTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTrackingResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTrackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTrackingResponse.Merge(m, src)
+}
+func (m *CreateTrackingResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTrackingResponse.Size(m)
+}
+func (m *CreateTrackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTrackingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTrackingResponse proto.InternalMessageInfo
+
+func (m *CreateTrackingResponse) GetId() string {
 	if m != nil {
-		return m.Windspeed
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *Weather) GetPressure() float32 {
+type ImportTrackingsRequest struct {
+	File []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Files are imported together with the file.
+	Files                [][]byte `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportTrackingsRequest) Reset()         { *m = ImportTrackingsRequest{} }
+func (m *ImportTrackingsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportTrackingsRequest) ProtoMessage()    {}
+func (*ImportTrackingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+}
+
+func (m *ImportTrackingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTrackingsRequest.Unmarshal(m, b)
+}
+func (m *ImportTrackingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTrackingsRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportTrackingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTrackingsRequest.Merge(m, src)
+}
+func (m *ImportTrackingsRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportTrackingsRequest.Size(m)
+}
+func (m *ImportTrackingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTrackingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTrackingsRequest proto.InternalMessageInfo
+
+func (m *ImportTrackingsRequest) GetFile() []byte {
 	if m != nil {
-		return m.Pressure
+		return m.File
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.Role", Role_name, Role_value)
-	proto.RegisterEnum("api.Scope", Scope_name, Scope_value)
-	proto.RegisterEnum("api.Action", Action_name, Action_value)
-	proto.RegisterType((*CreateAdminRequest)(nil), "api.CreateAdminRequest")
-	proto.RegisterType((*CreateAdminResponse)(nil), "api.CreateAdminResponse")
-	proto.RegisterType((*AddPermissionRequest)(nil), "api.AddPermissionRequest")
-	proto.RegisterType((*AddRoleRequest)(nil), "api.AddRoleRequest")
-	proto.RegisterType((*RemoveRoleRequest)(nil), "api.RemoveRoleRequest")
-	proto.RegisterType((*SignUpRequest)(nil), "api.SignUpRequest")
-	proto.RegisterType((*SignUpResponse)(nil), "api.SignUpResponse")
-	proto.RegisterType((*SignInRequest)(nil), "api.SignInRequest")
-	proto.RegisterType((*SignInResponse)(nil), "api.SignInResponse")
-	proto.RegisterType((*GetUserRequest)(nil), "api.GetUserRequest")
-	proto.RegisterType((*GetUserResponse)(nil), "api.GetUserResponse")
-	proto.RegisterType((*ListUsersRequest)(nil), "api.ListUsersRequest")
-	proto.RegisterType((*ListUsersResponse)(nil), "api.ListUsersResponse")
-	proto.RegisterType((*ListUsersDetailedResponse)(nil), "api.ListUsersDetailedResponse")
-	proto.RegisterType((*DeleteUserRequest)(nil), "api.DeleteUserRequest")
-	proto.RegisterType((*RefreshTokenRequest)(nil), "api.RefreshTokenRequest")
-	proto.RegisterType((*RefreshTokenResponse)(nil), "api.RefreshTokenResponse")
-	proto.RegisterType((*CreateTrackingRequest)(nil), "api.CreateTrackingRequest")
-	proto.RegisterType((*CreateTrackingResponse)(nil), "api.CreateTrackingResponse")
-	proto.RegisterType((*DeleteTrackingRequest)(nil), "api.DeleteTrackingRequest")
-	proto.RegisterType((*GetTrackingRequest)(nil), "api.GetTrackingRequest")
-	proto.RegisterType((*GetTrackingResponse)(nil), "api.GetTrackingResponse")
-	proto.RegisterType((*ListTrackingsRequest)(nil), "api.ListTrackingsRequest")
-	proto.RegisterType((*ListTrackingsResponse)(nil), "api.ListTrackingsResponse")
-	proto.RegisterType((*ReportRequest)(nil), "api.ReportRequest")
-	proto.RegisterType((*ReportResponse)(nil), "api.ReportResponse")
-	proto.RegisterType((*User)(nil), "api.User")
-	proto.RegisterType((*DetailedUser)(nil), "api.DetailedUser")
-	proto.RegisterType((*Tracking)(nil), "api.Tracking")
-	proto.RegisterType((*Location)(nil), "api.Location")
-	proto.RegisterType((*Weather)(nil), "api.Weather")
+func (m *ImportTrackingsRequest) GetFiles() [][]byte {
+	if m != nil {
+		return m.Files
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c)
+type ImportTrackingsResponse struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xeb, 0x6e, 0xdb, 0xc8,
-	0x15, 0x36, 0x25, 0x59, 0x97, 0x23, 0x4b, 0x96, 0xc7, 0x97, 0xc8, 0x4c, 0xb2, 0x16, 0x98, 0x4d,
-	0x37, 0xab, 0x5d, 0x4b, 0x89, 0x7b, 0x41, 0xb1, 0xfd, 0x13, 0xd9, 0xd2, 0x06, 0xda, 0xda, 0x8e,
-	0x43, 0x39, 0x49, 0x9b, 0x2d, 0x2a, 0xd0, 0xe2, 0x98, 0x66, 0x23, 0x71, 0x18, 0x92, 0xb2, 0x37,
-	0xbb, 0x08, 0x50, 0x14, 0xe8, 0x0b, 0xb4, 0xfd, 0xd5, 0x57, 0xe8, 0x2b, 0xf4, 0x47, 0xdf, 0xa1,
-	0xe8, 0xef, 0x00, 0x41, 0xd1, 0xb7, 0x28, 0x50, 0xcc, 0x8d, 0x37, 0x49, 0x4d, 0x1a, 0xa4, 0xc0,
-	0xfa, 0x8f, 0xc5, 0x33, 0xdf, 0x7c, 0xe7, 0xcc, 0xb9, 0xcc, 0x9c, 0x03, 0x25, 0xc3, 0xb5, 0x5b,
-	0xae, 0x47, 0x02, 0x82, 0xb2, 0x86, 0x6b, 0xab, 0xd7, 0x2d, 0x42, 0xac, 0x31, 0x6e, 0x33, 0xd1,
-	0xd9, 0xf4, 0xbc, 0x8d, 0x27, 0x6e, 0xf0, 0x92, 0x23, 0xd4, 0x9d, 0xf4, 0x62, 0x60, 0x4f, 0xb0,
-	0x1f, 0x18, 0x13, 0x57, 0x00, 0x3e, 0x4a, 0x03, 0xcc, 0xa9, 0x67, 0x04, 0x36, 0x71, 0xc4, 0xfa,
-	0x0d, 0xb1, 0x6e, 0xb8, 0x76, 0xdb, 0x70, 0x1c, 0x12, 0xb0, 0x45, 0x5f, 0xac, 0x7e, 0xce, 0xfe,
-	0x8d, 0x76, 0x2d, 0xec, 0xec, 0xfa, 0x57, 0x86, 0x65, 0x61, 0xaf, 0x4d, 0x5c, 0x86, 0x98, 0x83,
-	0xfe, 0x89, 0x65, 0x07, 0x17, 0xd3, 0xb3, 0xd6, 0x88, 0x4c, 0xda, 0x93, 0x2b, 0x3b, 0x78, 0x4e,
-	0xae, 0xda, 0x16, 0xd9, 0x65, 0x8b, 0xbb, 0x97, 0xc6, 0xd8, 0x36, 0x8d, 0x80, 0x78, 0x7e, 0x3b,
-	0xfc, 0xc9, 0xf7, 0x69, 0x4f, 0x00, 0x1d, 0x78, 0xd8, 0x08, 0x70, 0xc7, 0x9c, 0xd8, 0x8e, 0x8e,
-	0x5f, 0x4c, 0xb1, 0x1f, 0xa0, 0x1b, 0xb0, 0x8c, 0x27, 0x86, 0x3d, 0xae, 0x2b, 0x0d, 0xe5, 0x4e,
-	0x69, 0x3f, 0xff, 0xe6, 0xf5, 0x4e, 0xe6, 0x17, 0x8a, 0xce, 0x85, 0x48, 0x83, 0xa2, 0x6b, 0xf8,
-	0xfe, 0x15, 0xf1, 0xcc, 0x7a, 0x26, 0x01, 0x08, 0xe5, 0xda, 0x6d, 0x58, 0x4f, 0xf0, 0xfa, 0x2e,
-	0x71, 0x7c, 0x8c, 0xaa, 0x90, 0xb1, 0x4d, 0xce, 0xaa, 0x67, 0x6c, 0x53, 0xfb, 0x8b, 0x02, 0x1b,
-	0x1d, 0xd3, 0x3c, 0xc1, 0xde, 0xc4, 0xf6, 0x7d, 0x9b, 0x84, 0x16, 0x34, 0xa0, 0x30, 0xf5, 0xb1,
-	0x37, 0x94, 0xe8, 0x50, 0x85, 0x14, 0xa3, 0x3b, 0xb0, 0xec, 0x8f, 0x88, 0x8b, 0x99, 0x09, 0xd5,
-	0x3d, 0x68, 0xd1, 0xd8, 0x0d, 0xa8, 0x24, 0xb2, 0x97, 0x01, 0xd0, 0x67, 0x90, 0x37, 0x46, 0xd4,
-	0x59, 0xf5, 0x2c, 0x83, 0x96, 0x19, 0xb4, 0xc3, 0x44, 0x21, 0x56, 0x40, 0x90, 0x0a, 0x39, 0x3b,
-	0xc0, 0x93, 0x7a, 0x2e, 0xa1, 0x95, 0xc9, 0xb4, 0x5f, 0x42, 0xb5, 0x63, 0x9a, 0x3a, 0x19, 0xe3,
-	0x77, 0x37, 0xf3, 0x36, 0xe4, 0x3c, 0x32, 0x96, 0x56, 0x96, 0x98, 0x6a, 0xca, 0x10, 0x51, 0xd3,
-	0x65, 0xed, 0x57, 0xb0, 0xa6, 0xe3, 0x09, 0xb9, 0xc4, 0xff, 0x17, 0xf6, 0x47, 0x50, 0x19, 0xd8,
-	0x96, 0xf3, 0xd8, 0xfd, 0x70, 0x01, 0x6e, 0x40, 0x55, 0x52, 0x2e, 0x88, 0x6d, 0x87, 0x2b, 0xed,
-	0x87, 0x31, 0xdd, 0x48, 0x28, 0x95, 0xca, 0xd4, 0xb4, 0xb2, 0x98, 0x92, 0x3f, 0x29, 0x5c, 0x4b,
-	0x3f, 0xca, 0xa0, 0x8f, 0xa1, 0xe2, 0xe1, 0x73, 0x0f, 0xfb, 0x17, 0xc3, 0x80, 0x3c, 0xc7, 0x8e,
-	0x20, 0x4b, 0x0a, 0x91, 0x06, 0x2b, 0xc6, 0x68, 0x84, 0x7d, 0x5f, 0x80, 0x38, 0x71, 0x42, 0x86,
-	0x7e, 0x0a, 0x25, 0xfc, 0x8d, 0x6b, 0x7b, 0x78, 0x68, 0x04, 0x2c, 0x33, 0xca, 0x7b, 0x6a, 0x8b,
-	0x97, 0x64, 0x4b, 0x96, 0x6c, 0xeb, 0x54, 0xd6, 0xb4, 0x1e, 0x81, 0xe9, 0xd9, 0x1f, 0xe0, 0xe0,
-	0xb1, 0x8f, 0x3d, 0x79, 0xb4, 0xf4, 0xd9, 0xef, 0xc2, 0x6a, 0x88, 0x10, 0x86, 0xdf, 0x84, 0x1c,
-	0x8d, 0x1a, 0x03, 0x95, 0x45, 0xa8, 0x18, 0x80, 0x89, 0xb5, 0x67, 0x50, 0x3b, 0xb4, 0x7d, 0xb6,
-	0xc5, 0x97, 0xac, 0x75, 0x28, 0xb8, 0xd8, 0x1b, 0x7a, 0xf8, 0x05, 0xdb, 0x95, 0xd5, 0xe5, 0x27,
-	0xda, 0x82, 0xfc, 0x68, 0xea, 0xf9, 0xc4, 0x13, 0x27, 0x13, 0x5f, 0xd4, 0xc5, 0x2f, 0xa6, 0xd8,
-	0x7b, 0xc9, 0xce, 0x53, 0xd2, 0xf9, 0x87, 0x76, 0x06, 0x6b, 0x31, 0x6e, 0x61, 0x4f, 0x44, 0xa1,
-	0xa4, 0x29, 0x02, 0x12, 0x18, 0x63, 0xc6, 0x9c, 0xd5, 0xf9, 0x07, 0xda, 0x81, 0x65, 0x6a, 0xa6,
-	0x5f, 0xcf, 0x36, 0xb2, 0x49, 0xf3, 0xb9, 0x5c, 0xf3, 0x60, 0x3b, 0xd4, 0xd1, 0xc5, 0x81, 0x61,
-	0x8f, 0xb1, 0xf9, 0x9e, 0xba, 0x3e, 0x49, 0xea, 0x5a, 0x63, 0xba, 0x24, 0x67, 0x5c, 0xe7, 0x2d,
-	0x58, 0xeb, 0xe2, 0x31, 0x0e, 0xf0, 0x7f, 0x0b, 0xc5, 0xcf, 0x60, 0x5d, 0xe7, 0xb9, 0x71, 0x4a,
-	0xc3, 0x2e, 0x61, 0xef, 0x94, 0x47, 0xda, 0x9f, 0x15, 0xd8, 0x48, 0xee, 0xfe, 0x1e, 0xa5, 0xe1,
-	0x5f, 0x15, 0xd8, 0xe4, 0x97, 0xec, 0xa9, 0x67, 0x8c, 0x9e, 0xdb, 0x8e, 0x25, 0x0f, 0x87, 0x20,
-	0x67, 0x1a, 0x01, 0x16, 0x46, 0xb1, 0xdf, 0xe8, 0x1e, 0xe4, 0xe8, 0x03, 0xc5, 0x6c, 0x28, 0xef,
-	0x6d, 0xcf, 0xa8, 0xe8, 0x8a, 0xc7, 0x49, 0x2f, 0xca, 0x67, 0x0a, 0x7d, 0x0a, 0x45, 0xd3, 0xf6,
-	0x03, 0xc3, 0x19, 0x61, 0x66, 0x59, 0x66, 0xbf, 0xf2, 0xe6, 0xf5, 0x4e, 0xa9, 0xbf, 0x24, 0xfe,
-	0xf4, 0x70, 0x19, 0xdd, 0x83, 0xe2, 0x98, 0x8c, 0xd8, 0x36, 0x76, 0x75, 0x96, 0xf7, 0x2a, 0x2c,
-	0x6c, 0x87, 0x42, 0xc8, 0x6f, 0x90, 0x86, 0xa2, 0x87, 0x30, 0xed, 0x0e, 0x6c, 0xa5, 0xad, 0x5f,
-	0x70, 0x93, 0x7c, 0x02, 0x9b, 0x3c, 0xce, 0xe9, 0x73, 0xa6, 0x81, 0x1f, 0x03, 0x7a, 0x80, 0x83,
-	0xb7, 0xa1, 0xee, 0xc3, 0x7a, 0x02, 0x25, 0xb4, 0x7e, 0x0a, 0xc5, 0x40, 0xc8, 0x44, 0x91, 0xf2,
-	0x23, 0x84, 0xc0, 0x70, 0x59, 0xfb, 0x35, 0x6c, 0xd0, 0x64, 0x97, 0x2b, 0x1f, 0xbc, 0x60, 0x5f,
-	0xc0, 0x66, 0x8a, 0xff, 0xbd, 0x0a, 0xa9, 0x09, 0x25, 0x69, 0xb2, 0x2c, 0xa6, 0x85, 0x47, 0x32,
-	0xa1, 0xa2, 0x63, 0x97, 0x78, 0x41, 0xf4, 0x44, 0x94, 0xce, 0x3d, 0x32, 0x19, 0xc6, 0x12, 0x29,
-	0x12, 0xa0, 0x1f, 0x43, 0x98, 0x26, 0xff, 0x43, 0x46, 0x69, 0x3a, 0x54, 0xa5, 0x96, 0xa8, 0x90,
-	0x8c, 0x4b, 0xec, 0x19, 0x16, 0x1e, 0xfa, 0x2e, 0xc6, 0x3c, 0x4e, 0x19, 0x3d, 0x29, 0xa4, 0x8f,
-	0x44, 0x98, 0x89, 0x19, 0x06, 0x08, 0xbf, 0xb5, 0xcf, 0x21, 0x47, 0xeb, 0x3f, 0x1d, 0xe6, 0xe8,
-	0xb9, 0xc9, 0xc4, 0x9e, 0x1b, 0x6d, 0x0c, 0x2b, 0xf1, 0xab, 0xe4, 0xdd, 0x76, 0x51, 0x29, 0x7d,
-	0x48, 0xb9, 0x17, 0x4b, 0x3a, 0xff, 0x40, 0x0d, 0x28, 0xbb, 0x61, 0xe7, 0xe2, 0xd7, 0x73, 0x6c,
-	0x2d, 0x2e, 0xd2, 0xfe, 0xa5, 0x40, 0x51, 0x3a, 0x7b, 0x46, 0x55, 0x3d, 0x7a, 0xde, 0xb9, 0xb2,
-	0xf0, 0x59, 0x97, 0xf5, 0x9b, 0x8d, 0xd5, 0xef, 0xae, 0xa8, 0xdf, 0xdc, 0xdb, 0xbc, 0xcd, 0x60,
-	0x09, 0x8f, 0x2d, 0x27, 0x3d, 0x46, 0x33, 0x3d, 0x2c, 0xd6, 0xfc, 0x9c, 0x62, 0x8d, 0x8a, 0x14,
-	0xfd, 0x00, 0x0a, 0x57, 0xd8, 0x08, 0x2e, 0xb0, 0x57, 0x2f, 0x30, 0xe4, 0x0a, 0x43, 0x3e, 0xe5,
-	0x32, 0x5d, 0x2e, 0x6a, 0x01, 0x14, 0xe5, 0x6e, 0xf4, 0x23, 0x28, 0x8d, 0x89, 0x63, 0xd9, 0xc1,
-	0xd4, 0xe4, 0x99, 0xa3, 0xec, 0x6f, 0xbd, 0x79, 0xbd, 0x83, 0xf8, 0xbd, 0xf1, 0xdb, 0xf3, 0xbf,
-	0x3d, 0x12, 0x3f, 0xee, 0xeb, 0x11, 0x10, 0xed, 0x41, 0x71, 0x6c, 0x04, 0x7c, 0x53, 0x66, 0x66,
-	0xd3, 0x13, 0xb9, 0xe9, 0xc9, 0x7d, 0x3d, 0xc4, 0x69, 0xff, 0x56, 0xa0, 0x20, 0x4c, 0xa1, 0xc1,
-	0x08, 0xf0, 0xc4, 0xc5, 0x9e, 0x11, 0x4c, 0x3d, 0x2c, 0xd2, 0x28, 0x2e, 0x42, 0x77, 0x60, 0x35,
-	0xf6, 0x39, 0x9c, 0xd8, 0x8e, 0xc8, 0xa5, 0xb4, 0x78, 0x06, 0x69, 0x7c, 0xc3, 0xef, 0x3f, 0x3d,
-	0x2d, 0xa6, 0x55, 0xe2, 0x3b, 0xe4, 0xca, 0xc4, 0x6e, 0x70, 0xc1, 0x42, 0x93, 0xd1, 0x23, 0x01,
-	0x4d, 0xee, 0x2b, 0xdb, 0x31, 0x4d, 0xdb, 0xc3, 0xbc, 0x01, 0xe5, 0x91, 0x48, 0x0a, 0x29, 0x07,
-	0x15, 0xf0, 0xf4, 0xcf, 0x73, 0x8e, 0x50, 0xc0, 0xfa, 0x23, 0x0f, 0xfb, 0x3e, 0x3d, 0x54, 0x81,
-	0x07, 0x52, 0x7e, 0x37, 0x8f, 0x20, 0x47, 0xbb, 0x3d, 0xb4, 0x01, 0x35, 0xfd, 0xe1, 0x61, 0x6f,
-	0xf8, 0xf8, 0x78, 0x70, 0xd2, 0x3b, 0xe8, 0x7f, 0xd9, 0xef, 0x75, 0x6b, 0x4b, 0xa8, 0x0a, 0xc0,
-	0xa4, 0x9d, 0xee, 0x51, 0xff, 0xb8, 0xa6, 0xa0, 0x1a, 0xac, 0xb0, 0xef, 0xa3, 0xce, 0x71, 0xe7,
-	0x41, 0x4f, 0xaf, 0x65, 0x50, 0x05, 0x4a, 0x7c, 0xdf, 0xa0, 0xa7, 0xd7, 0xb2, 0xcd, 0xaf, 0x61,
-	0x99, 0x35, 0xd0, 0x68, 0x13, 0xd6, 0x06, 0x07, 0x0f, 0x4f, 0xd2, 0x84, 0xab, 0x50, 0x16, 0xe2,
-	0x41, 0x4f, 0x1f, 0xd4, 0x14, 0xb4, 0x0e, 0xab, 0x5c, 0x70, 0xaa, 0x77, 0x0e, 0x7e, 0xde, 0x3f,
-	0x7e, 0x30, 0xa8, 0x65, 0xa2, 0xcd, 0x27, 0x3d, 0xfd, 0xa8, 0x3f, 0x18, 0xf4, 0x1f, 0x1e, 0x0f,
-	0x6a, 0xd9, 0xe6, 0x53, 0xc8, 0xf3, 0x96, 0x1b, 0x6d, 0x01, 0xea, 0x1c, 0x9c, 0xf6, 0x1f, 0x1e,
-	0xcf, 0xd2, 0x0b, 0xb9, 0xde, 0xeb, 0x74, 0x6b, 0x0a, 0x5a, 0x83, 0x8a, 0x04, 0x9e, 0x74, 0x3b,
-	0xa7, 0xbd, 0x5a, 0x26, 0x26, 0xea, 0xf6, 0x0e, 0x7b, 0xa7, 0xbd, 0x5a, 0x76, 0xef, 0x1f, 0x15,
-	0x80, 0xce, 0x49, 0x7f, 0x80, 0xbd, 0x4b, 0x7b, 0x84, 0xd1, 0x3e, 0x94, 0x63, 0x93, 0x07, 0xba,
-	0xc6, 0xf2, 0x75, 0x76, 0xc6, 0x51, 0xeb, 0xb3, 0x0b, 0xfc, 0x4a, 0xd2, 0x96, 0x90, 0x05, 0x95,
-	0xc4, 0x54, 0x82, 0xb6, 0xf9, 0xc8, 0x30, 0x67, 0x52, 0x51, 0xb7, 0x66, 0x2a, 0xb1, 0x47, 0x87,
-	0x44, 0xed, 0xd6, 0xef, 0xfe, 0xfe, 0xcf, 0x3f, 0x66, 0x6e, 0xaa, 0x75, 0x36, 0xdf, 0x5d, 0xde,
-	0x6b, 0xd3, 0xe2, 0x6e, 0xc7, 0x2e, 0x87, 0x2f, 0x94, 0x26, 0x1a, 0x41, 0x41, 0x4c, 0x14, 0x68,
-	0x5d, 0xaa, 0x88, 0x4d, 0x00, 0x0b, 0xc9, 0x3f, 0x63, 0xe4, 0xb7, 0xd5, 0x5b, 0x09, 0xf2, 0xef,
-	0xc4, 0xfd, 0xf1, 0xaa, 0xcd, 0xee, 0xa7, 0xf6, 0x77, 0xf4, 0xdf, 0x2b, 0x64, 0x03, 0x44, 0xb3,
-	0x05, 0xda, 0xe2, 0x43, 0x42, 0x7a, 0xd8, 0x78, 0x9b, 0xaa, 0xe6, 0x3b, 0xa9, 0x3a, 0x84, 0x3c,
-	0x9f, 0x0a, 0x10, 0xe2, 0xf3, 0x58, 0x7c, 0xea, 0x50, 0xd7, 0x13, 0x32, 0xe1, 0xed, 0x6d, 0xc6,
-	0xbf, 0xae, 0x55, 0x25, 0xbf, 0x6f, 0x5b, 0xce, 0xd4, 0xa5, 0xde, 0x11, 0x6c, 0x7d, 0x27, 0xc6,
-	0x16, 0x8e, 0x13, 0x31, 0xb6, 0x68, 0x3c, 0x98, 0xcf, 0x66, 0x3b, 0x94, 0xed, 0x08, 0x0a, 0xa2,
-	0x27, 0x47, 0x0b, 0xce, 0xaa, 0x6e, 0x30, 0xca, 0x54, 0xe7, 0xae, 0x6d, 0x30, 0xce, 0x2a, 0x5a,
-	0x89, 0x7b, 0x00, 0x0d, 0xa0, 0x2c, 0x80, 0xfb, 0x2f, 0xfb, 0x5d, 0x11, 0xbe, 0xe4, 0x58, 0xb0,
-	0x80, 0x4f, 0xd8, 0x88, 0xd6, 0x92, 0x1e, 0xb5, 0xcd, 0x57, 0xe8, 0x11, 0x94, 0xc2, 0x2e, 0x1a,
-	0x6d, 0xf2, 0x4b, 0x39, 0x35, 0x15, 0xa8, 0x5b, 0x69, 0xb1, 0xa0, 0xdd, 0x64, 0xb4, 0xab, 0xa8,
-	0x12, 0xa7, 0xf5, 0xd1, 0x61, 0xac, 0xf9, 0x97, 0x2f, 0xdf, 0x22, 0xea, 0x8f, 0x92, 0xe2, 0x74,
-	0x1f, 0xaf, 0x2d, 0x21, 0x1d, 0x20, 0x6a, 0xb9, 0x17, 0xfa, 0x71, 0x51, 0x2e, 0x09, 0x4f, 0x36,
-	0x93, 0x9e, 0xfc, 0x1a, 0xaa, 0x11, 0x27, 0x73, 0xe6, 0x96, 0x68, 0xf9, 0x53, 0xbd, 0xfd, 0x42,
-	0x5e, 0xe1, 0xd1, 0xe6, 0x1c, 0x8f, 0x9a, 0xb0, 0x12, 0x6f, 0xe0, 0x51, 0x5d, 0xa4, 0xff, 0xcc,
-	0x44, 0xa0, 0x6e, 0xcf, 0x59, 0x11, 0xe7, 0xde, 0x61, 0xfc, 0xdb, 0xda, 0x86, 0xe4, 0x37, 0xa6,
-	0xc1, 0x45, 0x5b, 0xf4, 0xfa, 0x34, 0xb7, 0xce, 0xa1, 0x9a, 0xec, 0x65, 0x91, 0x1a, 0xbb, 0x5e,
-	0x52, 0x0d, 0xa9, 0x7a, 0x7d, 0xee, 0x9a, 0xd0, 0x75, 0x9d, 0xe9, 0xda, 0xd4, 0x6a, 0x52, 0x97,
-	0x6c, 0xd1, 0xa8, 0x9e, 0x21, 0x4b, 0xba, 0x50, 0xc9, 0x35, 0x99, 0x5f, 0x69, 0x0d, 0xf5, 0xd9,
-	0x05, 0x41, 0x7f, 0x93, 0xd1, 0x5f, 0x43, 0x9b, 0x69, 0x7a, 0xee, 0xae, 0x8b, 0x54, 0x67, 0xfb,
-	0x25, 0xf1, 0x58, 0xa4, 0xb7, 0xc3, 0xcc, 0x48, 0x37, 0xbd, 0xaa, 0x3a, 0x6f, 0x69, 0x51, 0xaa,
-	0x87, 0xfd, 0x28, 0xc2, 0x50, 0x49, 0xec, 0x79, 0x5f, 0x15, 0x0b, 0x0f, 0xe4, 0xb7, 0x8d, 0xf1,
-	0x18, 0x8d, 0x64, 0x72, 0xa5, 0x22, 0x33, 0x77, 0xa0, 0x58, 0x98, 0x60, 0x42, 0x49, 0x73, 0x81,
-	0xd7, 0x06, 0x90, 0xe7, 0x6d, 0xad, 0xb8, 0xa8, 0x12, 0x9d, 0xb4, 0xb8, 0xa8, 0x92, 0x7d, 0xaf,
-	0xd6, 0x60, 0x8c, 0x2a, 0xaa, 0xcf, 0x9a, 0xed, 0x31, 0xe4, 0xfe, 0xef, 0x95, 0x3f, 0x74, 0xbe,
-	0x45, 0x5f, 0xc0, 0xea, 0x57, 0xc4, 0xb2, 0x6c, 0xc7, 0x6a, 0x18, 0xae, 0xdb, 0xe8, 0x9c, 0xf4,
-	0xb5, 0x06, 0x94, 0x63, 0x22, 0x75, 0xed, 0x8c, 0x10, 0xf3, 0xe5, 0x25, 0xb9, 0x6f, 0xd1, 0x56,
-	0xb5, 0x35, 0x22, 0x93, 0xbd, 0xe5, 0xbb, 0xad, 0x7b, 0xad, 0xbb, 0x4d, 0x45, 0xd9, 0xab, 0x19,
-	0xae, 0x3b, 0xb6, 0x79, 0x63, 0xd6, 0xfe, 0x8d, 0x4f, 0x9c, 0x67, 0x37, 0x40, 0x85, 0xec, 0x57,
-	0x4f, 0x4f, 0xd1, 0x7a, 0x31, 0xa3, 0x56, 0x3a, 0xd3, 0xe0, 0x82, 0x78, 0xf6, 0xb7, 0x0c, 0xd0,
-	0xc8, 0x9c, 0x95, 0xa0, 0xc0, 0x57, 0x97, 0x9e, 0xe5, 0xdd, 0x33, 0x6a, 0xdb, 0x59, 0x9e, 0xf9,
-	0xe2, 0x87, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x29, 0x75, 0xef, 0xa0, 0x1d, 0x15, 0x00, 0x00,
+func (m *ImportTrackingsResponse) Reset()         { *m = ImportTrackingsResponse{} }
+func (m *ImportTrackingsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportTrackingsResponse) ProtoMessage()    {}
+func (*ImportTrackingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (m *ImportTrackingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTrackingsResponse.Unmarshal(m, b)
+}
+func (m *ImportTrackingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTrackingsResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportTrackingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTrackingsResponse.Merge(m, src)
+}
+func (m *ImportTrackingsResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportTrackingsResponse.Size(m)
+}
+func (m *ImportTrackingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTrackingsResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+var xxx_messageInfo_ImportTrackingsResponse proto.InternalMessageInfo
 
-// APIServiceClient is the client API for APIService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIServiceClient interface {
-	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
-	// Add permission
-	AddPermission(ctx context.Context, in *AddPermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Add role
-	AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Remove role
-	RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Sign up user
-	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error)
-	// Sign in user
-	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error)
-	// Get current user.
-	GetUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetUserResponse, error)
-	// Get user for provided id.
-	GetUserByID(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
-	// List users.
-	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
-	// List detailed users.
-	ListUsersDetailed(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersDetailedResponse, error)
-	// Delete current user.
-	DeleteUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Delete user for provided id.
-	DeleteUserByID(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Refresh token.
-	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
-	// Create new tracking for current user.
-	CreateTracking(ctx context.Context, in *CreateTrackingRequest, opts ...grpc.CallOption) (*CreateTrackingResponse, error)
-	// Get tracking by id.
-	GetTracking(ctx context.Context, in *GetTrackingRequest, opts ...grpc.CallOption) (*GetTrackingResponse, error)
-	// List tracking for current user.
-	ListTrackingsForUser(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error)
-	// List trackings for all users.
-	ListTrackings(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error)
-	// Delete tracking by id.
-	DeleteTracking(ctx context.Context, in *DeleteTrackingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Create report for current user.
-	// Create report for current user.
-	Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error)
+func (m *ImportTrackingsResponse) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
 }
 
-type aPIServiceClient struct {
-	cc grpc.ClientConnInterface
+type DeleteTrackingRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewAPIServiceClient(cc grpc.ClientConnInterface) APIServiceClient {
-	return &aPIServiceClient{cc}
+func (m *DeleteTrackingRequest) Reset()         { *m = DeleteTrackingRequest{} }
+func (m *DeleteTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTrackingRequest) ProtoMessage()    {}
+func (*DeleteTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
 }
 
-func (c *aPIServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error) {
-	out := new(CreateAdminResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/CreateAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DeleteTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTrackingRequest.Unmarshal(m, b)
+}
+func (m *DeleteTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTrackingRequest.Merge(m, src)
+}
+func (m *DeleteTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTrackingRequest.Size(m)
+}
+func (m *DeleteTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTrackingRequest.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) AddPermission(ctx context.Context, in *AddPermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.APIService/AddPermission", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteTrackingRequest proto.InternalMessageInfo
+
+func (m *DeleteTrackingRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIServiceClient) AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.APIService/AddRole", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type UpdateTrackingRequest struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tracking             *TrackingUpdate       `protobuf:"bytes,2,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,3,opt,name=update_mask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (c *aPIServiceClient) RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.APIService/RemoveRole", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateTrackingRequest) Reset()         { *m = UpdateTrackingRequest{} }
+func (m *UpdateTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTrackingRequest) ProtoMessage()    {}
+func (*UpdateTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
 }
 
-func (c *aPIServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error) {
-	out := new(SignUpResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/SignUp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTrackingRequest.Unmarshal(m, b)
+}
+func (m *UpdateTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTrackingRequest.Merge(m, src)
+}
+func (m *UpdateTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTrackingRequest.Size(m)
+}
+func (m *UpdateTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTrackingRequest.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
-	out := new(SignInResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/SignIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateTrackingRequest proto.InternalMessageInfo
+
+func (m *UpdateTrackingRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIServiceClient) GetUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	out := new(GetUserResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/GetUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateTrackingRequest) GetTracking() *TrackingUpdate {
+	if m != nil {
+		return m.Tracking
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) GetUserByID(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	out := new(GetUserResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/GetUserByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateTrackingRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
-	out := new(ListUsersResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/ListUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetTrackingRequest struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeRoute bool   `protobuf:"varint,2,opt,name=include_route,proto3" json:"include_route,omitempty"`
+	// Maximum deviation in meters of the simplified route from the stored one.
+	// Zero returns all samples.
+	RouteTolerance       float64  `protobuf:"fixed64,3,opt,name=route_tolerance,proto3" json:"route_tolerance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIServiceClient) ListUsersDetailed(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersDetailedResponse, error) {
-	out := new(ListUsersDetailedResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/ListUsersDetailed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTrackingRequest) Reset()         { *m = GetTrackingRequest{} }
+func (m *GetTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTrackingRequest) ProtoMessage()    {}
+func (*GetTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
 }
 
-func (c *aPIServiceClient) DeleteUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.APIService/DeleteUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrackingRequest.Unmarshal(m, b)
+}
+func (m *GetTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrackingRequest.Merge(m, src)
+}
+func (m *GetTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTrackingRequest.Size(m)
+}
+func (m *GetTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrackingRequest.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) DeleteUserByID(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.APIService/DeleteUserByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTrackingRequest proto.InternalMessageInfo
+
+func (m *GetTrackingRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
-	out := new(RefreshTokenResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/RefreshToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTrackingRequest) GetIncludeRoute() bool {
+	if m != nil {
+		return m.IncludeRoute
 	}
-	return out, nil
+	return false
 }
 
-func (c *aPIServiceClient) CreateTracking(ctx context.Context, in *CreateTrackingRequest, opts ...grpc.CallOption) (*CreateTrackingResponse, error) {
-	out := new(CreateTrackingResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/CreateTracking", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTrackingRequest) GetRouteTolerance() float64 {
+	if m != nil {
+		return m.RouteTolerance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *aPIServiceClient) GetTracking(ctx context.Context, in *GetTrackingRequest, opts ...grpc.CallOption) (*GetTrackingResponse, error) {
-	out := new(GetTrackingResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/GetTracking", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetTrackingResponse struct {
+	Tracking             *Tracking `protobuf:"bytes,1,opt,name=tracking,proto3" json:"tracking,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (c *aPIServiceClient) ListTrackingsForUser(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error) {
-	out := new(ListTrackingsResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/ListTrackingsForUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTrackingResponse) Reset()         { *m = GetTrackingResponse{} }
+func (m *GetTrackingResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTrackingResponse) ProtoMessage()    {}
+func (*GetTrackingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
 }
 
-func (c *aPIServiceClient) ListTrackings(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error) {
-	out := new(ListTrackingsResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/ListTrackings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTrackingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrackingResponse.Unmarshal(m, b)
+}
+func (m *GetTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrackingResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTrackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrackingResponse.Merge(m, src)
+}
+func (m *GetTrackingResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTrackingResponse.Size(m)
+}
+func (m *GetTrackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrackingResponse.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) DeleteTracking(ctx context.Context, in *DeleteTrackingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.APIService/DeleteTracking", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTrackingResponse proto.InternalMessageInfo
+
+func (m *GetTrackingResponse) GetTracking() *Tracking {
+	if m != nil {
+		return m.Tracking
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error) {
-	out := new(ReportResponse)
-	err := c.cc.Invoke(ctx, "/api.APIService/Report", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ListTrackingsRequest struct {
+	PerReq int64  `protobuf:"varint,1,opt,name=per_req,proto3" json:"per_req,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Query  string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// List trackings of members of the team coached by current user instead
+	// of its own ones. Used only by ListTrackingsForUser.
+	TeamId               string   `protobuf:"bytes,4,opt,name=team_id,proto3" json:"team_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTrackingsRequest) Reset()         { *m = ListTrackingsRequest{} }
+func (m *ListTrackingsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTrackingsRequest) ProtoMessage()    {}
+func (*ListTrackingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+
+func (m *ListTrackingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTrackingsRequest.Unmarshal(m, b)
+}
+func (m *ListTrackingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTrackingsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTrackingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTrackingsRequest.Merge(m, src)
+}
+func (m *ListTrackingsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTrackingsRequest.Size(m)
+}
+func (m *ListTrackingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTrackingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTrackingsRequest proto.InternalMessageInfo
+
+func (m *ListTrackingsRequest) GetPerReq() int64 {
+	if m != nil {
+		return m.PerReq
 	}
-	return out, nil
+	return 0
 }
 
-// APIServiceServer is the server API for APIService service.
-type APIServiceServer interface {
-	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
-	// Add permission
-	AddPermission(context.Context, *AddPermissionRequest) (*empty.Empty, error)
-	// Add role
-	AddRole(context.Context, *AddRoleRequest) (*empty.Empty, error)
-	// Remove role
-	RemoveRole(context.Context, *RemoveRoleRequest) (*empty.Empty, error)
-	// Sign up user
-	SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error)
-	// Sign in user
-	SignIn(context.Context, *SignInRequest) (*SignInResponse, error)
-	// Get current user.
-	GetUser(context.Context, *empty.Empty) (*GetUserResponse, error)
-	// Get user for provided id.
-	GetUserByID(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	// List users.
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	// List detailed users.
-	ListUsersDetailed(context.Context, *ListUsersRequest) (*ListUsersDetailedResponse, error)
-	// Delete current user.
-	DeleteUser(context.Context, *empty.Empty) (*empty.Empty, error)
-	// Delete user for provided id.
-	DeleteUserByID(context.Context, *DeleteUserRequest) (*empty.Empty, error)
-	// Refresh token.
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	// Create new tracking for current user.
-	CreateTracking(context.Context, *CreateTrackingRequest) (*CreateTrackingResponse, error)
-	// Get tracking by id.
-	GetTracking(context.Context, *GetTrackingRequest) (*GetTrackingResponse, error)
-	// List tracking for current user.
-	ListTrackingsForUser(context.Context, *ListTrackingsRequest) (*ListTrackingsResponse, error)
-	// List trackings for all users.
-	ListTrackings(context.Context, *ListTrackingsRequest) (*ListTrackingsResponse, error)
-	// Delete tracking by id.
-	DeleteTracking(context.Context, *DeleteTrackingRequest) (*empty.Empty, error)
-	// Create report for current user.
-	// Create report for current user.
-	Report(context.Context, *ReportRequest) (*ReportResponse, error)
+func (m *ListTrackingsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
 }
 
-// UnimplementedAPIServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServiceServer struct {
+func (m *ListTrackingsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
 
-func (*UnimplementedAPIServiceServer) CreateAdmin(ctx context.Context, req *CreateAdminRequest) (*CreateAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAdmin not implemented")
+func (m *ListTrackingsRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
 }
-func (*UnimplementedAPIServiceServer) AddPermission(ctx context.Context, req *AddPermissionRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPermission not implemented")
+
+type ListTrackingsResponse struct {
+	Cursor               string      `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Total                int64       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Trackings            []*Tracking `protobuf:"bytes,3,rep,name=trackings,json=tracking,proto3" json:"trackings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (*UnimplementedAPIServiceServer) AddRole(ctx context.Context, req *AddRoleRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRole not implemented")
+
+func (m *ListTrackingsResponse) Reset()         { *m = ListTrackingsResponse{} }
+func (m *ListTrackingsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTrackingsResponse) ProtoMessage()    {}
+func (*ListTrackingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
 }
-func (*UnimplementedAPIServiceServer) RemoveRole(ctx context.Context, req *RemoveRoleRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRole not implemented")
+
+func (m *ListTrackingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTrackingsResponse.Unmarshal(m, b)
 }
-func (*UnimplementedAPIServiceServer) SignUp(ctx context.Context, req *SignUpRequest) (*SignUpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
+func (m *ListTrackingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTrackingsResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedAPIServiceServer) SignIn(ctx context.Context, req *SignInRequest) (*SignInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignIn not implemented")
+func (m *ListTrackingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTrackingsResponse.Merge(m, src)
 }
-func (*UnimplementedAPIServiceServer) GetUser(ctx context.Context, req *empty.Empty) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+func (m *ListTrackingsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTrackingsResponse.Size(m)
 }
-func (*UnimplementedAPIServiceServer) GetUserByID(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+func (m *ListTrackingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTrackingsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedAPIServiceServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+
+var xxx_messageInfo_ListTrackingsResponse proto.InternalMessageInfo
+
+func (m *ListTrackingsResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
 }
-func (*UnimplementedAPIServiceServer) ListUsersDetailed(ctx context.Context, req *ListUsersRequest) (*ListUsersDetailedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsersDetailed not implemented")
+
+func (m *ListTrackingsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
-func (*UnimplementedAPIServiceServer) DeleteUser(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (m *ListTrackingsResponse) GetTrackings() []*Tracking {
+	if m != nil {
+		return m.Trackings
+	}
+	return nil
 }
-func (*UnimplementedAPIServiceServer) DeleteUserByID(ctx context.Context, req *DeleteUserRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserByID not implemented")
+
+type ExportTrackingsRequest struct {
+	Format ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=api.ExportFormat" json:"format,omitempty"`
+	Query  string       `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Export trackings of all users instead of current one.
+	AllUsers             bool     `protobuf:"varint,3,opt,name=all_users,proto3" json:"all_users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedAPIServiceServer) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (m *ExportTrackingsRequest) Reset()         { *m = ExportTrackingsRequest{} }
+func (m *ExportTrackingsRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportTrackingsRequest) ProtoMessage()    {}
+func (*ExportTrackingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
 }
-func (*UnimplementedAPIServiceServer) CreateTracking(ctx context.Context, req *CreateTrackingRequest) (*CreateTrackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTracking not implemented")
+
+func (m *ExportTrackingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTrackingsRequest.Unmarshal(m, b)
 }
-func (*UnimplementedAPIServiceServer) GetTracking(ctx context.Context, req *GetTrackingRequest) (*GetTrackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTracking not implemented")
+func (m *ExportTrackingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTrackingsRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedAPIServiceServer) ListTrackingsForUser(ctx context.Context, req *ListTrackingsRequest) (*ListTrackingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTrackingsForUser not implemented")
+func (m *ExportTrackingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTrackingsRequest.Merge(m, src)
 }
-func (*UnimplementedAPIServiceServer) ListTrackings(ctx context.Context, req *ListTrackingsRequest) (*ListTrackingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTrackings not implemented")
+func (m *ExportTrackingsRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportTrackingsRequest.Size(m)
+}
+func (m *ExportTrackingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTrackingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTrackingsRequest proto.InternalMessageInfo
+
+func (m *ExportTrackingsRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_CSV
+}
+
+func (m *ExportTrackingsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ExportTrackingsRequest) GetAllUsers() bool {
+	if m != nil {
+		return m.AllUsers
+	}
+	return false
+}
+
+type ExportTrackingsResponse struct {
+	// Next part of the file.
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTrackingsResponse) Reset()         { *m = ExportTrackingsResponse{} }
+func (m *ExportTrackingsResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportTrackingsResponse) ProtoMessage()    {}
+func (*ExportTrackingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+}
+
+func (m *ExportTrackingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTrackingsResponse.Unmarshal(m, b)
+}
+func (m *ExportTrackingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTrackingsResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportTrackingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTrackingsResponse.Merge(m, src)
+}
+func (m *ExportTrackingsResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportTrackingsResponse.Size(m)
+}
+func (m *ExportTrackingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTrackingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTrackingsResponse proto.InternalMessageInfo
+
+func (m *ExportTrackingsResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type BackfillWeatherRequest struct {
+	// Backfill only trackings matching the query.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Max weather lookups per second, 1 by default. Rate below 0.01 isn't
+	// allowed except zero.
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Max lookups at once, 1 by default.
+	Concurrency          int32    `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillWeatherRequest) Reset()         { *m = BackfillWeatherRequest{} }
+func (m *BackfillWeatherRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillWeatherRequest) ProtoMessage()    {}
+func (*BackfillWeatherRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+}
+
+func (m *BackfillWeatherRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackfillWeatherRequest.Unmarshal(m, b)
+}
+func (m *BackfillWeatherRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackfillWeatherRequest.Marshal(b, m, deterministic)
+}
+func (m *BackfillWeatherRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillWeatherRequest.Merge(m, src)
+}
+func (m *BackfillWeatherRequest) XXX_Size() int {
+	return xxx_messageInfo_BackfillWeatherRequest.Size(m)
+}
+func (m *BackfillWeatherRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillWeatherRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillWeatherRequest proto.InternalMessageInfo
+
+func (m *BackfillWeatherRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *BackfillWeatherRequest) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *BackfillWeatherRequest) GetConcurrency() int32 {
+	if m != nil {
+		return m.Concurrency
+	}
+	return 0
+}
+
+type BackfillWeatherResponse struct {
+	// Number of trackings with missing weather when backfill started.
+	Total  int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Done   int64 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Failed int64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Tracking handled last.
+	TrackingId string `protobuf:"bytes,4,opt,name=tracking_id,proto3" json:"tracking_id,omitempty"`
+	// Error of the tracking handled last, empty if weather is set.
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillWeatherResponse) Reset()         { *m = BackfillWeatherResponse{} }
+func (m *BackfillWeatherResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillWeatherResponse) ProtoMessage()    {}
+func (*BackfillWeatherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *BackfillWeatherResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackfillWeatherResponse.Unmarshal(m, b)
+}
+func (m *BackfillWeatherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackfillWeatherResponse.Marshal(b, m, deterministic)
+}
+func (m *BackfillWeatherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillWeatherResponse.Merge(m, src)
+}
+func (m *BackfillWeatherResponse) XXX_Size() int {
+	return xxx_messageInfo_BackfillWeatherResponse.Size(m)
+}
+func (m *BackfillWeatherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillWeatherResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillWeatherResponse proto.InternalMessageInfo
+
+func (m *BackfillWeatherResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *BackfillWeatherResponse) GetDone() int64 {
+	if m != nil {
+		return m.Done
+	}
+	return 0
+}
+
+func (m *BackfillWeatherResponse) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *BackfillWeatherResponse) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *BackfillWeatherResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ReportRequest struct {
+	FromDate    string             `protobuf:"bytes,1,opt,name=from_date,proto3" json:"from_date,omitempty"`
+	Duration    *duration.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Granularity Granularity        `protobuf:"varint,3,opt,name=granularity,proto3,enum=api.Granularity" json:"granularity,omitempty"`
+	// Team member to create report for, current user by default.
+	UserId               string   `protobuf:"bytes,4,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportRequest) Reset()         { *m = ReportRequest{} }
+func (m *ReportRequest) String() string { return proto.CompactTextString(m) }
+func (*ReportRequest) ProtoMessage()    {}
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+}
+
+func (m *ReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportRequest.Unmarshal(m, b)
+}
+func (m *ReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportRequest.Marshal(b, m, deterministic)
+}
+func (m *ReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportRequest.Merge(m, src)
+}
+func (m *ReportRequest) XXX_Size() int {
+	return xxx_messageInfo_ReportRequest.Size(m)
+}
+func (m *ReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportRequest proto.InternalMessageInfo
+
+func (m *ReportRequest) GetFromDate() string {
+	if m != nil {
+		return m.FromDate
+	}
+	return ""
+}
+
+func (m *ReportRequest) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *ReportRequest) GetGranularity() Granularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return Granularity_GRANULARITY_UNSPECIFIED
+}
+
+func (m *ReportRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type GetPersonalRecordsRequest struct {
+	// Team member to get records of, current user by default.
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPersonalRecordsRequest) Reset()         { *m = GetPersonalRecordsRequest{} }
+func (m *GetPersonalRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPersonalRecordsRequest) ProtoMessage()    {}
+func (*GetPersonalRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+}
+
+func (m *GetPersonalRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersonalRecordsRequest.Unmarshal(m, b)
+}
+func (m *GetPersonalRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersonalRecordsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPersonalRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersonalRecordsRequest.Merge(m, src)
+}
+func (m *GetPersonalRecordsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPersonalRecordsRequest.Size(m)
+}
+func (m *GetPersonalRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersonalRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersonalRecordsRequest proto.InternalMessageInfo
+
+func (m *GetPersonalRecordsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+type ReportResponse struct {
+	AverageSpeed         float32         `protobuf:"fixed32,1,opt,name=average_speed,proto3" json:"average_speed,omitempty"`
+	Distance             float32         `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Buckets              []*ReportBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReportResponse) Reset()         { *m = ReportResponse{} }
+func (m *ReportResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportResponse) ProtoMessage()    {}
+func (*ReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+}
+
+func (m *ReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportResponse.Unmarshal(m, b)
+}
+func (m *ReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportResponse.Marshal(b, m, deterministic)
+}
+func (m *ReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportResponse.Merge(m, src)
+}
+func (m *ReportResponse) XXX_Size() int {
+	return xxx_messageInfo_ReportResponse.Size(m)
+}
+func (m *ReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportResponse proto.InternalMessageInfo
+
+func (m *ReportResponse) GetAverageSpeed() float32 {
+	if m != nil {
+		return m.AverageSpeed
+	}
+	return 0
+}
+
+func (m *ReportResponse) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *ReportResponse) GetBuckets() []*ReportBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type GetPersonalRecordsResponse struct {
+	FastestPace          *TrackingRecord `protobuf:"bytes,1,opt,name=fastest_pace,proto3" json:"fastest_pace,omitempty"`
+	LongestDistance      *TrackingRecord `protobuf:"bytes,2,opt,name=longest_distance,proto3" json:"longest_distance,omitempty"`
+	LongestDuration      *TrackingRecord `protobuf:"bytes,3,opt,name=longest_duration,proto3" json:"longest_duration,omitempty"`
+	BiggestWeek          *PeriodRecord   `protobuf:"bytes,4,opt,name=biggest_week,proto3" json:"biggest_week,omitempty"`
+	BiggestMonth         *PeriodRecord   `protobuf:"bytes,5,opt,name=biggest_month,proto3" json:"biggest_month,omitempty"`
+	CurrentStreak        *Streak         `protobuf:"bytes,6,opt,name=current_streak,proto3" json:"current_streak,omitempty"`
+	LongestStreak        *Streak         `protobuf:"bytes,7,opt,name=longest_streak,proto3" json:"longest_streak,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPersonalRecordsResponse) Reset()         { *m = GetPersonalRecordsResponse{} }
+func (m *GetPersonalRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPersonalRecordsResponse) ProtoMessage()    {}
+func (*GetPersonalRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+}
+
+func (m *GetPersonalRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersonalRecordsResponse.Unmarshal(m, b)
+}
+func (m *GetPersonalRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersonalRecordsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPersonalRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersonalRecordsResponse.Merge(m, src)
+}
+func (m *GetPersonalRecordsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPersonalRecordsResponse.Size(m)
+}
+func (m *GetPersonalRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersonalRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersonalRecordsResponse proto.InternalMessageInfo
+
+func (m *GetPersonalRecordsResponse) GetFastestPace() *TrackingRecord {
+	if m != nil {
+		return m.FastestPace
+	}
+	return nil
+}
+
+func (m *GetPersonalRecordsResponse) GetLongestDistance() *TrackingRecord {
+	if m != nil {
+		return m.LongestDistance
+	}
+	return nil
+}
+
+func (m *GetPersonalRecordsResponse) GetLongestDuration() *TrackingRecord {
+	if m != nil {
+		return m.LongestDuration
+	}
+	return nil
+}
+
+func (m *GetPersonalRecordsResponse) GetBiggestWeek() *PeriodRecord {
+	if m != nil {
+		return m.BiggestWeek
+	}
+	return nil
+}
+
+func (m *GetPersonalRecordsResponse) GetBiggestMonth() *PeriodRecord {
+	if m != nil {
+		return m.BiggestMonth
+	}
+	return nil
+}
+
+func (m *GetPersonalRecordsResponse) GetCurrentStreak() *Streak {
+	if m != nil {
+		return m.CurrentStreak
+	}
+	return nil
+}
+
+func (m *GetPersonalRecordsResponse) GetLongestStreak() *Streak {
+	if m != nil {
+		return m.LongestStreak
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTeamRequest) Reset()         { *m = CreateTeamRequest{} }
+func (m *CreateTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamRequest) ProtoMessage()    {}
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+}
+
+func (m *CreateTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamRequest.Unmarshal(m, b)
+}
+func (m *CreateTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamRequest.Merge(m, src)
+}
+func (m *CreateTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamRequest.Size(m)
+}
+func (m *CreateTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamRequest proto.InternalMessageInfo
+
+func (m *CreateTeamRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateTeamResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTeamResponse) Reset()         { *m = CreateTeamResponse{} }
+func (m *CreateTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamResponse) ProtoMessage()    {}
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+}
+
+func (m *CreateTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamResponse.Unmarshal(m, b)
+}
+func (m *CreateTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamResponse.Merge(m, src)
+}
+func (m *CreateTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamResponse.Size(m)
+}
+func (m *CreateTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamResponse proto.InternalMessageInfo
+
+func (m *CreateTeamResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetTeamRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamRequest) Reset()         { *m = GetTeamRequest{} }
+func (m *GetTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTeamRequest) ProtoMessage()    {}
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+}
+
+func (m *GetTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamRequest.Unmarshal(m, b)
+}
+func (m *GetTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamRequest.Merge(m, src)
+}
+func (m *GetTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTeamRequest.Size(m)
+}
+func (m *GetTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamRequest proto.InternalMessageInfo
+
+func (m *GetTeamRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamResponse) Reset()         { *m = GetTeamResponse{} }
+func (m *GetTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTeamResponse) ProtoMessage()    {}
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+}
+
+func (m *GetTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamResponse.Unmarshal(m, b)
+}
+func (m *GetTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamResponse.Merge(m, src)
+}
+func (m *GetTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTeamResponse.Size(m)
+}
+func (m *GetTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamResponse proto.InternalMessageInfo
+
+func (m *GetTeamResponse) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+type ListTeamsResponse struct {
+	Teams                []*Team  `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTeamsResponse) Reset()         { *m = ListTeamsResponse{} }
+func (m *ListTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTeamsResponse) ProtoMessage()    {}
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+}
+
+func (m *ListTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTeamsResponse.Unmarshal(m, b)
+}
+func (m *ListTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTeamsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTeamsResponse.Merge(m, src)
+}
+func (m *ListTeamsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTeamsResponse.Size(m)
+}
+func (m *ListTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTeamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTeamsResponse proto.InternalMessageInfo
+
+func (m *ListTeamsResponse) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTeamRequest) Reset()         { *m = DeleteTeamRequest{} }
+func (m *DeleteTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTeamRequest) ProtoMessage()    {}
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
+}
+
+func (m *DeleteTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTeamRequest.Unmarshal(m, b)
+}
+func (m *DeleteTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTeamRequest.Merge(m, src)
+}
+func (m *DeleteTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTeamRequest.Size(m)
+}
+func (m *DeleteTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTeamRequest proto.InternalMessageInfo
+
+func (m *DeleteTeamRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type InviteToTeamRequest struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=team_id,proto3" json:"team_id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role                 TeamRole `protobuf:"varint,3,opt,name=role,proto3,enum=api.TeamRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteToTeamRequest) Reset()         { *m = InviteToTeamRequest{} }
+func (m *InviteToTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteToTeamRequest) ProtoMessage()    {}
+func (*InviteToTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+}
+
+func (m *InviteToTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteToTeamRequest.Unmarshal(m, b)
+}
+func (m *InviteToTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteToTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *InviteToTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteToTeamRequest.Merge(m, src)
+}
+func (m *InviteToTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteToTeamRequest.Size(m)
+}
+func (m *InviteToTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteToTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteToTeamRequest proto.InternalMessageInfo
+
+func (m *InviteToTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *InviteToTeamRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *InviteToTeamRequest) GetRole() TeamRole {
+	if m != nil {
+		return m.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+type AcceptTeamInvitationRequest struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=team_id,proto3" json:"team_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptTeamInvitationRequest) Reset()         { *m = AcceptTeamInvitationRequest{} }
+func (m *AcceptTeamInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptTeamInvitationRequest) ProtoMessage()    {}
+func (*AcceptTeamInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+}
+
+func (m *AcceptTeamInvitationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptTeamInvitationRequest.Unmarshal(m, b)
+}
+func (m *AcceptTeamInvitationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptTeamInvitationRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptTeamInvitationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptTeamInvitationRequest.Merge(m, src)
+}
+func (m *AcceptTeamInvitationRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptTeamInvitationRequest.Size(m)
+}
+func (m *AcceptTeamInvitationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptTeamInvitationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptTeamInvitationRequest proto.InternalMessageInfo
+
+func (m *AcceptTeamInvitationRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type LeaveTeamRequest struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=team_id,proto3" json:"team_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaveTeamRequest) Reset()         { *m = LeaveTeamRequest{} }
+func (m *LeaveTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaveTeamRequest) ProtoMessage()    {}
+func (*LeaveTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+}
+
+func (m *LeaveTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaveTeamRequest.Unmarshal(m, b)
+}
+func (m *LeaveTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaveTeamRequest.Marshal(b, m, deterministic)
+}
+func (m *LeaveTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveTeamRequest.Merge(m, src)
+}
+func (m *LeaveTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaveTeamRequest.Size(m)
+}
+func (m *LeaveTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveTeamRequest proto.InternalMessageInfo
+
+func (m *LeaveTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+type User struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified        bool     `protobuf:"varint,3,opt,name=email_verified,proto3" json:"email_verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
+}
+
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *User) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *User) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
+type Permission struct {
+	Action               Action   `protobuf:"varint,1,opt,name=action,proto3,enum=api.Action" json:"action,omitempty"`
+	Scope                Scope    `protobuf:"varint,2,opt,name=scope,proto3,enum=api.Scope" json:"scope,omitempty"`
+	Item                 string   `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
+}
+
+func (m *Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Permission.Unmarshal(m, b)
+}
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
+}
+func (m *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(m, src)
+}
+func (m *Permission) XXX_Size() int {
+	return xxx_messageInfo_Permission.Size(m)
+}
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permission proto.InternalMessageInfo
+
+func (m *Permission) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (m *Permission) GetScope() Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return Scope_SCOPE_UNSPECIFIED
+}
+
+func (m *Permission) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+// EffectivePermission is the permission with the source it's granted by.
+// Role fields are set only for PERMISSION_SOURCE_ROLE.
+type EffectivePermission struct {
+	Permission           *Permission      `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	Source               PermissionSource `protobuf:"varint,2,opt,name=source,proto3,enum=api.PermissionSource" json:"source,omitempty"`
+	RoleId               string           `protobuf:"bytes,3,opt,name=role_id,proto3" json:"role_id,omitempty"`
+	RoleName             string           `protobuf:"bytes,4,opt,name=role_name,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EffectivePermission) Reset()         { *m = EffectivePermission{} }
+func (m *EffectivePermission) String() string { return proto.CompactTextString(m) }
+func (*EffectivePermission) ProtoMessage()    {}
+func (*EffectivePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
+}
+
+func (m *EffectivePermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EffectivePermission.Unmarshal(m, b)
+}
+func (m *EffectivePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EffectivePermission.Marshal(b, m, deterministic)
+}
+func (m *EffectivePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EffectivePermission.Merge(m, src)
+}
+func (m *EffectivePermission) XXX_Size() int {
+	return xxx_messageInfo_EffectivePermission.Size(m)
+}
+func (m *EffectivePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EffectivePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EffectivePermission proto.InternalMessageInfo
+
+func (m *EffectivePermission) GetPermission() *Permission {
+	if m != nil {
+		return m.Permission
+	}
+	return nil
+}
+
+func (m *EffectivePermission) GetSource() PermissionSource {
+	if m != nil {
+		return m.Source
+	}
+	return PermissionSource_PERMISSION_SOURCE_UNSPECIFIED
+}
+
+func (m *EffectivePermission) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *EffectivePermission) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type Team struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId              string            `protobuf:"bytes,3,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	Coaches              []string          `protobuf:"bytes,4,rep,name=coaches,proto3" json:"coaches,omitempty"`
+	Members              []string          `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	Invitations          []*TeamInvitation `protobuf:"bytes,6,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Team) Reset()         { *m = Team{} }
+func (m *Team) String() string { return proto.CompactTextString(m) }
+func (*Team) ProtoMessage()    {}
+func (*Team) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
+}
+
+func (m *Team) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Team.Unmarshal(m, b)
+}
+func (m *Team) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Team.Marshal(b, m, deterministic)
+}
+func (m *Team) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Team.Merge(m, src)
+}
+func (m *Team) XXX_Size() int {
+	return xxx_messageInfo_Team.Size(m)
+}
+func (m *Team) XXX_DiscardUnknown() {
+	xxx_messageInfo_Team.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Team proto.InternalMessageInfo
+
+func (m *Team) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Team) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Team) GetOwnerId() string {
+	if m != nil {
+		return m.OwnerId
+	}
+	return ""
+}
+
+func (m *Team) GetCoaches() []string {
+	if m != nil {
+		return m.Coaches
+	}
+	return nil
+}
+
+func (m *Team) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *Team) GetInvitations() []*TeamInvitation {
+	if m != nil {
+		return m.Invitations
+	}
+	return nil
+}
+
+type TeamInvitation struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Role                 TeamRole `protobuf:"varint,2,opt,name=role,proto3,enum=api.TeamRole" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TeamInvitation) Reset()         { *m = TeamInvitation{} }
+func (m *TeamInvitation) String() string { return proto.CompactTextString(m) }
+func (*TeamInvitation) ProtoMessage()    {}
+func (*TeamInvitation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
+}
+
+func (m *TeamInvitation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TeamInvitation.Unmarshal(m, b)
+}
+func (m *TeamInvitation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TeamInvitation.Marshal(b, m, deterministic)
+}
+func (m *TeamInvitation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamInvitation.Merge(m, src)
+}
+func (m *TeamInvitation) XXX_Size() int {
+	return xxx_messageInfo_TeamInvitation.Size(m)
+}
+func (m *TeamInvitation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamInvitation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamInvitation proto.InternalMessageInfo
+
+func (m *TeamInvitation) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *TeamInvitation) GetRole() TeamRole {
+	if m != nil {
+		return m.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+// AuditEvent is a privileged action. Before and after are JSON objects with
+// the changed fields of the target.
+type AuditEvent struct {
+	Id        string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId   string       `protobuf:"bytes,2,opt,name=actor_id,proto3" json:"actor_id,omitempty"`
+	Action    string       `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Target    *AuditTarget `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Before    string       `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After     string       `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	RequestId string       `protobuf:"bytes,7,opt,name=request_id,proto3" json:"request_id,omitempty"`
+	ClientIp  string       `protobuf:"bytes,8,opt,name=client_ip,proto3" json:"client_ip,omitempty"`
+	CreatedAt string       `protobuf:"bytes,9,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// peer_ip is the address of the caller, e.g. the gateway, client_ip is
+	// taken from X-Forwarded-For only for calls from trusted proxies
+	PeerIp               string   `protobuf:"bytes,10,opt,name=peer_ip,proto3" json:"peer_ip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTarget() *AuditTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetClientIp() string {
+	if m != nil {
+		return m.ClientIp
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetPeerIp() string {
+	if m != nil {
+		return m.PeerIp
+	}
+	return ""
+}
+
+type AuditTarget struct {
+	Scope                string   `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Item                 string   `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditTarget) Reset()         { *m = AuditTarget{} }
+func (m *AuditTarget) String() string { return proto.CompactTextString(m) }
+func (*AuditTarget) ProtoMessage()    {}
+func (*AuditTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
+}
+
+func (m *AuditTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditTarget.Unmarshal(m, b)
+}
+func (m *AuditTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditTarget.Marshal(b, m, deterministic)
+}
+func (m *AuditTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditTarget.Merge(m, src)
+}
+func (m *AuditTarget) XXX_Size() int {
+	return xxx_messageInfo_AuditTarget.Size(m)
+}
+func (m *AuditTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditTarget proto.InternalMessageInfo
+
+func (m *AuditTarget) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *AuditTarget) GetItem() string {
+	if m != nil {
+		return m.Item
+	}
+	return ""
+}
+
+type RoleDefinition struct {
+	Id                   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Permissions          []*Permission `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	BuiltIn              bool          `protobuf:"varint,4,opt,name=built_in,proto3" json:"built_in,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RoleDefinition) Reset()         { *m = RoleDefinition{} }
+func (m *RoleDefinition) String() string { return proto.CompactTextString(m) }
+func (*RoleDefinition) ProtoMessage()    {}
+func (*RoleDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
+}
+
+func (m *RoleDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleDefinition.Unmarshal(m, b)
+}
+func (m *RoleDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleDefinition.Marshal(b, m, deterministic)
+}
+func (m *RoleDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleDefinition.Merge(m, src)
+}
+func (m *RoleDefinition) XXX_Size() int {
+	return xxx_messageInfo_RoleDefinition.Size(m)
+}
+func (m *RoleDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleDefinition proto.InternalMessageInfo
+
+func (m *RoleDefinition) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RoleDefinition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleDefinition) GetPermissions() []*Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *RoleDefinition) GetBuiltIn() bool {
+	if m != nil {
+		return m.BuiltIn
+	}
+	return false
+}
+
+// JSONWebKey is a public key as defined by RFC 7517.
+type JSONWebKey struct {
+	Kty                  string   `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Kid                  string   `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use                  string   `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	Alg                  string   `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	Crv                  string   `protobuf:"bytes,5,opt,name=crv,proto3" json:"crv,omitempty"`
+	X                    string   `protobuf:"bytes,6,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    string   `protobuf:"bytes,7,opt,name=y,proto3" json:"y,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JSONWebKey) Reset()         { *m = JSONWebKey{} }
+func (m *JSONWebKey) String() string { return proto.CompactTextString(m) }
+func (*JSONWebKey) ProtoMessage()    {}
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
+}
+
+func (m *JSONWebKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JSONWebKey.Unmarshal(m, b)
+}
+func (m *JSONWebKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JSONWebKey.Marshal(b, m, deterministic)
+}
+func (m *JSONWebKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONWebKey.Merge(m, src)
+}
+func (m *JSONWebKey) XXX_Size() int {
+	return xxx_messageInfo_JSONWebKey.Size(m)
+}
+func (m *JSONWebKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONWebKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONWebKey proto.InternalMessageInfo
+
+func (m *JSONWebKey) GetKty() string {
+	if m != nil {
+		return m.Kty
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetKid() string {
+	if m != nil {
+		return m.Kid
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetUse() string {
+	if m != nil {
+		return m.Use
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetAlg() string {
+	if m != nil {
+		return m.Alg
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetCrv() string {
+	if m != nil {
+		return m.Crv
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetX() string {
+	if m != nil {
+		return m.X
+	}
+	return ""
+}
+
+func (m *JSONWebKey) GetY() string {
+	if m != nil {
+		return m.Y
+	}
+	return ""
+}
+
+type DetailedUser struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions          []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	EmailVerified        bool     `protobuf:"varint,5,opt,name=email_verified,proto3" json:"email_verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DetailedUser) Reset()         { *m = DetailedUser{} }
+func (m *DetailedUser) String() string { return proto.CompactTextString(m) }
+func (*DetailedUser) ProtoMessage()    {}
+func (*DetailedUser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
+}
+
+func (m *DetailedUser) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetailedUser.Unmarshal(m, b)
+}
+func (m *DetailedUser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetailedUser.Marshal(b, m, deterministic)
+}
+func (m *DetailedUser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetailedUser.Merge(m, src)
+}
+func (m *DetailedUser) XXX_Size() int {
+	return xxx_messageInfo_DetailedUser.Size(m)
+}
+func (m *DetailedUser) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetailedUser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetailedUser proto.InternalMessageInfo
+
+func (m *DetailedUser) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DetailedUser) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *DetailedUser) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *DetailedUser) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *DetailedUser) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
+type Tracking struct {
+	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId   string             `protobuf:"bytes,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Date     string             `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Time     *duration.Duration `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Distance float32            `protobuf:"fixed32,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	Location *Location          `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Weather  *Weather           `protobuf:"bytes,7,opt,name=weather,proto3" json:"weather,omitempty"`
+	// Route is returned only by GetTracking with include_route.
+	Route                []*RoutePoint `protobuf:"bytes,8,rep,name=route,proto3" json:"route,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Tracking) Reset()         { *m = Tracking{} }
+func (m *Tracking) String() string { return proto.CompactTextString(m) }
+func (*Tracking) ProtoMessage()    {}
+func (*Tracking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *Tracking) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tracking.Unmarshal(m, b)
+}
+func (m *Tracking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tracking.Marshal(b, m, deterministic)
+}
+func (m *Tracking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tracking.Merge(m, src)
+}
+func (m *Tracking) XXX_Size() int {
+	return xxx_messageInfo_Tracking.Size(m)
+}
+func (m *Tracking) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tracking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tracking proto.InternalMessageInfo
+
+func (m *Tracking) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Tracking) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Tracking) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *Tracking) GetTime() *duration.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Tracking) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *Tracking) GetLocation() *Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *Tracking) GetWeather() *Weather {
+	if m != nil {
+		return m.Weather
+	}
+	return nil
+}
+
+func (m *Tracking) GetRoute() []*RoutePoint {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+type TrackingUpdate struct {
+	Date                 string             `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Time                 *duration.Duration `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Distance             float32            `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Location             *Location          `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TrackingUpdate) Reset()         { *m = TrackingUpdate{} }
+func (m *TrackingUpdate) String() string { return proto.CompactTextString(m) }
+func (*TrackingUpdate) ProtoMessage()    {}
+func (*TrackingUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *TrackingUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrackingUpdate.Unmarshal(m, b)
+}
+func (m *TrackingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrackingUpdate.Marshal(b, m, deterministic)
+}
+func (m *TrackingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackingUpdate.Merge(m, src)
+}
+func (m *TrackingUpdate) XXX_Size() int {
+	return xxx_messageInfo_TrackingUpdate.Size(m)
+}
+func (m *TrackingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackingUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackingUpdate proto.InternalMessageInfo
+
+func (m *TrackingUpdate) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *TrackingUpdate) GetTime() *duration.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *TrackingUpdate) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *TrackingUpdate) GetLocation() *Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+type ReportBucket struct {
+	StartDate            string             `protobuf:"bytes,1,opt,name=start_date,proto3" json:"start_date,omitempty"`
+	Count                int64              `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Distance             float32            `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Time                 *duration.Duration `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	AverageSpeed         float32            `protobuf:"fixed32,5,opt,name=average_speed,proto3" json:"average_speed,omitempty"`
+	LongestRun           float32            `protobuf:"fixed32,6,opt,name=longest_run,proto3" json:"longest_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReportBucket) Reset()         { *m = ReportBucket{} }
+func (m *ReportBucket) String() string { return proto.CompactTextString(m) }
+func (*ReportBucket) ProtoMessage()    {}
+func (*ReportBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *ReportBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportBucket.Unmarshal(m, b)
+}
+func (m *ReportBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportBucket.Marshal(b, m, deterministic)
+}
+func (m *ReportBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportBucket.Merge(m, src)
+}
+func (m *ReportBucket) XXX_Size() int {
+	return xxx_messageInfo_ReportBucket.Size(m)
+}
+func (m *ReportBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportBucket proto.InternalMessageInfo
+
+func (m *ReportBucket) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *ReportBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReportBucket) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *ReportBucket) GetTime() *duration.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *ReportBucket) GetAverageSpeed() float32 {
+	if m != nil {
+		return m.AverageSpeed
+	}
+	return 0
+}
+
+func (m *ReportBucket) GetLongestRun() float32 {
+	if m != nil {
+		return m.LongestRun
+	}
+	return 0
+}
+
+// TrackingRecord points to the tracking that holds the record.
+type TrackingRecord struct {
+	TrackingId string             `protobuf:"bytes,1,opt,name=tracking_id,proto3" json:"tracking_id,omitempty"`
+	Date       string             `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Distance   float32            `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Time       *duration.Duration `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Time per kilometer.
+	Pace                 *duration.Duration `protobuf:"bytes,5,opt,name=pace,proto3" json:"pace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TrackingRecord) Reset()         { *m = TrackingRecord{} }
+func (m *TrackingRecord) String() string { return proto.CompactTextString(m) }
+func (*TrackingRecord) ProtoMessage()    {}
+func (*TrackingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *TrackingRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrackingRecord.Unmarshal(m, b)
+}
+func (m *TrackingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrackingRecord.Marshal(b, m, deterministic)
+}
+func (m *TrackingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackingRecord.Merge(m, src)
+}
+func (m *TrackingRecord) XXX_Size() int {
+	return xxx_messageInfo_TrackingRecord.Size(m)
+}
+func (m *TrackingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrackingRecord proto.InternalMessageInfo
+
+func (m *TrackingRecord) GetTrackingId() string {
+	if m != nil {
+		return m.TrackingId
+	}
+	return ""
+}
+
+func (m *TrackingRecord) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *TrackingRecord) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *TrackingRecord) GetTime() *duration.Duration {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *TrackingRecord) GetPace() *duration.Duration {
+	if m != nil {
+		return m.Pace
+	}
+	return nil
+}
+
+// PeriodRecord is ISO week or calendar month with the biggest distance.
+type PeriodRecord struct {
+	StartDate            string   `protobuf:"bytes,1,opt,name=start_date,proto3" json:"start_date,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Distance             float32  `protobuf:"fixed32,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	TrackingIds          []string `protobuf:"bytes,4,rep,name=tracking_ids,proto3" json:"tracking_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeriodRecord) Reset()         { *m = PeriodRecord{} }
+func (m *PeriodRecord) String() string { return proto.CompactTextString(m) }
+func (*PeriodRecord) ProtoMessage()    {}
+func (*PeriodRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *PeriodRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeriodRecord.Unmarshal(m, b)
+}
+func (m *PeriodRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeriodRecord.Marshal(b, m, deterministic)
+}
+func (m *PeriodRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodRecord.Merge(m, src)
+}
+func (m *PeriodRecord) XXX_Size() int {
+	return xxx_messageInfo_PeriodRecord.Size(m)
+}
+func (m *PeriodRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodRecord proto.InternalMessageInfo
+
+func (m *PeriodRecord) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *PeriodRecord) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PeriodRecord) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *PeriodRecord) GetTrackingIds() []string {
+	if m != nil {
+		return m.TrackingIds
+	}
+	return nil
+}
+
+// Streak is number of consecutive days with at least one tracking.
+type Streak struct {
+	Days                 int64    `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	StartDate            string   `protobuf:"bytes,2,opt,name=start_date,proto3" json:"start_date,omitempty"`
+	EndDate              string   `protobuf:"bytes,3,opt,name=end_date,proto3" json:"end_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Streak) Reset()         { *m = Streak{} }
+func (m *Streak) String() string { return proto.CompactTextString(m) }
+func (*Streak) ProtoMessage()    {}
+func (*Streak) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *Streak) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Streak.Unmarshal(m, b)
+}
+func (m *Streak) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Streak.Marshal(b, m, deterministic)
+}
+func (m *Streak) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Streak.Merge(m, src)
+}
+func (m *Streak) XXX_Size() int {
+	return xxx_messageInfo_Streak.Size(m)
+}
+func (m *Streak) XXX_DiscardUnknown() {
+	xxx_messageInfo_Streak.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Streak proto.InternalMessageInfo
+
+func (m *Streak) GetDays() int64 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *Streak) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *Streak) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+type Location struct {
+	Longitude            float64  `protobuf:"fixed64,1,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude             float64  `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Location) Reset()         { *m = Location{} }
+func (m *Location) String() string { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()    {}
+func (*Location) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *Location) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Location.Unmarshal(m, b)
+}
+func (m *Location) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Location.Marshal(b, m, deterministic)
+}
+func (m *Location) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Location.Merge(m, src)
+}
+func (m *Location) XXX_Size() int {
+	return xxx_messageInfo_Location.Size(m)
+}
+func (m *Location) XXX_DiscardUnknown() {
+	xxx_messageInfo_Location.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Location proto.InternalMessageInfo
+
+func (m *Location) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *Location) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+type RoutePoint struct {
+	Latitude             float64              `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude            float64              `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Elevation            float64              `protobuf:"fixed64,3,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	Time                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RoutePoint) Reset()         { *m = RoutePoint{} }
+func (m *RoutePoint) String() string { return proto.CompactTextString(m) }
+func (*RoutePoint) ProtoMessage()    {}
+func (*RoutePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *RoutePoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoutePoint.Unmarshal(m, b)
+}
+func (m *RoutePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoutePoint.Marshal(b, m, deterministic)
+}
+func (m *RoutePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutePoint.Merge(m, src)
+}
+func (m *RoutePoint) XXX_Size() int {
+	return xxx_messageInfo_RoutePoint.Size(m)
+}
+func (m *RoutePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutePoint proto.InternalMessageInfo
+
+func (m *RoutePoint) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *RoutePoint) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *RoutePoint) GetElevation() float64 {
+	if m != nil {
+		return m.Elevation
+	}
+	return 0
+}
+
+func (m *RoutePoint) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type Weather struct {
+	Temperature          float32  `protobuf:"fixed32,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	TemperatureMin       float32  `protobuf:"fixed32,2,opt,name=temperature_min,proto3" json:"temperature_min,omitempty"`
+	TemperatureMax       float32  `protobuf:"fixed32,3,opt,name=temperature_max,proto3" json:"temperature_max,omitempty"`
+	Snowdepth            float32  `protobuf:"fixed32,4,opt,name=snowdepth,proto3" json:"snowdepth,omitempty"`
+	Winddirection        float32  `protobuf:"fixed32,5,opt,name=winddirection,proto3" json:"winddirection,omitempty"`
+	Windspeed            float32  `protobuf:"fixed32,6,opt,name=windspeed,proto3" json:"windspeed,omitempty"`
+	Pressure             float32  `protobuf:"fixed32,7,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Weather) Reset()         { *m = Weather{} }
+func (m *Weather) String() string { return proto.CompactTextString(m) }
+func (*Weather) ProtoMessage()    {}
+func (*Weather) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *Weather) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Weather.Unmarshal(m, b)
+}
+func (m *Weather) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Weather.Marshal(b, m, deterministic)
+}
+func (m *Weather) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Weather.Merge(m, src)
+}
+func (m *Weather) XXX_Size() int {
+	return xxx_messageInfo_Weather.Size(m)
+}
+func (m *Weather) XXX_DiscardUnknown() {
+	xxx_messageInfo_Weather.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Weather proto.InternalMessageInfo
+
+func (m *Weather) GetTemperature() float32 {
+	if m != nil {
+		return m.Temperature
+	}
+	return 0
+}
+
+func (m *Weather) GetTemperatureMin() float32 {
+	if m != nil {
+		return m.TemperatureMin
+	}
+	return 0
+}
+
+func (m *Weather) GetTemperatureMax() float32 {
+	if m != nil {
+		return m.TemperatureMax
+	}
+	return 0
+}
+
+func (m *Weather) GetSnowdepth() float32 {
+	if m != nil {
+		return m.Snowdepth
+	}
+	return 0
+}
+
+func (m *Weather) GetWinddirection() float32 {
+	if m != nil {
+		return m.Winddirection
+	}
+	return 0
+}
+
+func (m *Weather) GetWindspeed() float32 {
+	if m != nil {
+		return m.Windspeed
+	}
+	return 0
+}
+
+func (m *Weather) GetPressure() float32 {
+	if m != nil {
+		return m.Pressure
+	}
+	return 0
+}
+
+var E_Auth = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptor.MethodOptions)(nil),
+	ExtensionType: (*AuthRule)(nil),
+	Field:         50000,
+	Name:          "api.auth",
+	Tag:           "bytes,50000,opt,name=auth",
+	Filename:      "api.proto",
+}
+
+func init() {
+	proto.RegisterEnum("api.Role", Role_name, Role_value)
+	proto.RegisterEnum("api.Granularity", Granularity_name, Granularity_value)
+	proto.RegisterEnum("api.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("api.Scope", Scope_name, Scope_value)
+	proto.RegisterEnum("api.TeamRole", TeamRole_name, TeamRole_value)
+	proto.RegisterEnum("api.PermissionSource", PermissionSource_name, PermissionSource_value)
+	proto.RegisterEnum("api.Action", Action_name, Action_value)
+	proto.RegisterType((*AuthRule)(nil), "api.AuthRule")
+	proto.RegisterType((*CreateAdminRequest)(nil), "api.CreateAdminRequest")
+	proto.RegisterType((*CreateAdminResponse)(nil), "api.CreateAdminResponse")
+	proto.RegisterType((*AddPermissionRequest)(nil), "api.AddPermissionRequest")
+	proto.RegisterType((*RemovePermissionRequest)(nil), "api.RemovePermissionRequest")
+	proto.RegisterType((*ListEffectivePermissionsRequest)(nil), "api.ListEffectivePermissionsRequest")
+	proto.RegisterType((*ListEffectivePermissionsResponse)(nil), "api.ListEffectivePermissionsResponse")
+	proto.RegisterType((*AddRoleRequest)(nil), "api.AddRoleRequest")
+	proto.RegisterType((*RemoveRoleRequest)(nil), "api.RemoveRoleRequest")
+	proto.RegisterType((*CreateRoleRequest)(nil), "api.CreateRoleRequest")
+	proto.RegisterType((*CreateRoleResponse)(nil), "api.CreateRoleResponse")
+	proto.RegisterType((*UpdateRoleRequest)(nil), "api.UpdateRoleRequest")
+	proto.RegisterType((*DeleteRoleRequest)(nil), "api.DeleteRoleRequest")
+	proto.RegisterType((*GetRoleRequest)(nil), "api.GetRoleRequest")
+	proto.RegisterType((*GetRoleResponse)(nil), "api.GetRoleResponse")
+	proto.RegisterType((*ListRolesResponse)(nil), "api.ListRolesResponse")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "api.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "api.ListAuditEventsResponse")
+	proto.RegisterType((*SignUpRequest)(nil), "api.SignUpRequest")
+	proto.RegisterType((*SignUpResponse)(nil), "api.SignUpResponse")
+	proto.RegisterType((*UnlockSignInRequest)(nil), "api.UnlockSignInRequest")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "api.ChangePasswordRequest")
+	proto.RegisterType((*ChangeEmailRequest)(nil), "api.ChangeEmailRequest")
+	proto.RegisterType((*ChangeUserPasswordRequest)(nil), "api.ChangeUserPasswordRequest")
+	proto.RegisterType((*ChangeUserEmailRequest)(nil), "api.ChangeUserEmailRequest")
+	proto.RegisterType((*SignInRequest)(nil), "api.SignInRequest")
+	proto.RegisterType((*SignInResponse)(nil), "api.SignInResponse")
+	proto.RegisterType((*GetUserRequest)(nil), "api.GetUserRequest")
+	proto.RegisterType((*GetUserResponse)(nil), "api.GetUserResponse")
+	proto.RegisterType((*ListUsersRequest)(nil), "api.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "api.ListUsersResponse")
+	proto.RegisterType((*ListUsersDetailedResponse)(nil), "api.ListUsersDetailedResponse")
+	proto.RegisterType((*DeleteUserRequest)(nil), "api.DeleteUserRequest")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "api.RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "api.RefreshTokenResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "api.LogoutRequest")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "api.RequestPasswordResetRequest")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "api.ResetPasswordRequest")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "api.VerifyEmailRequest")
+	proto.RegisterType((*GetJWKSResponse)(nil), "api.GetJWKSResponse")
+	proto.RegisterType((*CreateTrackingRequest)(nil), "api.CreateTrackingRequest")
+	proto.RegisterType((*CreateTrackingResponse)(nil), "api.CreateTrackingResponse")
+	proto.RegisterType((*ImportTrackingsRequest)(nil), "api.ImportTrackingsRequest")
+	proto.RegisterType((*ImportTrackingsResponse)(nil), "api.ImportTrackingsResponse")
+	proto.RegisterType((*DeleteTrackingRequest)(nil), "api.DeleteTrackingRequest")
+	proto.RegisterType((*UpdateTrackingRequest)(nil), "api.UpdateTrackingRequest")
+	proto.RegisterType((*GetTrackingRequest)(nil), "api.GetTrackingRequest")
+	proto.RegisterType((*GetTrackingResponse)(nil), "api.GetTrackingResponse")
+	proto.RegisterType((*ListTrackingsRequest)(nil), "api.ListTrackingsRequest")
+	proto.RegisterType((*ListTrackingsResponse)(nil), "api.ListTrackingsResponse")
+	proto.RegisterType((*ExportTrackingsRequest)(nil), "api.ExportTrackingsRequest")
+	proto.RegisterType((*ExportTrackingsResponse)(nil), "api.ExportTrackingsResponse")
+	proto.RegisterType((*BackfillWeatherRequest)(nil), "api.BackfillWeatherRequest")
+	proto.RegisterType((*BackfillWeatherResponse)(nil), "api.BackfillWeatherResponse")
+	proto.RegisterType((*ReportRequest)(nil), "api.ReportRequest")
+	proto.RegisterType((*GetPersonalRecordsRequest)(nil), "api.GetPersonalRecordsRequest")
+	proto.RegisterType((*ReportResponse)(nil), "api.ReportResponse")
+	proto.RegisterType((*GetPersonalRecordsResponse)(nil), "api.GetPersonalRecordsResponse")
+	proto.RegisterType((*CreateTeamRequest)(nil), "api.CreateTeamRequest")
+	proto.RegisterType((*CreateTeamResponse)(nil), "api.CreateTeamResponse")
+	proto.RegisterType((*GetTeamRequest)(nil), "api.GetTeamRequest")
+	proto.RegisterType((*GetTeamResponse)(nil), "api.GetTeamResponse")
+	proto.RegisterType((*ListTeamsResponse)(nil), "api.ListTeamsResponse")
+	proto.RegisterType((*DeleteTeamRequest)(nil), "api.DeleteTeamRequest")
+	proto.RegisterType((*InviteToTeamRequest)(nil), "api.InviteToTeamRequest")
+	proto.RegisterType((*AcceptTeamInvitationRequest)(nil), "api.AcceptTeamInvitationRequest")
+	proto.RegisterType((*LeaveTeamRequest)(nil), "api.LeaveTeamRequest")
+	proto.RegisterType((*User)(nil), "api.User")
+	proto.RegisterType((*Permission)(nil), "api.Permission")
+	proto.RegisterType((*EffectivePermission)(nil), "api.EffectivePermission")
+	proto.RegisterType((*Team)(nil), "api.Team")
+	proto.RegisterType((*TeamInvitation)(nil), "api.TeamInvitation")
+	proto.RegisterType((*AuditEvent)(nil), "api.AuditEvent")
+	proto.RegisterType((*AuditTarget)(nil), "api.AuditTarget")
+	proto.RegisterType((*RoleDefinition)(nil), "api.RoleDefinition")
+	proto.RegisterType((*JSONWebKey)(nil), "api.JSONWebKey")
+	proto.RegisterType((*DetailedUser)(nil), "api.DetailedUser")
+	proto.RegisterType((*Tracking)(nil), "api.Tracking")
+	proto.RegisterType((*TrackingUpdate)(nil), "api.TrackingUpdate")
+	proto.RegisterType((*ReportBucket)(nil), "api.ReportBucket")
+	proto.RegisterType((*TrackingRecord)(nil), "api.TrackingRecord")
+	proto.RegisterType((*PeriodRecord)(nil), "api.PeriodRecord")
+	proto.RegisterType((*Streak)(nil), "api.Streak")
+	proto.RegisterType((*Location)(nil), "api.Location")
+	proto.RegisterType((*RoutePoint)(nil), "api.RoutePoint")
+	proto.RegisterType((*Weather)(nil), "api.Weather")
+	proto.RegisterExtension(E_Auth)
+}
+
+func init() {
+	proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c)
+}
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 4782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0x5b, 0x6f, 0x23, 0x47,
+	0x76, 0xf0, 0x34, 0xa9, 0x0b, 0x75, 0x24, 0x51, 0x54, 0xe9, 0x46, 0xf5, 0x68, 0x46, 0x72, 0xcd,
+	0x78, 0x47, 0x43, 0x5b, 0x43, 0x5b, 0xbe, 0x7d, 0x98, 0xfd, 0x12, 0x9b, 0x23, 0xd1, 0x63, 0xd9,
+	0xa3, 0x91, 0xdc, 0xd4, 0x5c, 0x3c, 0x9b, 0x2c, 0xd3, 0x22, 0x4b, 0x54, 0x5b, 0x64, 0x37, 0xdd,
+	0xdd, 0x94, 0x46, 0xde, 0x35, 0xd6, 0xf0, 0x66, 0xfd, 0x16, 0x04, 0xc8, 0x06, 0x79, 0x48, 0xf2,
+	0x90, 0xa7, 0x00, 0x41, 0x90, 0x00, 0x9b, 0x60, 0xdf, 0xf7, 0x07, 0xe4, 0x21, 0x40, 0x1e, 0xf2,
+	0x68, 0xc0, 0xc9, 0xdf, 0x08, 0x36, 0xa8, 0x5b, 0x77, 0xf5, 0x4d, 0xe2, 0x38, 0x4e, 0x90, 0xcc,
+	0x8b, 0xba, 0x4e, 0x9d, 0x3a, 0xf7, 0xaa, 0x3a, 0x75, 0x78, 0x06, 0x26, 0xcc, 0xbe, 0x75, 0xa7,
+	0xef, 0x3a, 0xbe, 0x83, 0xf2, 0x66, 0xdf, 0xd2, 0xaf, 0x76, 0x1c, 0xa7, 0xd3, 0x25, 0x55, 0x06,
+	0x3a, 0x1c, 0x1c, 0x55, 0x49, 0xaf, 0xef, 0x9f, 0x73, 0x0c, 0x7d, 0x35, 0x3e, 0xe9, 0x5b, 0x3d,
+	0xe2, 0xf9, 0x66, 0xaf, 0x2f, 0x10, 0xae, 0xc7, 0x11, 0xda, 0x03, 0xd7, 0xf4, 0x2d, 0xc7, 0x16,
+	0xf3, 0x6b, 0xf1, 0xf9, 0x23, 0x8b, 0x74, 0xdb, 0xcd, 0x9e, 0xe9, 0x9d, 0x64, 0x61, 0xb4, 0x89,
+	0xd7, 0x72, 0xad, 0xbe, 0xef, 0xb8, 0x02, 0x63, 0x45, 0x60, 0x98, 0x7d, 0xab, 0x6a, 0xda, 0xb6,
+	0xe3, 0x33, 0x06, 0x9e, 0x98, 0x7d, 0x95, 0xfd, 0x69, 0x6d, 0x74, 0x88, 0xbd, 0xe1, 0x9d, 0x99,
+	0x9d, 0x0e, 0x71, 0xab, 0x4e, 0x9f, 0x61, 0xa4, 0x60, 0xbf, 0xdd, 0xb1, 0xfc, 0xe3, 0xc1, 0xe1,
+	0x9d, 0x96, 0xd3, 0xab, 0xf6, 0xce, 0x2c, 0xff, 0xc4, 0x39, 0xab, 0x76, 0x9c, 0x0d, 0x36, 0xb9,
+	0x71, 0x6a, 0x76, 0xad, 0xb6, 0xe9, 0x3b, 0xae, 0x57, 0x0d, 0x3e, 0xf9, 0x3a, 0xfc, 0x8f, 0x1a,
+	0x14, 0x6a, 0x03, 0xff, 0xd8, 0x18, 0x74, 0x09, 0x5a, 0x84, 0xb1, 0xfe, 0xe0, 0xb0, 0x6b, 0xb5,
+	0xca, 0xda, 0x9a, 0xb6, 0x5e, 0x30, 0xc4, 0x08, 0xdd, 0x80, 0x31, 0xb3, 0x45, 0xb9, 0x95, 0x73,
+	0x6b, 0xda, 0x7a, 0x71, 0x73, 0xf2, 0x0e, 0xb5, 0x75, 0x8d, 0x81, 0x0c, 0x31, 0x85, 0xd6, 0x60,
+	0xd4, 0x6b, 0x39, 0x7d, 0x52, 0xce, 0x33, 0x1c, 0x60, 0x38, 0x0d, 0x0a, 0x31, 0xf8, 0x04, 0xba,
+	0x06, 0x60, 0xf9, 0xa4, 0xd7, 0x64, 0xa6, 0x2a, 0x8f, 0xac, 0x69, 0xeb, 0x13, 0xc6, 0x04, 0x85,
+	0xbc, 0x4f, 0x01, 0xe8, 0x36, 0x94, 0xcc, 0x6e, 0xd7, 0x39, 0x6b, 0x0e, 0xec, 0x53, 0xe2, 0x5a,
+	0x47, 0x16, 0x69, 0x97, 0x47, 0x99, 0x1c, 0x33, 0x0c, 0xfe, 0x28, 0x00, 0xe3, 0xc7, 0x80, 0xb6,
+	0x5c, 0x62, 0xfa, 0xa4, 0xd6, 0xee, 0x59, 0xb6, 0x41, 0x3e, 0x1b, 0x10, 0xcf, 0x47, 0x2b, 0x30,
+	0x4a, 0x7a, 0xa6, 0xd5, 0x65, 0xd2, 0x4f, 0xdc, 0x1b, 0xfb, 0xf6, 0x9b, 0xd5, 0xdc, 0x53, 0xcd,
+	0xe0, 0x40, 0x84, 0xa1, 0xd0, 0x37, 0x3d, 0xef, 0xcc, 0x71, 0xdb, 0xe5, 0x5c, 0x04, 0x21, 0x80,
+	0xe3, 0x97, 0x61, 0x2e, 0x42, 0xd7, 0xeb, 0x3b, 0xb6, 0x47, 0x50, 0x11, 0x72, 0x56, 0x9b, 0x53,
+	0x35, 0x72, 0x56, 0x1b, 0xff, 0xad, 0x06, 0xf3, 0xb5, 0x76, 0x7b, 0x9f, 0xb8, 0x3d, 0xcb, 0xf3,
+	0x2c, 0x27, 0x90, 0x60, 0x0d, 0xc6, 0x07, 0x1e, 0x71, 0x9b, 0x12, 0x3b, 0x60, 0x21, 0xc1, 0x68,
+	0x5d, 0x5a, 0x29, 0x17, 0xb7, 0x52, 0x28, 0x2f, 0xb7, 0xd6, 0x2b, 0x81, 0xd1, 0xf3, 0x09, 0xa3,
+	0x07, 0xb8, 0xd2, 0xf8, 0x3a, 0x8c, 0x50, 0x43, 0x72, 0xa3, 0x06, 0xb3, 0x0c, 0x86, 0xff, 0x5e,
+	0x83, 0x25, 0x83, 0xf4, 0x9c, 0x53, 0xf2, 0x7f, 0x44, 0xe0, 0x2d, 0x58, 0x7d, 0x60, 0x79, 0x7e,
+	0xfd, 0xe8, 0x88, 0xb4, 0x7c, 0x4b, 0x15, 0xdb, 0x1b, 0x5a, 0x6e, 0xfc, 0x63, 0x58, 0xcb, 0x26,
+	0x22, 0xfc, 0x7a, 0x17, 0x26, 0xfb, 0x21, 0xb8, 0xac, 0xad, 0xe5, 0xd7, 0x27, 0x37, 0xcb, 0x4c,
+	0xec, 0x94, 0x75, 0x86, 0x8a, 0x8c, 0x4f, 0xa0, 0x58, 0x6b, 0xb7, 0x0d, 0xa7, 0x4b, 0x86, 0xb7,
+	0xe5, 0x35, 0x18, 0x71, 0x9d, 0xae, 0x34, 0xe5, 0x04, 0x63, 0xc4, 0x28, 0x30, 0x30, 0x2a, 0xc3,
+	0x38, 0xfd, 0x4b, 0x09, 0xe4, 0x59, 0xac, 0xc9, 0x21, 0xb6, 0x61, 0x96, 0x7b, 0xf0, 0x7f, 0x88,
+	0xdf, 0x21, 0xcc, 0xf2, 0x7d, 0xa0, 0xf2, 0xd3, 0x61, 0xc4, 0x36, 0x7b, 0x24, 0xc6, 0x8c, 0xc1,
+	0xd0, 0xeb, 0x51, 0x4b, 0xe6, 0x98, 0x25, 0x67, 0x18, 0xc3, 0x2c, 0x03, 0xde, 0x04, 0xa4, 0xf2,
+	0xc8, 0xd8, 0x6a, 0x9f, 0xc3, 0xec, 0xa3, 0x7e, 0x3b, 0x26, 0xc9, 0x62, 0x88, 0x14, 0xc8, 0x91,
+	0xb3, 0xda, 0x81, 0x84, 0xb9, 0xcb, 0x25, 0xcc, 0x0f, 0x21, 0xe1, 0x2b, 0x30, 0xbb, 0x4d, 0xba,
+	0x64, 0x28, 0xde, 0x78, 0x1d, 0x8a, 0xf7, 0x89, 0x3f, 0x0c, 0xe6, 0x5d, 0x98, 0x09, 0x30, 0x85,
+	0xd6, 0xb7, 0x84, 0xa3, 0x28, 0xf2, 0xe4, 0xe6, 0x5c, 0xe0, 0xa8, 0x6d, 0x72, 0x64, 0xd9, 0x16,
+	0x3b, 0x66, 0x19, 0x02, 0xfe, 0x5d, 0x98, 0xa5, 0x51, 0x4d, 0xe7, 0xc2, 0x30, 0xbe, 0x0d, 0xa3,
+	0x74, 0x52, 0x06, 0x70, 0xea, 0x72, 0x8e, 0x81, 0xff, 0x50, 0x83, 0x45, 0x4a, 0xa0, 0x36, 0x68,
+	0x5b, 0x7e, 0xfd, 0x94, 0xd8, 0x7e, 0xb0, 0xa5, 0xca, 0x30, 0xde, 0x27, 0x6e, 0xd3, 0x25, 0x9f,
+	0x31, 0x31, 0xf2, 0x86, 0x1c, 0xd2, 0x6b, 0xa1, 0x35, 0x70, 0x3d, 0xc7, 0xe5, 0x86, 0x35, 0xc4,
+	0x08, 0xe9, 0x50, 0x30, 0x5b, 0xbe, 0xe3, 0x86, 0x01, 0x14, 0x8c, 0xd1, 0x0a, 0x4c, 0xf8, 0xa6,
+	0xdb, 0x21, 0x3e, 0x9d, 0x14, 0x47, 0x7d, 0x00, 0xc0, 0x7d, 0x58, 0x4a, 0x48, 0x21, 0x94, 0x09,
+	0x99, 0x69, 0x11, 0x66, 0xf3, 0x30, 0xea, 0x3b, 0xbe, 0xd9, 0x65, 0x32, 0xe4, 0x0d, 0x3e, 0x40,
+	0xb7, 0x60, 0x8c, 0xb0, 0xf5, 0x11, 0x87, 0x86, 0x74, 0x0d, 0x31, 0x8d, 0x3f, 0x86, 0xe9, 0x86,
+	0xd5, 0xb1, 0x1f, 0xf5, 0xbf, 0xbf, 0xcb, 0x62, 0x0d, 0x8a, 0x92, 0x64, 0x46, 0xf0, 0xbe, 0x0b,
+	0x73, 0x8f, 0xec, 0xae, 0xd3, 0x3a, 0xa1, 0x78, 0x3b, 0xb6, 0x62, 0xe9, 0xc8, 0xc6, 0x0d, 0x37,
+	0x2c, 0x25, 0xd0, 0x17, 0x56, 0xce, 0x59, 0x7d, 0x7c, 0x06, 0x0b, 0x5b, 0xc7, 0xa6, 0xdd, 0x21,
+	0xfb, 0x82, 0xa9, 0x24, 0xb1, 0x09, 0xa5, 0xd6, 0xc0, 0x75, 0x89, 0xed, 0x37, 0x03, 0x39, 0xa3,
+	0x8a, 0x24, 0xe6, 0x51, 0x05, 0xa6, 0x6c, 0x72, 0xd6, 0xcc, 0xd0, 0x2b, 0x32, 0x87, 0x8f, 0x00,
+	0x71, 0xc6, 0x75, 0x6a, 0x8e, 0xe1, 0x6c, 0x96, 0x26, 0x53, 0xee, 0x62, 0x99, 0xb0, 0x05, 0xcb,
+	0x9c, 0xcf, 0x23, 0x8f, 0xb8, 0x71, 0x25, 0x2f, 0x3f, 0xe0, 0x5e, 0x44, 0xa5, 0xa7, 0xb0, 0x18,
+	0xb2, 0x8a, 0xa8, 0x75, 0x39, 0x9f, 0x40, 0xf1, 0x5c, 0x8a, 0xe2, 0xb8, 0x06, 0xd3, 0x51, 0x07,
+	0xcf, 0x47, 0xec, 0x24, 0xed, 0xa3, 0xc7, 0x63, 0x4a, 0x89, 0xa5, 0x3f, 0xd5, 0xa0, 0x28, 0x69,
+	0x88, 0x60, 0xba, 0x09, 0xd3, 0x2e, 0x39, 0x72, 0x89, 0x77, 0xdc, 0xf4, 0x9d, 0x13, 0x62, 0x0b,
+	0x62, 0x51, 0x20, 0xc2, 0x30, 0x65, 0xb6, 0x5a, 0xc4, 0xf3, 0x04, 0x12, 0x27, 0x1c, 0x81, 0xa1,
+	0xff, 0x07, 0x13, 0xe4, 0x79, 0xdf, 0x72, 0x49, 0xd3, 0xf4, 0xd9, 0x46, 0x9d, 0xdc, 0xd4, 0xef,
+	0xf0, 0xdc, 0xf3, 0x8e, 0xcc, 0x4e, 0xef, 0x1c, 0xc8, 0x04, 0xd8, 0x08, 0x91, 0x69, 0x88, 0xdf,
+	0x27, 0x3e, 0x35, 0x98, 0x54, 0x2d, 0x1e, 0xe2, 0xaf, 0xc1, 0x4c, 0x80, 0x21, 0x04, 0xbf, 0x06,
+	0x23, 0xd4, 0x6e, 0xe2, 0x30, 0xe3, 0xb7, 0x0e, 0x43, 0x60, 0x60, 0xfc, 0x0c, 0x4a, 0x74, 0xef,
+	0x53, 0xc8, 0x7f, 0xe1, 0xec, 0x99, 0x87, 0xd1, 0xcf, 0x06, 0xc4, 0x3d, 0x17, 0x07, 0x0f, 0x1f,
+	0xd0, 0x7b, 0x4b, 0xa1, 0xfd, 0x9d, 0x4e, 0x94, 0x55, 0x18, 0xa5, 0x62, 0xca, 0x03, 0x45, 0x11,
+	0x9f, 0xc3, 0xb1, 0x0b, 0xcb, 0x01, 0x8f, 0x6d, 0xe2, 0x9b, 0x56, 0x97, 0xb4, 0xbf, 0xf3, 0xe9,
+	0x15, 0xe1, 0x35, 0xcb, 0x78, 0x49, 0x9a, 0x2a, 0xcf, 0x1b, 0xf2, 0x26, 0xba, 0xc8, 0x15, 0x3f,
+	0x84, 0x39, 0x83, 0xc7, 0xc6, 0x01, 0x75, 0xbb, 0x44, 0x1b, 0x2a, 0x8e, 0xf0, 0x9f, 0x6b, 0x30,
+	0x1f, 0x5d, 0xfd, 0xbf, 0x28, 0x0c, 0x7f, 0x07, 0xa6, 0x1f, 0x38, 0x1d, 0x67, 0xe0, 0x4b, 0x9d,
+	0x5e, 0x4d, 0x15, 0x2a, 0xd8, 0x97, 0x31, 0xdd, 0x7e, 0x08, 0x57, 0xc5, 0xc2, 0xf0, 0x84, 0xf1,
+	0x88, 0x3f, 0xd4, 0xa9, 0x86, 0x9f, 0x52, 0xbb, 0x78, 0xc4, 0x8f, 0x1f, 0x4e, 0x2b, 0x30, 0x9a,
+	0xc6, 0x7a, 0x54, 0xda, 0xe3, 0xf2, 0xfb, 0x63, 0x13, 0xd0, 0x63, 0xfa, 0xa0, 0x39, 0x8f, 0x9f,
+	0xb1, 0xd9, 0x74, 0xf1, 0xdb, 0x6c, 0xbb, 0x7d, 0xf8, 0xe4, 0xa3, 0x46, 0xe0, 0xa0, 0x1b, 0x30,
+	0x72, 0x42, 0xce, 0xe5, 0xe5, 0xcf, 0x2f, 0xc0, 0x0f, 0x1b, 0x7b, 0x0f, 0x9f, 0x90, 0xc3, 0x8f,
+	0xc8, 0xb9, 0xc1, 0x26, 0xf1, 0xbf, 0x69, 0xb0, 0xc0, 0xb3, 0xad, 0x03, 0xd7, 0x6c, 0x9d, 0x58,
+	0x76, 0x47, 0xf2, 0x43, 0x30, 0x42, 0xd3, 0x2b, 0xe1, 0x56, 0xf6, 0x8d, 0x5e, 0x87, 0x11, 0xfa,
+	0x1e, 0x66, 0x92, 0x4f, 0x6e, 0x2e, 0x27, 0x9c, 0xb4, 0x2d, 0xde, 0xc2, 0x46, 0x41, 0xbe, 0x8a,
+	0xd1, 0x6d, 0x28, 0xb4, 0x2d, 0xcf, 0x37, 0xed, 0x16, 0x7f, 0x00, 0xe6, 0xee, 0x4d, 0x7f, 0xfb,
+	0xcd, 0xea, 0xc4, 0xce, 0x15, 0xf1, 0xcf, 0x08, 0xa6, 0xd1, 0xeb, 0x50, 0xe8, 0x3a, 0x2d, 0xb6,
+	0x8c, 0x65, 0x06, 0x93, 0x9b, 0xd3, 0x4c, 0xe8, 0x07, 0x02, 0xc8, 0x75, 0x5e, 0xd3, 0x8c, 0x00,
+	0x0d, 0xbd, 0x4c, 0x33, 0x9c, 0x81, 0x4f, 0xca, 0xa3, 0x8a, 0x92, 0x06, 0x85, 0xec, 0x3b, 0x96,
+	0xed, 0x1b, 0x7c, 0x16, 0xaf, 0xc3, 0x62, 0x5c, 0xc9, 0x8c, 0x9b, 0xf9, 0x1e, 0x2c, 0xee, 0xf4,
+	0xfa, 0x8e, 0xeb, 0x4b, 0x4c, 0x4f, 0xb1, 0xc7, 0x91, 0x25, 0x52, 0xb1, 0x29, 0x83, 0x7d, 0xd3,
+	0xdd, 0x4b, 0xff, 0xf2, 0xbc, 0x76, 0xca, 0xe0, 0x03, 0xfc, 0x0a, 0x2c, 0x25, 0x68, 0x08, 0x76,
+	0x25, 0xc8, 0x5b, 0x6d, 0xee, 0x92, 0x09, 0x83, 0x7e, 0xe2, 0x5b, 0xb0, 0xc0, 0x77, 0x70, 0xdc,
+	0xfe, 0x71, 0xc9, 0xfe, 0x5a, 0x83, 0x05, 0x9e, 0xf1, 0xc6, 0x31, 0xb3, 0xb2, 0xde, 0x77, 0xa0,
+	0xe0, 0x0b, 0x54, 0xe1, 0x31, 0x9e, 0x01, 0xca, 0xf5, 0x9c, 0x5a, 0x68, 0x55, 0x89, 0x8c, 0xfe,
+	0x3f, 0x4c, 0x0e, 0xd8, 0x1c, 0x2b, 0x5b, 0x64, 0x6e, 0x49, 0xf6, 0x3a, 0xdf, 0x35, 0xbd, 0x13,
+	0x43, 0x45, 0xc7, 0x3f, 0xd7, 0x00, 0xdd, 0x27, 0xfe, 0x25, 0xfa, 0xd0, 0xf3, 0xc3, 0xb2, 0x5b,
+	0xdd, 0x41, 0x9b, 0x34, 0xb9, 0x0b, 0x73, 0xec, 0x49, 0x1f, 0x05, 0xa2, 0x77, 0x60, 0x86, 0x7d,
+	0x34, 0x7d, 0xa7, 0x4b, 0xdc, 0x20, 0x8a, 0xb4, 0x78, 0x14, 0xc5, 0xb1, 0xf0, 0x7b, 0x30, 0x17,
+	0x11, 0x22, 0x48, 0x89, 0x43, 0x9b, 0x68, 0x4a, 0x8c, 0x05, 0x88, 0xc1, 0x34, 0x7e, 0x0e, 0xf3,
+	0xf4, 0x3c, 0x4f, 0x04, 0xc2, 0xf7, 0x74, 0x27, 0x51, 0x3a, 0x3e, 0x31, 0x7b, 0x61, 0x1e, 0x2c,
+	0x87, 0xf8, 0x33, 0x58, 0x88, 0x71, 0xfe, 0x4e, 0xb7, 0x48, 0x05, 0x26, 0xa4, 0x32, 0xf2, 0x26,
+	0xc9, 0x54, 0xf6, 0x0c, 0x16, 0xeb, 0xcf, 0x53, 0xe3, 0xfe, 0x36, 0x8c, 0x1d, 0x39, 0x6e, 0xcf,
+	0xf4, 0x19, 0xcf, 0xa2, 0xb8, 0x8c, 0x38, 0xf2, 0xfb, 0x6c, 0xc2, 0x10, 0x08, 0xa1, 0x9e, 0x39,
+	0x55, 0xcf, 0x15, 0x98, 0x30, 0xbb, 0xdd, 0xa6, 0xbc, 0xd0, 0xa8, 0x93, 0x43, 0x00, 0xde, 0x80,
+	0xa5, 0x04, 0x63, 0xa1, 0x2d, 0x3f, 0x81, 0x4c, 0xb9, 0xe3, 0xe8, 0x37, 0xfe, 0x63, 0x0d, 0x16,
+	0xef, 0x99, 0xad, 0x93, 0x23, 0xab, 0xdb, 0x7d, 0x42, 0x4c, 0xff, 0x38, 0xbc, 0xf6, 0x02, 0xee,
+	0x9a, 0xca, 0xbd, 0x02, 0x23, 0xae, 0x29, 0xa2, 0x4b, 0xbb, 0xb7, 0xf8, 0xed, 0x37, 0xab, 0x28,
+	0x88, 0x9a, 0x8f, 0xf9, 0x9f, 0x9d, 0xf7, 0x0c, 0x86, 0x83, 0xde, 0x80, 0xc9, 0x96, 0x63, 0xf3,
+	0x5c, 0xb4, 0xc5, 0xbd, 0x35, 0x7a, 0x6f, 0xf6, 0xdb, 0x6f, 0x56, 0xa7, 0x4b, 0xbf, 0x95, 0xff,
+	0xb4, 0xf2, 0xac, 0xa1, 0x62, 0xe1, 0x5f, 0x6a, 0xb0, 0x94, 0x90, 0x48, 0x68, 0x10, 0xf8, 0x45,
+	0x53, 0xfd, 0x42, 0xf5, 0x72, 0x6c, 0x22, 0x9c, 0xc5, 0xbe, 0xa9, 0x67, 0x8f, 0xd8, 0xed, 0xce,
+	0xb8, 0xe6, 0x0d, 0x31, 0x42, 0x6b, 0x30, 0x29, 0x7d, 0x14, 0x06, 0x8a, 0x0a, 0xa2, 0x3c, 0x88,
+	0xeb, 0x3a, 0x6e, 0x79, 0x54, 0xe4, 0x94, 0x74, 0x80, 0xff, 0x41, 0x83, 0x69, 0x83, 0x50, 0xbb,
+	0x86, 0xf7, 0xc7, 0xc4, 0x91, 0xeb, 0xf4, 0x9a, 0xca, 0xa1, 0x1e, 0x02, 0xd0, 0x5b, 0x10, 0x1c,
+	0xd9, 0x2f, 0x72, 0xba, 0x6f, 0xc2, 0x64, 0xc7, 0x35, 0xed, 0x41, 0xd7, 0x74, 0x2d, 0xff, 0x5c,
+	0xd4, 0x77, 0x4a, 0x2c, 0x42, 0xee, 0x87, 0x70, 0x43, 0x45, 0x52, 0x5f, 0x39, 0x23, 0x91, 0x57,
+	0x0e, 0x7e, 0x0b, 0x96, 0xef, 0x13, 0x7f, 0x9f, 0xb8, 0x9e, 0x63, 0x9b, 0x5d, 0x83, 0xb4, 0x1c,
+	0xb7, 0xed, 0x5d, 0xfa, 0x38, 0xc2, 0x3f, 0x83, 0xa2, 0x54, 0x35, 0xcc, 0x4d, 0xcc, 0x53, 0xe2,
+	0x9a, 0x1d, 0xd2, 0xf4, 0xfa, 0x84, 0xf0, 0x15, 0x39, 0x23, 0x0a, 0xa4, 0x79, 0x77, 0x70, 0x35,
+	0xe5, 0x18, 0x42, 0x30, 0x46, 0xaf, 0xc0, 0xf8, 0xe1, 0xa0, 0x75, 0x42, 0xfc, 0x68, 0x0e, 0xc6,
+	0xf9, 0xdc, 0x63, 0x33, 0x86, 0xc4, 0xc0, 0xbf, 0xca, 0x83, 0x9e, 0x26, 0xb8, 0x90, 0xe6, 0x1d,
+	0x98, 0x3a, 0x32, 0x3d, 0x9f, 0x78, 0xf4, 0x7d, 0xd3, 0x8a, 0x3e, 0xe6, 0xc3, 0x03, 0x8a, 0xae,
+	0x31, 0x22, 0x88, 0xe8, 0x5d, 0x28, 0x75, 0x1d, 0xbb, 0x43, 0xc7, 0x11, 0x41, 0x33, 0x16, 0x27,
+	0x90, 0x23, 0x04, 0xa4, 0x77, 0xf3, 0xc3, 0x10, 0x90, 0xfe, 0x7d, 0x0b, 0xa6, 0x0e, 0xad, 0x0e,
+	0x83, 0x9d, 0x11, 0x72, 0x22, 0xae, 0xe5, 0x59, 0x59, 0x1d, 0xb1, 0x9c, 0xb6, 0x14, 0x5c, 0x45,
+	0x43, 0xef, 0xc0, 0xb4, 0x1c, 0xf7, 0x1c, 0xdb, 0x3f, 0x2e, 0x8f, 0x66, 0xad, 0x8b, 0xe2, 0xa1,
+	0x37, 0xa0, 0x28, 0x9f, 0x82, 0x9e, 0xef, 0x12, 0xf3, 0xa4, 0x3c, 0xc6, 0x56, 0xf2, 0x92, 0x61,
+	0x83, 0x81, 0x8c, 0x18, 0x0a, 0x5d, 0x24, 0x05, 0x17, 0x8b, 0xc6, 0x53, 0x16, 0x45, 0x51, 0x70,
+	0x55, 0x56, 0xb2, 0x0e, 0x88, 0xd9, 0x1b, 0xa2, 0x92, 0x15, 0x96, 0xa5, 0xf8, 0x82, 0x8c, 0xfc,
+	0x81, 0x57, 0x7b, 0x54, 0x9a, 0x59, 0xd5, 0x1e, 0xfe, 0x40, 0x8a, 0x10, 0xbb, 0x06, 0x23, 0xf4,
+	0x0a, 0x88, 0x3c, 0x90, 0x18, 0x02, 0x03, 0xe3, 0x37, 0xf9, 0x23, 0x86, 0x42, 0xc2, 0xe0, 0x5a,
+	0x85, 0x51, 0x3a, 0x29, 0xd3, 0x3c, 0x65, 0x11, 0x87, 0x87, 0xc5, 0xaa, 0x61, 0x84, 0x7a, 0x0e,
+	0x73, 0x3b, 0xf6, 0xa9, 0xe5, 0x93, 0x03, 0x47, 0x45, 0x5f, 0x0b, 0xaf, 0xaa, 0xd8, 0x43, 0x58,
+	0x80, 0x2f, 0x7e, 0x08, 0xa3, 0x97, 0x44, 0x19, 0x8b, 0x9f, 0x0f, 0xd3, 0xa1, 0x8c, 0x41, 0xcd,
+	0x11, 0xbf, 0x0b, 0x57, 0x6b, 0xad, 0x16, 0xe9, 0x33, 0xf5, 0x98, 0x0c, 0xfc, 0xac, 0x19, 0x56,
+	0x02, 0xfc, 0x26, 0x94, 0x1e, 0x10, 0xf3, 0x94, 0xbc, 0x90, 0xdc, 0xf8, 0x00, 0x46, 0xe8, 0xd3,
+	0x29, 0x91, 0x9d, 0xcc, 0x47, 0xf4, 0x91, 0x7a, 0xfc, 0x00, 0x8a, 0xec, 0xa3, 0x19, 0xfc, 0x0e,
+	0xc1, 0xef, 0xb3, 0x18, 0x14, 0x3b, 0x00, 0x61, 0xed, 0x50, 0xf9, 0x95, 0x44, 0x1b, 0xe2, 0x57,
+	0x92, 0x5c, 0xd6, 0xaf, 0x24, 0xb2, 0x32, 0x9e, 0x4f, 0xa9, 0x8c, 0xff, 0x9d, 0x06, 0x73, 0x29,
+	0x95, 0x69, 0x54, 0x05, 0x08, 0x0b, 0x97, 0x22, 0xae, 0x12, 0xb5, 0x4d, 0x05, 0x05, 0x6d, 0xc0,
+	0x98, 0xe7, 0x0c, 0xdc, 0x96, 0x94, 0x63, 0x21, 0x86, 0xdc, 0x60, 0x93, 0x86, 0x40, 0xca, 0xae,
+	0x14, 0xd3, 0xeb, 0x86, 0x7d, 0xb2, 0xfd, 0x24, 0xea, 0x7c, 0x01, 0x00, 0xff, 0x4a, 0x83, 0x11,
+	0xea, 0xa8, 0x84, 0xdd, 0x91, 0x5a, 0xa9, 0x15, 0x15, 0x5a, 0x1d, 0x0a, 0xce, 0x99, 0x4d, 0xd4,
+	0x72, 0xa2, 0x1c, 0x53, 0x01, 0x5a, 0x8e, 0xd9, 0x3a, 0x26, 0x5e, 0x79, 0x84, 0x25, 0xd5, 0x72,
+	0x48, 0x67, 0x7a, 0xa4, 0x77, 0x48, 0x93, 0x8e, 0x51, 0x3e, 0x23, 0x86, 0xe8, 0x2d, 0x98, 0xb4,
+	0x82, 0x00, 0xf3, 0xca, 0x63, 0x4a, 0x71, 0x34, 0x16, 0x7c, 0x2a, 0x1e, 0xde, 0x85, 0x62, 0x74,
+	0xfa, 0x82, 0x7a, 0xdd, 0x4b, 0x91, 0x02, 0x7b, 0x6a, 0xc0, 0xff, 0x55, 0x0e, 0x20, 0xac, 0x47,
+	0x26, 0x0c, 0xa1, 0xd6, 0x50, 0x73, 0xb1, 0x1a, 0xea, 0x62, 0xe4, 0x07, 0x95, 0x89, 0x20, 0x86,
+	0xd6, 0x61, 0x8c, 0x97, 0x52, 0xc5, 0x39, 0x5d, 0x0a, 0x8b, 0x9e, 0x07, 0x0c, 0x6e, 0x88, 0x79,
+	0x4a, 0xe1, 0x90, 0x1c, 0x39, 0x2e, 0x11, 0x59, 0x83, 0x18, 0xd1, 0xb0, 0x37, 0x8f, 0x7c, 0xe2,
+	0xb2, 0x63, 0x77, 0xc2, 0xe0, 0x03, 0x74, 0x1d, 0xc0, 0xe5, 0x3b, 0x8a, 0x4a, 0x33, 0xce, 0xa6,
+	0x14, 0x08, 0xf5, 0x75, 0xab, 0x6b, 0xd1, 0x13, 0xd9, 0xea, 0x97, 0x0b, 0xdc, 0xd7, 0x01, 0x80,
+	0xae, 0x6e, 0xb1, 0x83, 0xb3, 0x4d, 0xdf, 0xf7, 0x13, 0x7c, 0x75, 0x08, 0xe1, 0xf9, 0x34, 0x35,
+	0x5b, 0xbf, 0x0c, 0xdc, 0x8a, 0x62, 0x88, 0xdf, 0x81, 0x49, 0x45, 0x78, 0x2a, 0x1c, 0xdf, 0x22,
+	0x22, 0xc1, 0x63, 0x03, 0x1a, 0x31, 0x6c, 0x5b, 0x88, 0x88, 0x61, 0xdb, 0xe1, 0xe7, 0x1a, 0x14,
+	0xa3, 0x75, 0xee, 0xa1, 0x02, 0xed, 0xc5, 0x7f, 0x0a, 0xa0, 0x6e, 0x3a, 0x1c, 0x58, 0x5d, 0xbf,
+	0x69, 0xf1, 0x37, 0x6b, 0xc1, 0x08, 0xc6, 0xf8, 0x2b, 0x0d, 0x20, 0x7c, 0x70, 0xd3, 0xb7, 0xdf,
+	0x89, 0x2f, 0xb3, 0x53, 0xfa, 0xc9, 0x20, 0x81, 0x7b, 0xe9, 0x27, 0x85, 0x0c, 0x3c, 0x22, 0xdc,
+	0x4a, 0x3f, 0x29, 0xc4, 0xec, 0x76, 0xc4, 0x0e, 0xa2, 0x9f, 0x14, 0xd2, 0x72, 0x4f, 0x85, 0xe3,
+	0xe8, 0x27, 0x9a, 0x02, 0xed, 0xb9, 0xf0, 0x98, 0xf6, 0x9c, 0x8e, 0xce, 0x85, 0x93, 0xb4, 0x73,
+	0x5a, 0x40, 0x9c, 0x52, 0x2b, 0x47, 0x43, 0x9e, 0x74, 0xf3, 0xf2, 0xa7, 0x83, 0x3c, 0xdb, 0x3b,
+	0x7c, 0x40, 0xb3, 0x51, 0xd5, 0x40, 0x7c, 0xc7, 0x45, 0xec, 0x91, 0x3c, 0x21, 0x47, 0x53, 0x4f,
+	0xc8, 0x3f, 0xca, 0x41, 0x41, 0x66, 0x1f, 0x09, 0x91, 0x94, 0x7d, 0x95, 0x8b, 0xee, 0x2b, 0x59,
+	0x94, 0xc8, 0x2b, 0x45, 0x89, 0x0d, 0x51, 0x94, 0x18, 0xb9, 0x2c, 0x6d, 0x65, 0x68, 0x91, 0xac,
+	0x6f, 0x34, 0x96, 0xf5, 0xdd, 0x56, 0x2a, 0x10, 0x63, 0x29, 0x15, 0x08, 0xa5, 0xf2, 0xf0, 0x03,
+	0x18, 0x3f, 0xe3, 0xd9, 0xbe, 0xc8, 0x36, 0xa6, 0x18, 0xa6, 0x7c, 0x01, 0xc8, 0xc9, 0xb0, 0x42,
+	0x51, 0xb8, 0xb0, 0x42, 0xf1, 0x37, 0x1a, 0x14, 0xa3, 0xef, 0xf2, 0xd4, 0x02, 0xcc, 0xc6, 0xb0,
+	0x05, 0x18, 0xae, 0xeb, 0x0b, 0x14, 0x5f, 0x6e, 0x5f, 0x52, 0x7c, 0x09, 0x55, 0xc7, 0xff, 0xaa,
+	0xc1, 0x94, 0x9a, 0x08, 0xd3, 0x1d, 0xee, 0xf9, 0xa6, 0xeb, 0xab, 0x6f, 0x0b, 0x05, 0x42, 0x83,
+	0xa9, 0xe5, 0x0c, 0x6c, 0x5f, 0x3e, 0x4f, 0xd9, 0x00, 0xe9, 0x71, 0xe1, 0x14, 0x69, 0x5e, 0xd0,
+	0xa7, 0x89, 0x7c, 0x7f, 0x34, 0x2d, 0xdf, 0x5f, 0x83, 0x49, 0x99, 0x04, 0xba, 0x03, 0xee, 0xe0,
+	0x9c, 0xa1, 0x82, 0xf0, 0x6f, 0x14, 0x2f, 0xf0, 0x04, 0x35, 0xfe, 0x00, 0xd3, 0x92, 0x0f, 0x30,
+	0xe9, 0xa7, 0x9c, 0xe2, 0xa7, 0xef, 0x51, 0xb7, 0x0d, 0x18, 0x61, 0xaf, 0x86, 0xd1, 0x4b, 0xd1,
+	0x29, 0x1a, 0xfd, 0x21, 0x6f, 0x4a, 0xcd, 0xb0, 0xff, 0x1b, 0x9c, 0x83, 0x61, 0x4a, 0xd1, 0x5f,
+	0x1e, 0x03, 0x11, 0x18, 0x7e, 0x0a, 0x63, 0x3c, 0xf1, 0xe6, 0xe6, 0x61, 0x65, 0x48, 0xfe, 0xda,
+	0x35, 0xcf, 0xbd, 0x98, 0x4c, 0xb9, 0x84, 0x4c, 0x3a, 0x14, 0x88, 0xdd, 0x6e, 0x2a, 0x5b, 0x3d,
+	0x18, 0x63, 0x1f, 0x0a, 0x32, 0x26, 0xd1, 0x9b, 0x30, 0x41, 0xdd, 0x67, 0xf9, 0x83, 0x36, 0x57,
+	0x2d, 0xf2, 0xc2, 0xff, 0xf2, 0xe8, 0x37, 0x1f, 0x8b, 0x8f, 0xf7, 0x8c, 0x10, 0x11, 0x6d, 0x42,
+	0xa1, 0x6b, 0xfa, 0x7c, 0x51, 0xa2, 0x2c, 0xf0, 0xe5, 0x63, 0xb9, 0xe8, 0xf1, 0x7b, 0x46, 0x80,
+	0x87, 0xff, 0x49, 0x03, 0x08, 0x77, 0x6d, 0x84, 0x84, 0x36, 0x1c, 0x89, 0xa8, 0xb0, 0xb9, 0x61,
+	0x85, 0x5d, 0x81, 0x09, 0xd2, 0x25, 0xa7, 0xe1, 0xdb, 0x4d, 0x33, 0x42, 0x00, 0x7a, 0x3b, 0x12,
+	0x4b, 0x17, 0x54, 0xcd, 0x83, 0x2a, 0x1f, 0xc3, 0xc7, 0xff, 0xa1, 0xc1, 0xb8, 0x38, 0xaa, 0x58,
+	0x84, 0x93, 0x5e, 0x9f, 0xb8, 0xa6, 0x3f, 0x70, 0x89, 0x78, 0x2a, 0xab, 0x20, 0xb4, 0x0e, 0x33,
+	0xca, 0xb0, 0xd9, 0xb3, 0x6c, 0xf1, 0x5e, 0x8e, 0x83, 0x13, 0x98, 0xe6, 0x73, 0x11, 0x3d, 0x71,
+	0x30, 0xd5, 0xcb, 0xb3, 0x9d, 0xb3, 0x36, 0xe9, 0xfb, 0xc7, 0x4c, 0xfc, 0x9c, 0x11, 0x02, 0xe8,
+	0x86, 0x3e, 0xb3, 0xec, 0x76, 0xdb, 0x72, 0x09, 0x4f, 0x74, 0xc4, 0x86, 0x8e, 0x00, 0x29, 0x0d,
+	0x0a, 0xe0, 0x5b, 0x9e, 0x6f, 0xe7, 0x10, 0xc0, 0x7e, 0x56, 0x73, 0x89, 0xe7, 0x51, 0xa5, 0xc6,
+	0x79, 0x08, 0xcb, 0x71, 0x65, 0x17, 0x46, 0x68, 0x7e, 0x80, 0xe6, 0xa1, 0x64, 0xec, 0x3d, 0xa8,
+	0x37, 0x1f, 0x3d, 0x6c, 0xec, 0xd7, 0xb7, 0x76, 0xde, 0xdf, 0xa9, 0x6f, 0x97, 0xae, 0xa0, 0x22,
+	0x00, 0x83, 0xd6, 0xb6, 0x77, 0x77, 0x1e, 0x96, 0x34, 0x54, 0x82, 0x29, 0x36, 0xde, 0xad, 0x3d,
+	0xac, 0xdd, 0xaf, 0x1b, 0xa5, 0x1c, 0x9a, 0x86, 0x09, 0xbe, 0xae, 0x51, 0x37, 0x4a, 0xf9, 0xca,
+	0xd7, 0x1a, 0x4c, 0x2a, 0xf5, 0x0e, 0x74, 0x15, 0x96, 0xee, 0x1b, 0xb5, 0x87, 0x8f, 0x1e, 0xd4,
+	0x8c, 0x9d, 0x83, 0x4f, 0x62, 0xd4, 0xe7, 0x60, 0x46, 0x9d, 0xdc, 0xae, 0x7d, 0x52, 0xd2, 0xa8,
+	0x20, 0x2a, 0xf0, 0x49, 0xbd, 0xfe, 0x51, 0x29, 0x87, 0x16, 0x60, 0x56, 0x85, 0xee, 0xee, 0x3d,
+	0x3c, 0xf8, 0xa0, 0x94, 0x47, 0x65, 0x98, 0x57, 0xc1, 0x3b, 0x8d, 0x3d, 0xbe, 0x60, 0xa4, 0xf2,
+	0x18, 0xa6, 0xd4, 0xca, 0x1c, 0x25, 0x50, 0x7f, 0xba, 0xbf, 0x67, 0x1c, 0x34, 0xdf, 0xdf, 0x33,
+	0x76, 0x6b, 0x07, 0xcd, 0xad, 0xc6, 0xe3, 0xd2, 0x15, 0x4a, 0x20, 0x0a, 0x7e, 0xb8, 0x4d, 0x13,
+	0x95, 0x92, 0x96, 0x5c, 0x70, 0x7f, 0xff, 0x69, 0x29, 0x57, 0xf9, 0x11, 0x8c, 0xb2, 0xb7, 0x08,
+	0x9d, 0x6f, 0x6c, 0xed, 0xed, 0xc7, 0x2d, 0x36, 0x03, 0x93, 0x02, 0xdc, 0xa8, 0x1b, 0x8d, 0x92,
+	0x46, 0x95, 0xe4, 0x80, 0x03, 0xa3, 0xb6, 0xf5, 0xd1, 0xce, 0xc3, 0xfb, 0x0d, 0xae, 0x0e, 0x07,
+	0xee, 0xd7, 0x8d, 0xdd, 0x9d, 0x46, 0x63, 0x67, 0xef, 0x61, 0xa3, 0x94, 0xaf, 0xec, 0x43, 0x41,
+	0xe6, 0xc6, 0x68, 0x19, 0x16, 0x0e, 0xea, 0xb5, 0xdd, 0x66, 0x8a, 0x57, 0xe6, 0x60, 0x26, 0x9c,
+	0xda, 0xda, 0xab, 0x6d, 0x7d, 0xc0, 0xed, 0x16, 0x02, 0x77, 0xeb, 0xbb, 0xf7, 0xa8, 0x7b, 0x2a,
+	0x5d, 0x28, 0xc5, 0x9f, 0x2c, 0xe8, 0x25, 0xb8, 0x16, 0xb2, 0x6d, 0x36, 0xf6, 0x1e, 0x19, 0x5b,
+	0x71, 0x0e, 0x3a, 0x2c, 0x26, 0x51, 0x28, 0xe5, 0x92, 0x46, 0x05, 0x4b, 0xce, 0xd5, 0xb6, 0x1e,
+	0x94, 0x72, 0x95, 0x27, 0x30, 0xc6, 0x1f, 0x73, 0x68, 0x11, 0x50, 0x6d, 0xeb, 0x80, 0x22, 0x24,
+	0xcc, 0x23, 0xe0, 0x46, 0xbd, 0xb6, 0x5d, 0xd2, 0xd0, 0x2c, 0x4c, 0x4b, 0xc4, 0xfd, 0xed, 0xda,
+	0x41, 0xbd, 0x94, 0x53, 0x40, 0xdb, 0xf5, 0x07, 0xf5, 0x83, 0x7a, 0x29, 0xbf, 0xf9, 0xdb, 0x0a,
+	0x40, 0x6d, 0x7f, 0xa7, 0x41, 0xdc, 0x53, 0xab, 0x45, 0xd0, 0x07, 0x30, 0xa9, 0x34, 0xa1, 0xa1,
+	0x25, 0x76, 0x3f, 0x27, 0xdb, 0xdd, 0xf4, 0x72, 0x72, 0x82, 0x17, 0x0b, 0xf0, 0xd8, 0x57, 0xbf,
+	0x2e, 0xe7, 0x0a, 0x1a, 0x72, 0x60, 0x3a, 0xd2, 0xa6, 0x86, 0x96, 0xf9, 0x4b, 0x21, 0xa5, 0x75,
+	0x4d, 0x5f, 0x4c, 0x1c, 0x2a, 0x75, 0xda, 0x2f, 0x89, 0x5f, 0xf9, 0xea, 0xd7, 0xe5, 0x91, 0x52,
+	0xae, 0x9c, 0xff, 0xea, 0x5f, 0xfe, 0xfd, 0x97, 0xb9, 0x6b, 0x7a, 0x99, 0xb5, 0x2b, 0x9e, 0xbe,
+	0x5e, 0xa5, 0xb9, 0x5a, 0x55, 0xc9, 0x09, 0xef, 0x6a, 0x15, 0xf4, 0x53, 0x28, 0xc5, 0x3b, 0xcd,
+	0xd0, 0x8a, 0xa8, 0xa8, 0xa5, 0x36, 0xa0, 0x65, 0xb2, 0xdd, 0x8c, 0xb0, 0xbd, 0x59, 0xc1, 0x11,
+	0xb6, 0x3f, 0x11, 0x89, 0xe2, 0x17, 0xaa, 0x00, 0xe8, 0x2f, 0x34, 0x28, 0x67, 0xf5, 0x7c, 0xa1,
+	0x9b, 0x3c, 0xcd, 0xb9, 0xb8, 0xaf, 0x4c, 0x7f, 0xf9, 0x12, 0x2c, 0x61, 0x60, 0x2e, 0x9d, 0x26,
+	0xa5, 0x43, 0xc3, 0x48, 0xf7, 0x67, 0x1a, 0x8c, 0x8b, 0x8e, 0x31, 0x34, 0x27, 0xfd, 0xa0, 0xf4,
+	0x0b, 0x65, 0x9a, 0xe2, 0xc7, 0x11, 0x53, 0xec, 0xeb, 0x37, 0x32, 0x98, 0xb1, 0xdc, 0xbd, 0xfa,
+	0x13, 0xfa, 0xe7, 0x8b, 0x67, 0xb7, 0xf5, 0x5b, 0x12, 0x4d, 0x81, 0x33, 0x3c, 0xba, 0xc0, 0x0b,
+	0xd7, 0xa1, 0xbf, 0xa4, 0x77, 0x5e, 0xd0, 0x5d, 0x86, 0x16, 0x15, 0x7f, 0x7d, 0x07, 0xf1, 0x2a,
+	0xc3, 0x89, 0x57, 0x19, 0x5a, 0xbc, 0x26, 0x40, 0xd8, 0x27, 0x26, 0xa4, 0x4b, 0x34, 0xa7, 0xe9,
+	0x4b, 0x09, 0xb8, 0x70, 0xd5, 0x5a, 0x44, 0x3c, 0x84, 0xa7, 0x23, 0x7c, 0x69, 0xd0, 0xb6, 0x00,
+	0xc2, 0x16, 0x33, 0xc1, 0x20, 0xd1, 0x73, 0x96, 0xa9, 0xfe, 0xcb, 0x11, 0xfa, 0x4b, 0x3a, 0x8a,
+	0xe9, 0x65, 0xb5, 0xbf, 0xa0, 0x4c, 0xfe, 0x00, 0x20, 0xec, 0x25, 0x13, 0x4c, 0x12, 0xcd, 0x65,
+	0x99, 0x4c, 0x30, 0x63, 0x92, 0x17, 0x4c, 0xe6, 0x2b, 0x29, 0x4c, 0xd0, 0x53, 0x18, 0x17, 0x6d,
+	0x65, 0x22, 0xbc, 0xa2, 0xed, 0x68, 0xfa, 0x7c, 0x14, 0x28, 0xcc, 0x83, 0x23, 0x91, 0x3c, 0x8f,
+	0xd2, 0x28, 0x3f, 0x83, 0x89, 0xa0, 0xe9, 0x0c, 0x65, 0x88, 0xa8, 0x2f, 0x06, 0x3b, 0x27, 0xd2,
+	0x9c, 0x86, 0xaf, 0x45, 0x18, 0xcc, 0xa0, 0xa8, 0xfd, 0x91, 0x0b, 0x33, 0xb1, 0x4e, 0x30, 0x74,
+	0x35, 0xa0, 0x94, 0xec, 0x52, 0xd3, 0x57, 0xd2, 0x27, 0x05, 0xb3, 0x9b, 0x11, 0x66, 0x8b, 0x68,
+	0x5e, 0x32, 0x33, 0x29, 0x72, 0x95, 0xf7, 0x82, 0x21, 0x03, 0xc6, 0x78, 0xe3, 0x16, 0x42, 0xbc,
+	0xfc, 0xa6, 0x36, 0x86, 0xe9, 0x73, 0x11, 0x98, 0x20, 0xbc, 0xca, 0x4f, 0x54, 0x46, 0x76, 0x0e,
+	0x17, 0x25, 0x59, 0xcf, 0xea, 0xd8, 0x83, 0x3e, 0xf5, 0xaf, 0xa0, 0xb9, 0x63, 0x2b, 0x34, 0x77,
+	0xec, 0x24, 0xcd, 0x1d, 0x7b, 0x18, 0x9a, 0x96, 0x4d, 0x69, 0x1e, 0xc3, 0x94, 0xda, 0x3e, 0x86,
+	0xf8, 0x81, 0x9f, 0xd2, 0x51, 0x96, 0x19, 0x37, 0x3f, 0x10, 0xc1, 0xc9, 0x99, 0x94, 0xf1, 0x5c,
+	0x68, 0x0f, 0xff, 0xb8, 0x3a, 0x60, 0x94, 0x28, 0xa7, 0x06, 0x8b, 0x1d, 0x56, 0x37, 0xc8, 0xf2,
+	0x6f, 0x10, 0x3e, 0x6a, 0xaf, 0x0f, 0xd6, 0xa9, 0x0e, 0xeb, 0x9c, 0x7c, 0x11, 0x4d, 0xa9, 0x5b,
+	0x1f, 0x35, 0x61, 0x52, 0xa0, 0xdf, 0x3b, 0xdf, 0xd9, 0x0e, 0x83, 0x52, 0xe9, 0x61, 0xc9, 0xa0,
+	0x4a, 0xf7, 0x54, 0xa1, 0xa4, 0x95, 0x35, 0x9c, 0xb3, 0xda, 0xdc, 0x3e, 0x68, 0x36, 0x7a, 0xac,
+	0xd0, 0xb8, 0xfc, 0x84, 0xc7, 0x25, 0x5d, 0xea, 0xa1, 0x85, 0x20, 0x30, 0xd4, 0xce, 0x22, 0x7d,
+	0x31, 0x0e, 0x8e, 0x85, 0xa5, 0x16, 0x0f, 0x4b, 0x76, 0xfc, 0xa0, 0x03, 0xa5, 0x91, 0x48, 0x96,
+	0x55, 0xb2, 0x58, 0x5c, 0x8f, 0x82, 0xe3, 0x3d, 0x41, 0xb8, 0x20, 0x59, 0xa1, 0xa7, 0xf2, 0x10,
+	0xb8, 0xd0, 0xd2, 0x59, 0xce, 0x8c, 0xd8, 0xba, 0x12, 0xb5, 0x75, 0x07, 0x8a, 0x21, 0x65, 0x66,
+	0x6e, 0xf5, 0x88, 0x51, 0x2d, 0x7e, 0xd1, 0x39, 0x56, 0x28, 0xe5, 0x55, 0x9b, 0x57, 0x52, 0x6c,
+	0xde, 0x85, 0x62, 0xb4, 0x23, 0x11, 0xe9, 0xfc, 0xe4, 0x4d, 0x6b, 0x53, 0xbc, 0x30, 0x2e, 0xa5,
+	0x2a, 0xba, 0xbe, 0x10, 0x61, 0x23, 0xfb, 0x63, 0xf8, 0xd1, 0x3c, 0xa9, 0xb4, 0x21, 0xca, 0x54,
+	0x28, 0xd1, 0x98, 0x98, 0xc9, 0xe7, 0x86, 0xc2, 0x47, 0x39, 0x9a, 0x19, 0x1f, 0x56, 0xa9, 0xa2,
+	0x4c, 0xbe, 0xd4, 0x64, 0xb3, 0xa3, 0xda, 0x84, 0x88, 0xae, 0x2b, 0xcc, 0x52, 0xba, 0x13, 0x2f,
+	0xcd, 0x5c, 0x38, 0xd7, 0x9b, 0xfa, 0x6a, 0x56, 0x6e, 0xa0, 0xe8, 0x79, 0x06, 0x33, 0xb1, 0xde,
+	0x44, 0x71, 0x0a, 0xa6, 0x77, 0x2c, 0x66, 0xf2, 0xae, 0x46, 0x78, 0xbf, 0xa4, 0xaf, 0x64, 0xf0,
+	0x0e, 0x74, 0xff, 0x94, 0x96, 0x78, 0xc2, 0xae, 0x2f, 0x71, 0xc4, 0xa4, 0xb4, 0x91, 0xe9, 0xcb,
+	0x29, 0x33, 0xca, 0x76, 0x95, 0x07, 0xd9, 0x32, 0x9e, 0x8f, 0x9c, 0x31, 0xa2, 0x13, 0x8b, 0xf2,
+	0x7a, 0x06, 0x63, 0xbc, 0x8b, 0x4b, 0x1c, 0x91, 0x91, 0x96, 0xae, 0x4c, 0x95, 0x6e, 0x2a, 0x2e,
+	0x8c, 0x1f, 0x60, 0x5d, 0x46, 0x81, 0xd2, 0xee, 0xc1, 0x2c, 0x27, 0x57, 0xeb, 0x76, 0x1b, 0x44,
+	0x64, 0x5c, 0x2f, 0xba, 0xc1, 0xd6, 0x15, 0x56, 0x2b, 0x78, 0x29, 0x85, 0x55, 0xd5, 0xec, 0x32,
+	0xb3, 0x7d, 0xcd, 0xba, 0xe5, 0x92, 0x2d, 0x65, 0x68, 0x4d, 0x58, 0x29, 0xb3, 0xdb, 0x2c, 0x93,
+	0xf9, 0x9b, 0x8a, 0x11, 0xd7, 0xf1, 0x8d, 0x08, 0x73, 0x19, 0x2a, 0x55, 0x97, 0x52, 0xaa, 0x8a,
+	0x92, 0x3c, 0x15, 0xa4, 0x0f, 0xd3, 0x91, 0xee, 0x34, 0x24, 0xdd, 0x94, 0xec, 0x58, 0xcb, 0xe4,
+	0xfc, 0xaa, 0xc2, 0x79, 0x0d, 0x5f, 0xbd, 0x80, 0x33, 0x8f, 0x98, 0x49, 0xa5, 0x6b, 0x4d, 0x6c,
+	0xc9, 0x64, 0x1f, 0x5b, 0x26, 0xb7, 0x8a, 0xc2, 0xed, 0x3a, 0x5e, 0x8e, 0x70, 0x63, 0x61, 0x59,
+	0x65, 0xc5, 0xe3, 0x73, 0xae, 0x1d, 0xa2, 0x9a, 0xd8, 0x6d, 0x46, 0xdf, 0x12, 0xd5, 0xa0, 0x17,
+	0x75, 0x6b, 0x45, 0x71, 0x6b, 0x3a, 0x47, 0x97, 0x31, 0xa1, 0x1c, 0x7f, 0x1f, 0xc6, 0x45, 0x7f,
+	0xdd, 0xe5, 0x17, 0xa1, 0xda, 0x85, 0x87, 0x6f, 0x2a, 0x6a, 0x95, 0xd1, 0x62, 0xf5, 0xce, 0x19,
+	0xe9, 0x76, 0x37, 0x4e, 0x6c, 0xe7, 0xcc, 0xae, 0x7e, 0x7a, 0x76, 0xe2, 0xdd, 0xf9, 0xd4, 0x73,
+	0x6c, 0xf4, 0x29, 0x14, 0xa3, 0x0d, 0x6a, 0xf2, 0xf4, 0x4c, 0x6b, 0xcd, 0xd3, 0xaf, 0xa6, 0xce,
+	0x29, 0xd9, 0xc3, 0x15, 0xc6, 0x6e, 0x01, 0x97, 0xa4, 0x4e, 0xb2, 0x36, 0x47, 0x55, 0x19, 0xc0,
+	0x4c, 0xac, 0x3d, 0x4d, 0x9c, 0x29, 0xe9, 0x8d, 0x6f, 0xfa, 0x4a, 0xfa, 0xa4, 0x60, 0x77, 0x2b,
+	0x60, 0x77, 0x0d, 0x97, 0xe3, 0xec, 0xbc, 0xaa, 0xc5, 0x96, 0x51, 0xb6, 0x27, 0xec, 0xd6, 0x0f,
+	0xf4, 0x5b, 0x92, 0xd6, 0x8a, 0x2b, 0x57, 0x4e, 0x4e, 0x08, 0x56, 0xb7, 0xc5, 0xed, 0x9f, 0x0b,
+	0x6e, 0xa2, 0x25, 0xb4, 0x10, 0x67, 0x29, 0x6f, 0xa3, 0x68, 0xef, 0xd6, 0xfb, 0x8e, 0xcb, 0xae,
+	0xd6, 0xe5, 0xe0, 0x4a, 0x4e, 0xa8, 0xa9, 0xa7, 0x4d, 0x29, 0x49, 0xc1, 0x95, 0x78, 0xbe, 0x11,
+	0x28, 0x89, 0x7a, 0x30, 0x1d, 0x59, 0xf7, 0x5d, 0xd9, 0xbc, 0x2c, 0x12, 0x82, 0x5c, 0x96, 0x72,
+	0x1e, 0x3d, 0x67, 0xd0, 0x01, 0xcc, 0xd4, 0x9f, 0xa7, 0x39, 0xb0, 0xfe, 0xfc, 0x02, 0x07, 0x66,
+	0x74, 0x59, 0xe1, 0x11, 0xaa, 0xdb, 0x6b, 0x1a, 0x3a, 0x87, 0x62, 0xb4, 0xbd, 0x50, 0x84, 0x60,
+	0x6a, 0xcf, 0x61, 0xe6, 0x9e, 0x7a, 0x83, 0xf9, 0x28, 0xa7, 0xf8, 0xe8, 0xa5, 0xcd, 0x74, 0x1f,
+	0xdd, 0x0d, 0xfb, 0x0d, 0x7f, 0xa1, 0xc1, 0x4c, 0xac, 0x85, 0x4a, 0x68, 0x94, 0xde, 0xea, 0xa5,
+	0xaf, 0xa4, 0x4f, 0x0a, 0x8d, 0xde, 0x12, 0x97, 0x1d, 0x37, 0xe3, 0x2d, 0x8c, 0x93, 0x66, 0x14,
+	0xbf, 0xce, 0x54, 0x0f, 0x05, 0x89, 0xbb, 0x5a, 0xe5, 0x35, 0x5a, 0x16, 0x29, 0x46, 0x7b, 0x31,
+	0x85, 0x09, 0x52, 0x1b, 0x34, 0x33, 0x4d, 0x70, 0x5b, 0x24, 0x4c, 0x4a, 0x98, 0x56, 0x32, 0xc2,
+	0xf4, 0x09, 0x8c, 0xf1, 0x1f, 0x52, 0xc4, 0xcd, 0x17, 0xe9, 0xd8, 0xd2, 0xe7, 0x22, 0x30, 0xe5,
+	0x3c, 0xe1, 0xa1, 0xa8, 0xa3, 0x94, 0xfd, 0xe6, 0x72, 0x72, 0x3f, 0x05, 0x94, 0x6c, 0x48, 0x12,
+	0x99, 0x4b, 0x66, 0x8b, 0x95, 0xbe, 0x9a, 0x39, 0xaf, 0x04, 0x28, 0x67, 0x7e, 0x15, 0x2d, 0xa7,
+	0x31, 0xe7, 0x7c, 0x7e, 0x24, 0x5f, 0xe6, 0xec, 0x27, 0x7e, 0xf5, 0x65, 0xae, 0x34, 0x67, 0xe8,
+	0x4b, 0x09, 0xb8, 0xe0, 0xb2, 0x12, 0x70, 0x51, 0x5e, 0xe5, 0xac, 0x99, 0x85, 0x9e, 0x23, 0x07,
+	0xec, 0x24, 0x66, 0x94, 0x83, 0x97, 0x83, 0x4a, 0x76, 0x3e, 0x0a, 0x14, 0x34, 0xaf, 0x07, 0x34,
+	0x95, 0xa7, 0x2c, 0xa3, 0xc9, 0x3d, 0xf1, 0x98, 0x3f, 0x19, 0xe8, 0x9a, 0x61, 0x9e, 0xb2, 0x91,
+	0x1e, 0x1c, 0xbc, 0x1c, 0x10, 0x57, 0xde, 0x0b, 0x8c, 0x38, 0xfa, 0x3d, 0x99, 0xd9, 0x2b, 0xa6,
+	0x48, 0xb4, 0xe3, 0x64, 0x86, 0x92, 0x22, 0x75, 0x25, 0x4d, 0x6a, 0x1f, 0xa6, 0xd4, 0x76, 0x1d,
+	0x91, 0xa5, 0xa5, 0x74, 0xf0, 0x5c, 0x98, 0x94, 0x5e, 0x89, 0xe7, 0x16, 0x82, 0x83, 0xe8, 0x91,
+	0xf9, 0xa2, 0xaa, 0x74, 0x41, 0x50, 0x0f, 0xfc, 0x0c, 0xe6, 0xd3, 0x5a, 0x75, 0x44, 0x8e, 0x73,
+	0x41, 0x17, 0x4f, 0xa6, 0x14, 0x1b, 0x81, 0x14, 0x37, 0xf0, 0xf5, 0x2c, 0x29, 0x4c, 0x46, 0x98,
+	0xbf, 0x7f, 0x27, 0x82, 0x56, 0x1f, 0xf9, 0xf8, 0x8a, 0xb5, 0xfe, 0x5c, 0x98, 0xd4, 0x70, 0x56,
+	0x18, 0x5f, 0xcb, 0x62, 0xd5, 0xa5, 0xd4, 0xee, 0x6a, 0x95, 0xbb, 0x5b, 0x30, 0x42, 0xd3, 0x01,
+	0x74, 0x3d, 0x41, 0x6d, 0x97, 0xf8, 0xc7, 0x4e, 0x7b, 0x8f, 0xff, 0xbf, 0xeb, 0xf2, 0x3f, 0x7f,
+	0x9d, 0x57, 0x7e, 0x33, 0x95, 0xff, 0x6f, 0xda, 0x60, 0x8b, 0xef, 0xfd, 0x42, 0xfb, 0x93, 0xda,
+	0xe7, 0xe8, 0x2e, 0xcc, 0x7c, 0xe8, 0x74, 0x3a, 0x96, 0xdd, 0x59, 0x33, 0xfb, 0xfd, 0xb5, 0xda,
+	0xfe, 0x0e, 0x5e, 0x83, 0x49, 0x05, 0xa4, 0xcf, 0x1e, 0x3a, 0x4e, 0xfb, 0xfc, 0xd4, 0x79, 0xaf,
+	0x43, 0x93, 0x0f, 0xfa, 0x3f, 0xb6, 0x37, 0x47, 0x5f, 0xbb, 0xf3, 0xfa, 0x9d, 0xd7, 0x2a, 0x9a,
+	0xb6, 0x59, 0x32, 0xfb, 0xfd, 0xae, 0xc8, 0x74, 0xaa, 0x34, 0x67, 0x78, 0xb6, 0x02, 0x3a, 0xe4,
+	0x3f, 0x7c, 0x72, 0x80, 0xe6, 0x0a, 0x39, 0x7d, 0x9a, 0xf2, 0x74, 0x5c, 0xeb, 0x73, 0x86, 0xb0,
+	0x96, 0x3b, 0x9c, 0x80, 0x71, 0x3e, 0x7b, 0xe5, 0xd9, 0x58, 0xff, 0x90, 0x2a, 0x7a, 0x38, 0xc6,
+	0x84, 0x7f, 0xe3, 0x3f, 0x07, 0x00, 0xcb, 0xc6, 0x84, 0x20, 0x11, 0x3f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// APIServiceClient is the client API for APIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIServiceClient interface {
+	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
+	// Add permission
+	AddPermission(ctx context.Context, in *AddPermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Remove permission from the user ACL. Permissions granted by roles are
+	// removed with the role.
+	RemovePermission(ctx context.Context, in *RemovePermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List permissions of the user granted by its roles and ACL. Every
+	// permission is listed once per source.
+	ListEffectivePermissions(ctx context.Context, in *ListEffectivePermissionsRequest, opts ...grpc.CallOption) (*ListEffectivePermissionsResponse, error)
+	// Add role
+	AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Remove role
+	RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Create role with the set of permissions.
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	// Update role by id. Changes apply to all users with the role. Built-in
+	// roles couldn't be changed.
+	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete role by id. Users keep the reference, but it grants nothing.
+	// Built-in roles couldn't be deleted.
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Get role by id.
+	GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*GetRoleResponse, error)
+	// List all roles including built-in ones.
+	ListRoles(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListRolesResponse, error)
+	// List audit events of privileged actions from the oldest one.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Sign up user
+	SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error)
+	// Sign in user
+	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// Unlock sign in of the user or the client address locked after failed
+	// attempts.
+	UnlockSignIn(ctx context.Context, in *UnlockSignInRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Get current user.
+	GetUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// Get user by id.
+	GetUserByID(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// List users.
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// List detailed users.
+	ListUsersDetailed(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersDetailedResponse, error)
+	// Delete current user.
+	DeleteUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete user by id.
+	DeleteUserByID(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Change password of current user. Other sessions are signed out.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Change email of current user. The new email has to be verified.
+	ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Change password of user by id. All sessions of the user are signed out.
+	ChangeUserPassword(ctx context.Context, in *ChangeUserPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Change email of user by id. The new email has to be verified.
+	ChangeUserEmail(ctx context.Context, in *ChangeUserEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Refresh token.
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Revoke refresh token of the current session.
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Revoke refresh tokens of all sessions of the current user.
+	LogoutAllSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Send the link to reset password to the email. It succeeds even if
+	// there is no user with the email.
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Set new password by the token from the email. All sessions are revoked.
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Verify email by the token from the email.
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Send the verification link to the email of the current user again.
+	ResendVerification(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Public keys, which access tokens are signed with, in JWKS format.
+	GetJWKS(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetJWKSResponse, error)
+	// Create new tracking for current user.
+	CreateTracking(ctx context.Context, in *CreateTrackingRequest, opts ...grpc.CallOption) (*CreateTrackingResponse, error)
+	// Import trackings for current user from GPX, TCX or FIT files.
+	// Gateway also accepts the files as multipart/form-data upload.
+	// Trackings are saved only if all activities of all files are valid.
+	ImportTrackings(ctx context.Context, in *ImportTrackingsRequest, opts ...grpc.CallOption) (*ImportTrackingsResponse, error)
+	// Get tracking by id.
+	GetTracking(ctx context.Context, in *GetTrackingRequest, opts ...grpc.CallOption) (*GetTrackingResponse, error)
+	// List tracking for current user.
+	ListTrackingsForUser(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error)
+	// List trackings for all users.
+	ListTrackings(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error)
+	// Stream all trackings matching the query as CSV, NDJSON or GPX file.
+	// Gateway serves it as download on GET /api/v1/trackings/export.
+	ExportTrackings(ctx context.Context, in *ExportTrackingsRequest, opts ...grpc.CallOption) (APIService_ExportTrackingsClient, error)
+	// Update tracking by id. Only fields listed in update_mask are changed.
+	UpdateTracking(ctx context.Context, in *UpdateTrackingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Look up weather again for trackings of all users with missing weather.
+	// Progress is streamed after every tracking.
+	BackfillWeather(ctx context.Context, in *BackfillWeatherRequest, opts ...grpc.CallOption) (APIService_BackfillWeatherClient, error)
+	// Delete tracking by id.
+	DeleteTracking(ctx context.Context, in *DeleteTrackingRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Create report for current user or, for coaches, for the team member.
+	Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error)
+	// Get personal records and streaks of current user or, for coaches, of
+	// the team member.
+	GetPersonalRecords(ctx context.Context, in *GetPersonalRecordsRequest, opts ...grpc.CallOption) (*GetPersonalRecordsResponse, error)
+	// Create team owned by current user. The owner is the first coach.
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	// Get team by id. Only its owner, coaches, members and invited users
+	// could get it.
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	// List teams of current user including invitations.
+	ListTeams(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// Delete team by id. Only the owner could delete it.
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Invite user by email as coach or member. Only the owner could invite.
+	// Unknown emails are accepted too, so users can't be found out by email.
+	InviteToTeam(ctx context.Context, in *InviteToTeamRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Accept invitation of current user to the team.
+	AcceptTeamInvitation(ctx context.Context, in *AcceptTeamInvitationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Leave the team or decline invitation to it. Coaches lose access to
+	// trackings of the member immediately.
+	LeaveTeam(ctx context.Context, in *LeaveTeamRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type aPIServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAPIServiceClient(cc grpc.ClientConnInterface) APIServiceClient {
+	return &aPIServiceClient{cc}
+}
+
+func (c *aPIServiceClient) CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error) {
+	out := new(CreateAdminResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/CreateAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) AddPermission(ctx context.Context, in *AddPermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/AddPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RemovePermission(ctx context.Context, in *RemovePermissionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/RemovePermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListEffectivePermissions(ctx context.Context, in *ListEffectivePermissionsRequest, opts ...grpc.CallOption) (*ListEffectivePermissionsResponse, error) {
+	out := new(ListEffectivePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListEffectivePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) AddRole(ctx context.Context, in *AddRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/AddRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RemoveRole(ctx context.Context, in *RemoveRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/RemoveRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/UpdateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetRole(ctx context.Context, in *GetRoleRequest, opts ...grpc.CallOption) (*GetRoleResponse, error) {
+	out := new(GetRoleResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListRoles(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListRolesResponse, error) {
+	out := new(ListRolesResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SignUp(ctx context.Context, in *SignUpRequest, opts ...grpc.CallOption) (*SignUpResponse, error) {
+	out := new(SignUpResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/SignUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/SignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) UnlockSignIn(ctx context.Context, in *UnlockSignInRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/UnlockSignIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetUserByID(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetUserByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListUsersDetailed(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersDetailedResponse, error) {
+	out := new(ListUsersDetailedResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListUsersDetailed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DeleteUser(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DeleteUserByID(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/DeleteUserByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ChangeEmail(ctx context.Context, in *ChangeEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/ChangeEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ChangeUserPassword(ctx context.Context, in *ChangeUserPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/ChangeUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ChangeUserEmail(ctx context.Context, in *ChangeUserEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/ChangeUserEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) LogoutAllSessions(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/LogoutAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/VerifyEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ResendVerification(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/ResendVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetJWKS(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetJWKSResponse, error) {
+	out := new(GetJWKSResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetJWKS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) CreateTracking(ctx context.Context, in *CreateTrackingRequest, opts ...grpc.CallOption) (*CreateTrackingResponse, error) {
+	out := new(CreateTrackingResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/CreateTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ImportTrackings(ctx context.Context, in *ImportTrackingsRequest, opts ...grpc.CallOption) (*ImportTrackingsResponse, error) {
+	out := new(ImportTrackingsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ImportTrackings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetTracking(ctx context.Context, in *GetTrackingRequest, opts ...grpc.CallOption) (*GetTrackingResponse, error) {
+	out := new(GetTrackingResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListTrackingsForUser(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error) {
+	out := new(ListTrackingsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListTrackingsForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListTrackings(ctx context.Context, in *ListTrackingsRequest, opts ...grpc.CallOption) (*ListTrackingsResponse, error) {
+	out := new(ListTrackingsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListTrackings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ExportTrackings(ctx context.Context, in *ExportTrackingsRequest, opts ...grpc.CallOption) (APIService_ExportTrackingsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/api.APIService/ExportTrackings", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceExportTrackingsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_ExportTrackingsClient interface {
+	Recv() (*ExportTrackingsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceExportTrackingsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceExportTrackingsClient) Recv() (*ExportTrackingsResponse, error) {
+	m := new(ExportTrackingsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) UpdateTracking(ctx context.Context, in *UpdateTrackingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/UpdateTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) BackfillWeather(ctx context.Context, in *BackfillWeatherRequest, opts ...grpc.CallOption) (APIService_BackfillWeatherClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[1], "/api.APIService/BackfillWeather", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceBackfillWeatherClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_BackfillWeatherClient interface {
+	Recv() (*BackfillWeatherResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceBackfillWeatherClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceBackfillWeatherClient) Recv() (*BackfillWeatherResponse, error) {
+	m := new(BackfillWeatherResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) DeleteTracking(ctx context.Context, in *DeleteTrackingRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/DeleteTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) Report(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error) {
+	out := new(ReportResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetPersonalRecords(ctx context.Context, in *GetPersonalRecordsRequest, opts ...grpc.CallOption) (*GetPersonalRecordsResponse, error) {
+	out := new(GetPersonalRecordsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetPersonalRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/CreateTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/GetTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ListTeams(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, "/api.APIService/ListTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/DeleteTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) InviteToTeam(ctx context.Context, in *InviteToTeamRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/InviteToTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) AcceptTeamInvitation(ctx context.Context, in *AcceptTeamInvitationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/AcceptTeamInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) LeaveTeam(ctx context.Context, in *LeaveTeamRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.APIService/LeaveTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServiceServer is the server API for APIService service.
+type APIServiceServer interface {
+	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
+	// Add permission
+	AddPermission(context.Context, *AddPermissionRequest) (*empty.Empty, error)
+	// Remove permission from the user ACL. Permissions granted by roles are
+	// removed with the role.
+	RemovePermission(context.Context, *RemovePermissionRequest) (*empty.Empty, error)
+	// List permissions of the user granted by its roles and ACL. Every
+	// permission is listed once per source.
+	ListEffectivePermissions(context.Context, *ListEffectivePermissionsRequest) (*ListEffectivePermissionsResponse, error)
+	// Add role
+	AddRole(context.Context, *AddRoleRequest) (*empty.Empty, error)
+	// Remove role
+	RemoveRole(context.Context, *RemoveRoleRequest) (*empty.Empty, error)
+	// Create role with the set of permissions.
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	// Update role by id. Changes apply to all users with the role. Built-in
+	// roles couldn't be changed.
+	UpdateRole(context.Context, *UpdateRoleRequest) (*empty.Empty, error)
+	// Delete role by id. Users keep the reference, but it grants nothing.
+	// Built-in roles couldn't be deleted.
+	DeleteRole(context.Context, *DeleteRoleRequest) (*empty.Empty, error)
+	// Get role by id.
+	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
+	// List all roles including built-in ones.
+	ListRoles(context.Context, *empty.Empty) (*ListRolesResponse, error)
+	// List audit events of privileged actions from the oldest one.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Sign up user
+	SignUp(context.Context, *SignUpRequest) (*SignUpResponse, error)
+	// Sign in user
+	SignIn(context.Context, *SignInRequest) (*SignInResponse, error)
+	// Unlock sign in of the user or the client address locked after failed
+	// attempts.
+	UnlockSignIn(context.Context, *UnlockSignInRequest) (*empty.Empty, error)
+	// Get current user.
+	GetUser(context.Context, *empty.Empty) (*GetUserResponse, error)
+	// Get user by id.
+	GetUserByID(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// List users.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// List detailed users.
+	ListUsersDetailed(context.Context, *ListUsersRequest) (*ListUsersDetailedResponse, error)
+	// Delete current user.
+	DeleteUser(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Delete user by id.
+	DeleteUserByID(context.Context, *DeleteUserRequest) (*empty.Empty, error)
+	// Change password of current user. Other sessions are signed out.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
+	// Change email of current user. The new email has to be verified.
+	ChangeEmail(context.Context, *ChangeEmailRequest) (*empty.Empty, error)
+	// Change password of user by id. All sessions of the user are signed out.
+	ChangeUserPassword(context.Context, *ChangeUserPasswordRequest) (*empty.Empty, error)
+	// Change email of user by id. The new email has to be verified.
+	ChangeUserEmail(context.Context, *ChangeUserEmailRequest) (*empty.Empty, error)
+	// Refresh token.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Revoke refresh token of the current session.
+	Logout(context.Context, *LogoutRequest) (*empty.Empty, error)
+	// Revoke refresh tokens of all sessions of the current user.
+	LogoutAllSessions(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Send the link to reset password to the email. It succeeds even if
+	// there is no user with the email.
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*empty.Empty, error)
+	// Set new password by the token from the email. All sessions are revoked.
+	ResetPassword(context.Context, *ResetPasswordRequest) (*empty.Empty, error)
+	// Verify email by the token from the email.
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*empty.Empty, error)
+	// Send the verification link to the email of the current user again.
+	ResendVerification(context.Context, *empty.Empty) (*empty.Empty, error)
+	// Public keys, which access tokens are signed with, in JWKS format.
+	GetJWKS(context.Context, *empty.Empty) (*GetJWKSResponse, error)
+	// Create new tracking for current user.
+	CreateTracking(context.Context, *CreateTrackingRequest) (*CreateTrackingResponse, error)
+	// Import trackings for current user from GPX, TCX or FIT files.
+	// Gateway also accepts the files as multipart/form-data upload.
+	// Trackings are saved only if all activities of all files are valid.
+	ImportTrackings(context.Context, *ImportTrackingsRequest) (*ImportTrackingsResponse, error)
+	// Get tracking by id.
+	GetTracking(context.Context, *GetTrackingRequest) (*GetTrackingResponse, error)
+	// List tracking for current user.
+	ListTrackingsForUser(context.Context, *ListTrackingsRequest) (*ListTrackingsResponse, error)
+	// List trackings for all users.
+	ListTrackings(context.Context, *ListTrackingsRequest) (*ListTrackingsResponse, error)
+	// Stream all trackings matching the query as CSV, NDJSON or GPX file.
+	// Gateway serves it as download on GET /api/v1/trackings/export.
+	ExportTrackings(*ExportTrackingsRequest, APIService_ExportTrackingsServer) error
+	// Update tracking by id. Only fields listed in update_mask are changed.
+	UpdateTracking(context.Context, *UpdateTrackingRequest) (*empty.Empty, error)
+	// Look up weather again for trackings of all users with missing weather.
+	// Progress is streamed after every tracking.
+	BackfillWeather(*BackfillWeatherRequest, APIService_BackfillWeatherServer) error
+	// Delete tracking by id.
+	DeleteTracking(context.Context, *DeleteTrackingRequest) (*empty.Empty, error)
+	// Create report for current user or, for coaches, for the team member.
+	Report(context.Context, *ReportRequest) (*ReportResponse, error)
+	// Get personal records and streaks of current user or, for coaches, of
+	// the team member.
+	GetPersonalRecords(context.Context, *GetPersonalRecordsRequest) (*GetPersonalRecordsResponse, error)
+	// Create team owned by current user. The owner is the first coach.
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	// Get team by id. Only its owner, coaches, members and invited users
+	// could get it.
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	// List teams of current user including invitations.
+	ListTeams(context.Context, *empty.Empty) (*ListTeamsResponse, error)
+	// Delete team by id. Only the owner could delete it.
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*empty.Empty, error)
+	// Invite user by email as coach or member. Only the owner could invite.
+	// Unknown emails are accepted too, so users can't be found out by email.
+	InviteToTeam(context.Context, *InviteToTeamRequest) (*empty.Empty, error)
+	// Accept invitation of current user to the team.
+	AcceptTeamInvitation(context.Context, *AcceptTeamInvitationRequest) (*empty.Empty, error)
+	// Leave the team or decline invitation to it. Coaches lose access to
+	// trackings of the member immediately.
+	LeaveTeam(context.Context, *LeaveTeamRequest) (*empty.Empty, error)
+}
+
+// UnimplementedAPIServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServiceServer struct {
+}
+
+func (*UnimplementedAPIServiceServer) CreateAdmin(ctx context.Context, req *CreateAdminRequest) (*CreateAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAdmin not implemented")
+}
+func (*UnimplementedAPIServiceServer) AddPermission(ctx context.Context, req *AddPermissionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPermission not implemented")
+}
+func (*UnimplementedAPIServiceServer) RemovePermission(ctx context.Context, req *RemovePermissionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePermission not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListEffectivePermissions(ctx context.Context, req *ListEffectivePermissionsRequest) (*ListEffectivePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEffectivePermissions not implemented")
+}
+func (*UnimplementedAPIServiceServer) AddRole(ctx context.Context, req *AddRoleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) RemoveRole(ctx context.Context, req *RemoveRoleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetRole(ctx context.Context, req *GetRoleRequest) (*GetRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListRoles(ctx context.Context, req *empty.Empty) (*ListRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (*UnimplementedAPIServiceServer) SignUp(ctx context.Context, req *SignUpRequest) (*SignUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUp not implemented")
+}
+func (*UnimplementedAPIServiceServer) SignIn(ctx context.Context, req *SignInRequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignIn not implemented")
+}
+func (*UnimplementedAPIServiceServer) UnlockSignIn(ctx context.Context, req *UnlockSignInRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSignIn not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetUser(ctx context.Context, req *empty.Empty) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetUserByID(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByID not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListUsersDetailed(ctx context.Context, req *ListUsersRequest) (*ListUsersDetailedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsersDetailed not implemented")
+}
+func (*UnimplementedAPIServiceServer) DeleteUser(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedAPIServiceServer) DeleteUserByID(ctx context.Context, req *DeleteUserRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserByID not implemented")
+}
+func (*UnimplementedAPIServiceServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedAPIServiceServer) ChangeEmail(ctx context.Context, req *ChangeEmailRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (*UnimplementedAPIServiceServer) ChangeUserPassword(ctx context.Context, req *ChangeUserPasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserPassword not implemented")
+}
+func (*UnimplementedAPIServiceServer) ChangeUserEmail(ctx context.Context, req *ChangeUserEmailRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserEmail not implemented")
+}
+func (*UnimplementedAPIServiceServer) RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (*UnimplementedAPIServiceServer) Logout(ctx context.Context, req *LogoutRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (*UnimplementedAPIServiceServer) LogoutAllSessions(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllSessions not implemented")
+}
+func (*UnimplementedAPIServiceServer) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedAPIServiceServer) ResetPassword(ctx context.Context, req *ResetPasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedAPIServiceServer) VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (*UnimplementedAPIServiceServer) ResendVerification(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerification not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetJWKS(ctx context.Context, req *empty.Empty) (*GetJWKSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (*UnimplementedAPIServiceServer) CreateTracking(ctx context.Context, req *CreateTrackingRequest) (*CreateTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTracking not implemented")
+}
+func (*UnimplementedAPIServiceServer) ImportTrackings(ctx context.Context, req *ImportTrackingsRequest) (*ImportTrackingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTrackings not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetTracking(ctx context.Context, req *GetTrackingRequest) (*GetTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTracking not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListTrackingsForUser(ctx context.Context, req *ListTrackingsRequest) (*ListTrackingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackingsForUser not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListTrackings(ctx context.Context, req *ListTrackingsRequest) (*ListTrackingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackings not implemented")
+}
+func (*UnimplementedAPIServiceServer) ExportTrackings(req *ExportTrackingsRequest, srv APIService_ExportTrackingsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTrackings not implemented")
+}
+func (*UnimplementedAPIServiceServer) UpdateTracking(ctx context.Context, req *UpdateTrackingRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTracking not implemented")
+}
+func (*UnimplementedAPIServiceServer) BackfillWeather(req *BackfillWeatherRequest, srv APIService_BackfillWeatherServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackfillWeather not implemented")
+}
+func (*UnimplementedAPIServiceServer) DeleteTracking(ctx context.Context, req *DeleteTrackingRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTracking not implemented")
+}
+func (*UnimplementedAPIServiceServer) Report(ctx context.Context, req *ReportRequest) (*ReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetPersonalRecords(ctx context.Context, req *GetPersonalRecordsRequest) (*GetPersonalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonalRecords not implemented")
+}
+func (*UnimplementedAPIServiceServer) CreateTeam(ctx context.Context, req *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (*UnimplementedAPIServiceServer) GetTeam(ctx context.Context, req *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (*UnimplementedAPIServiceServer) ListTeams(ctx context.Context, req *empty.Empty) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (*UnimplementedAPIServiceServer) DeleteTeam(ctx context.Context, req *DeleteTeamRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (*UnimplementedAPIServiceServer) InviteToTeam(ctx context.Context, req *InviteToTeamRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToTeam not implemented")
+}
+func (*UnimplementedAPIServiceServer) AcceptTeamInvitation(ctx context.Context, req *AcceptTeamInvitationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTeamInvitation not implemented")
+}
+func (*UnimplementedAPIServiceServer) LeaveTeam(ctx context.Context, req *LeaveTeamRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveTeam not implemented")
+}
+
+func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
+	s.RegisterService(&_APIService_serviceDesc, srv)
+}
+
+func _APIService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).CreateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/CreateAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_AddPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).AddPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/AddPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).AddPermission(ctx, req.(*AddPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RemovePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RemovePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/RemovePermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RemovePermission(ctx, req.(*RemovePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEffectivePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ListEffectivePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListEffectivePermissions(ctx, req.(*ListEffectivePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_AddRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).AddRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/AddRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).AddRole(ctx, req.(*AddRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RemoveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RemoveRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/RemoveRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RemoveRole(ctx, req.(*RemoveRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/UpdateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).UpdateRole(ctx, req.(*UpdateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).DeleteRole(ctx, req.(*DeleteRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetRole(ctx, req.(*GetRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListRoles(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SignUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/SignUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SignUp(ctx, req.(*SignUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/SignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SignIn(ctx, req.(*SignInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_UnlockSignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockSignInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).UnlockSignIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/UnlockSignIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).UnlockSignIn(ctx, req.(*UnlockSignInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetUser(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetUserByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/GetUserByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetUserByID(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ListUsersDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListUsersDetailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ListUsersDetailed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListUsersDetailed(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).DeleteUser(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_DeleteUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).DeleteUserByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/DeleteUserByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).DeleteUserByID(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ChangeEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ChangeEmail(ctx, req.(*ChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ChangeUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ChangeUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ChangeUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ChangeUserPassword(ctx, req.(*ChangeUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ChangeUserEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ChangeUserEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ChangeUserEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ChangeUserEmail(ctx, req.(*ChangeUserEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServiceServer) DeleteTracking(ctx context.Context, req *DeleteTrackingRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTracking not implemented")
+
+func _APIService_LogoutAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).LogoutAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/LogoutAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).LogoutAllSessions(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServiceServer) Report(ctx context.Context, req *ReportRequest) (*ReportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+
+func _APIService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
-	s.RegisterService(&_APIService_serviceDesc, srv)
+func _APIService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_CreateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAdminRequest)
+func _APIService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).CreateAdmin(ctx, in)
+		return srv.(APIServiceServer).VerifyEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/CreateAdmin",
+		FullMethod: "/api.APIService/VerifyEmail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).CreateAdmin(ctx, req.(*CreateAdminRequest))
+		return srv.(APIServiceServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_AddPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPermissionRequest)
+func _APIService_ResendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).AddPermission(ctx, in)
+		return srv.(APIServiceServer).ResendVerification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/AddPermission",
+		FullMethod: "/api.APIService/ResendVerification",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).AddPermission(ctx, req.(*AddPermissionRequest))
+		return srv.(APIServiceServer).ResendVerification(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_AddRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRoleRequest)
+func _APIService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).AddRole(ctx, in)
+		return srv.(APIServiceServer).GetJWKS(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/AddRole",
+		FullMethod: "/api.APIService/GetJWKS",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).AddRole(ctx, req.(*AddRoleRequest))
+		return srv.(APIServiceServer).GetJWKS(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_RemoveRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRoleRequest)
+func _APIService_CreateTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).RemoveRole(ctx, in)
+		return srv.(APIServiceServer).CreateTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/RemoveRole",
+		FullMethod: "/api.APIService/CreateTracking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).RemoveRole(ctx, req.(*RemoveRoleRequest))
+		return srv.(APIServiceServer).CreateTracking(ctx, req.(*CreateTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_SignUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignUpRequest)
+func _APIService_ImportTrackings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTrackingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).SignUp(ctx, in)
+		return srv.(APIServiceServer).ImportTrackings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/SignUp",
+		FullMethod: "/api.APIService/ImportTrackings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).SignUp(ctx, req.(*SignUpRequest))
+		return srv.(APIServiceServer).ImportTrackings(ctx, req.(*ImportTrackingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_SignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignInRequest)
+func _APIService_GetTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).SignIn(ctx, in)
+		return srv.(APIServiceServer).GetTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/SignIn",
+		FullMethod: "/api.APIService/GetTracking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).SignIn(ctx, req.(*SignInRequest))
+		return srv.(APIServiceServer).GetTracking(ctx, req.(*GetTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _APIService_ListTrackingsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrackingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).GetUser(ctx, in)
+		return srv.(APIServiceServer).ListTrackingsForUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/GetUser",
+		FullMethod: "/api.APIService/ListTrackingsForUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).GetUser(ctx, req.(*empty.Empty))
+		return srv.(APIServiceServer).ListTrackingsForUser(ctx, req.(*ListTrackingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_GetUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _APIService_ListTrackings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrackingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).GetUserByID(ctx, in)
+		return srv.(APIServiceServer).ListTrackings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/GetUserByID",
+		FullMethod: "/api.APIService/ListTrackings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).GetUserByID(ctx, req.(*GetUserRequest))
+		return srv.(APIServiceServer).ListTrackings(ctx, req.(*ListTrackingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _APIService_ExportTrackings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTrackingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).ExportTrackings(m, &aPIServiceExportTrackingsServer{stream})
+}
+
+type APIService_ExportTrackingsServer interface {
+	Send(*ExportTrackingsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceExportTrackingsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceExportTrackingsServer) Send(m *ExportTrackingsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_UpdateTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).ListUsers(ctx, in)
+		return srv.(APIServiceServer).UpdateTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/ListUsers",
+		FullMethod: "/api.APIService/UpdateTracking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(APIServiceServer).UpdateTracking(ctx, req.(*UpdateTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_ListUsersDetailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _APIService_BackfillWeather_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackfillWeatherRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).BackfillWeather(m, &aPIServiceBackfillWeatherServer{stream})
+}
+
+type APIService_BackfillWeatherServer interface {
+	Send(*BackfillWeatherResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceBackfillWeatherServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceBackfillWeatherServer) Send(m *BackfillWeatherResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_DeleteTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrackingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).ListUsersDetailed(ctx, in)
+		return srv.(APIServiceServer).DeleteTracking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/ListUsersDetailed",
+		FullMethod: "/api.APIService/DeleteTracking",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).ListUsersDetailed(ctx, req.(*ListUsersRequest))
+		return srv.(APIServiceServer).DeleteTracking(ctx, req.(*DeleteTrackingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _APIService_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).DeleteUser(ctx, in)
+		return srv.(APIServiceServer).Report(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/DeleteUser",
+		FullMethod: "/api.APIService/Report",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).DeleteUser(ctx, req.(*empty.Empty))
+		return srv.(APIServiceServer).Report(ctx, req.(*ReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_DeleteUserByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _APIService_GetPersonalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonalRecordsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).DeleteUserByID(ctx, in)
+		return srv.(APIServiceServer).GetPersonalRecords(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/DeleteUserByID",
+		FullMethod: "/api.APIService/GetPersonalRecords",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).DeleteUserByID(ctx, req.(*DeleteUserRequest))
+		return srv.(APIServiceServer).GetPersonalRecords(ctx, req.(*GetPersonalRecordsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _APIService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).RefreshToken(ctx, in)
+		return srv.(APIServiceServer).CreateTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/RefreshToken",
+		FullMethod: "/api.APIService/CreateTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(APIServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_CreateTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTrackingRequest)
+func _APIService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).CreateTracking(ctx, in)
+		return srv.(APIServiceServer).GetTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/CreateTracking",
+		FullMethod: "/api.APIService/GetTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).CreateTracking(ctx, req.(*CreateTrackingRequest))
+		return srv.(APIServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_GetTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTrackingRequest)
+func _APIService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).GetTracking(ctx, in)
+		return srv.(APIServiceServer).ListTeams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/GetTracking",
+		FullMethod: "/api.APIService/ListTeams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).GetTracking(ctx, req.(*GetTrackingRequest))
+		return srv.(APIServiceServer).ListTeams(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_ListTrackingsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTrackingsRequest)
+func _APIService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).ListTrackingsForUser(ctx, in)
+		return srv.(APIServiceServer).DeleteTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/ListTrackingsForUser",
+		FullMethod: "/api.APIService/DeleteTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).ListTrackingsForUser(ctx, req.(*ListTrackingsRequest))
+		return srv.(APIServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_ListTrackings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTrackingsRequest)
+func _APIService_InviteToTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).ListTrackings(ctx, in)
+		return srv.(APIServiceServer).InviteToTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/ListTrackings",
+		FullMethod: "/api.APIService/InviteToTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).ListTrackings(ctx, req.(*ListTrackingsRequest))
+		return srv.(APIServiceServer).InviteToTeam(ctx, req.(*InviteToTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_DeleteTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTrackingRequest)
+func _APIService_AcceptTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTeamInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).DeleteTracking(ctx, in)
+		return srv.(APIServiceServer).AcceptTeamInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/DeleteTracking",
+		FullMethod: "/api.APIService/AcceptTeamInvitation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).DeleteTracking(ctx, req.(*DeleteTrackingRequest))
+		return srv.(APIServiceServer).AcceptTeamInvitation(ctx, req.(*AcceptTeamInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _APIService_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportRequest)
+func _APIService_LeaveTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServiceServer).Report(ctx, in)
+		return srv.(APIServiceServer).LeaveTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.APIService/Report",
+		FullMethod: "/api.APIService/LeaveTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServiceServer).Report(ctx, req.(*ReportRequest))
+		return srv.(APIServiceServer).LeaveTeam(ctx, req.(*LeaveTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2539,6 +7083,14 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddPermission",
 			Handler:    _APIService_AddPermission_Handler,
 		},
+		{
+			MethodName: "RemovePermission",
+			Handler:    _APIService_RemovePermission_Handler,
+		},
+		{
+			MethodName: "ListEffectivePermissions",
+			Handler:    _APIService_ListEffectivePermissions_Handler,
+		},
 		{
 			MethodName: "AddRole",
 			Handler:    _APIService_AddRole_Handler,
@@ -2547,6 +7099,30 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRole",
 			Handler:    _APIService_RemoveRole_Handler,
 		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _APIService_CreateRole_Handler,
+		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _APIService_UpdateRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _APIService_DeleteRole_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _APIService_GetRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _APIService_ListRoles_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _APIService_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "SignUp",
 			Handler:    _APIService_SignUp_Handler,
@@ -2555,6 +7131,10 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignIn",
 			Handler:    _APIService_SignIn_Handler,
 		},
+		{
+			MethodName: "UnlockSignIn",
+			Handler:    _APIService_UnlockSignIn_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _APIService_GetUser_Handler,
@@ -2579,14 +7159,62 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUserByID",
 			Handler:    _APIService_DeleteUserByID_Handler,
 		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _APIService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _APIService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "ChangeUserPassword",
+			Handler:    _APIService_ChangeUserPassword_Handler,
+		},
+		{
+			MethodName: "ChangeUserEmail",
+			Handler:    _APIService_ChangeUserEmail_Handler,
+		},
 		{
 			MethodName: "RefreshToken",
 			Handler:    _APIService_RefreshToken_Handler,
 		},
+		{
+			MethodName: "Logout",
+			Handler:    _APIService_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllSessions",
+			Handler:    _APIService_LogoutAllSessions_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _APIService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _APIService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _APIService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerification",
+			Handler:    _APIService_ResendVerification_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _APIService_GetJWKS_Handler,
+		},
 		{
 			MethodName: "CreateTracking",
 			Handler:    _APIService_CreateTracking_Handler,
 		},
+		{
+			MethodName: "ImportTrackings",
+			Handler:    _APIService_ImportTrackings_Handler,
+		},
 		{
 			MethodName: "GetTracking",
 			Handler:    _APIService_GetTracking_Handler,
@@ -2599,6 +7227,10 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTrackings",
 			Handler:    _APIService_ListTrackings_Handler,
 		},
+		{
+			MethodName: "UpdateTracking",
+			Handler:    _APIService_UpdateTracking_Handler,
+		},
 		{
 			MethodName: "DeleteTracking",
 			Handler:    _APIService_DeleteTracking_Handler,
@@ -2607,7 +7239,50 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Report",
 			Handler:    _APIService_Report_Handler,
 		},
+		{
+			MethodName: "GetPersonalRecords",
+			Handler:    _APIService_GetPersonalRecords_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _APIService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _APIService_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _APIService_ListTeams_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _APIService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "InviteToTeam",
+			Handler:    _APIService_InviteToTeam_Handler,
+		},
+		{
+			MethodName: "AcceptTeamInvitation",
+			Handler:    _APIService_AcceptTeamInvitation_Handler,
+		},
+		{
+			MethodName: "LeaveTeam",
+			Handler:    _APIService_LeaveTeam_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTrackings",
+			Handler:       _APIService_ExportTrackings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BackfillWeather",
+			Handler:       _APIService_BackfillWeather_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
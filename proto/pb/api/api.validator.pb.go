@@ -10,6 +10,8 @@ import (
 	_ "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "google.golang.org/genproto/protobuf/field_mask"
+	_ "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "github.com/mwitkow/go-proto-validators"
@@ -21,6 +23,9 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+func (this *AuthRule) Validate() error {
+	return nil
+}
 func (this *CreateAdminRequest) Validate() error {
 	if this.Email == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("Email", fmt.Errorf(`value '%v' must not be an empty string`, this.Email))
@@ -42,6 +47,31 @@ func (this *AddPermissionRequest) Validate() error {
 	}
 	return nil
 }
+func (this *RemovePermissionRequest) Validate() error {
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	if this.Item == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Item", fmt.Errorf(`value '%v' must not be an empty string`, this.Item))
+	}
+	return nil
+}
+func (this *ListEffectivePermissionsRequest) Validate() error {
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	return nil
+}
+func (this *ListEffectivePermissionsResponse) Validate() error {
+	for _, item := range this.Permissions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Permissions", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *AddRoleRequest) Validate() error {
 	if this.UserId == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
@@ -54,6 +84,81 @@ func (this *RemoveRoleRequest) Validate() error {
 	}
 	return nil
 }
+func (this *CreateRoleRequest) Validate() error {
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	for _, item := range this.Permissions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Permissions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CreateRoleResponse) Validate() error {
+	return nil
+}
+func (this *UpdateRoleRequest) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	for _, item := range this.Permissions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Permissions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DeleteRoleRequest) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *GetRoleRequest) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *GetRoleResponse) Validate() error {
+	if this.Role != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Role); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Role", err)
+		}
+	}
+	return nil
+}
+func (this *ListRolesResponse) Validate() error {
+	for _, item := range this.Roles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Roles", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListAuditEventsRequest) Validate() error {
+	return nil
+}
+func (this *ListAuditEventsResponse) Validate() error {
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *SignUpRequest) Validate() error {
 	if this.Email == "" {
 		return github_com_mwitkow_go_proto_validators.FieldError("Email", fmt.Errorf(`value '%v' must not be an empty string`, this.Email))
@@ -66,6 +171,45 @@ func (this *SignUpRequest) Validate() error {
 func (this *SignUpResponse) Validate() error {
 	return nil
 }
+func (this *UnlockSignInRequest) Validate() error {
+	return nil
+}
+func (this *ChangePasswordRequest) Validate() error {
+	if this.CurrentPassword == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("CurrentPassword", fmt.Errorf(`value '%v' must not be an empty string`, this.CurrentPassword))
+	}
+	if this.NewPassword == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NewPassword", fmt.Errorf(`value '%v' must not be an empty string`, this.NewPassword))
+	}
+	return nil
+}
+func (this *ChangeEmailRequest) Validate() error {
+	if this.Email == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Email", fmt.Errorf(`value '%v' must not be an empty string`, this.Email))
+	}
+	if this.CurrentPassword == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("CurrentPassword", fmt.Errorf(`value '%v' must not be an empty string`, this.CurrentPassword))
+	}
+	return nil
+}
+func (this *ChangeUserPasswordRequest) Validate() error {
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	if this.NewPassword == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("NewPassword", fmt.Errorf(`value '%v' must not be an empty string`, this.NewPassword))
+	}
+	return nil
+}
+func (this *ChangeUserEmailRequest) Validate() error {
+	if this.UserId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("UserId", fmt.Errorf(`value '%v' must not be an empty string`, this.UserId))
+	}
+	if this.Email == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Email", fmt.Errorf(`value '%v' must not be an empty string`, this.Email))
+	}
+	return nil
+}
 func (this *SignInRequest) Validate() error {
 	return nil
 }
@@ -125,6 +269,43 @@ func (this *RefreshTokenResponse) Validate() error {
 	}
 	return nil
 }
+func (this *LogoutRequest) Validate() error {
+	if this.RefreshToken == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("RefreshToken", fmt.Errorf(`value '%v' must not be an empty string`, this.RefreshToken))
+	}
+	return nil
+}
+func (this *RequestPasswordResetRequest) Validate() error {
+	if this.Email == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Email", fmt.Errorf(`value '%v' must not be an empty string`, this.Email))
+	}
+	return nil
+}
+func (this *ResetPasswordRequest) Validate() error {
+	if this.Token == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Token", fmt.Errorf(`value '%v' must not be an empty string`, this.Token))
+	}
+	if this.Password == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Password", fmt.Errorf(`value '%v' must not be an empty string`, this.Password))
+	}
+	return nil
+}
+func (this *VerifyEmailRequest) Validate() error {
+	if this.Token == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Token", fmt.Errorf(`value '%v' must not be an empty string`, this.Token))
+	}
+	return nil
+}
+func (this *GetJWKSResponse) Validate() error {
+	for _, item := range this.Keys {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Keys", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *CreateTrackingRequest) Validate() error {
 	if this.Time != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
@@ -142,15 +323,50 @@ func (this *CreateTrackingRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Location", err)
 		}
 	}
+	for _, item := range this.Route {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Route", err)
+			}
+		}
+	}
 	return nil
 }
 func (this *CreateTrackingResponse) Validate() error {
 	return nil
 }
+func (this *ImportTrackingsRequest) Validate() error {
+	return nil
+}
+func (this *ImportTrackingsResponse) Validate() error {
+	return nil
+}
 func (this *DeleteTrackingRequest) Validate() error {
 	return nil
 }
+func (this *UpdateTrackingRequest) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	if nil == this.Tracking {
+		return github_com_mwitkow_go_proto_validators.FieldError("Tracking", fmt.Errorf("message must exist"))
+	}
+	if this.Tracking != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Tracking); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Tracking", err)
+		}
+	}
+	if this.UpdateMask != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.UpdateMask); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("UpdateMask", err)
+		}
+	}
+	return nil
+}
 func (this *GetTrackingRequest) Validate() error {
+	if !(this.RouteTolerance >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("RouteTolerance", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.RouteTolerance))
+	}
 	return nil
 }
 func (this *GetTrackingResponse) Validate() error {
@@ -174,6 +390,30 @@ func (this *ListTrackingsResponse) Validate() error {
 	}
 	return nil
 }
+func (this *ExportTrackingsRequest) Validate() error {
+	return nil
+}
+func (this *ExportTrackingsResponse) Validate() error {
+	return nil
+}
+func (this *BackfillWeatherRequest) Validate() error {
+	if !(this.Rate >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Rate", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Rate))
+	}
+	if !(this.Rate <= 50) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Rate", fmt.Errorf(`value '%v' must be lower than or equal to '50'`, this.Rate))
+	}
+	if !(this.Concurrency > -1) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Concurrency", fmt.Errorf(`value '%v' must be greater than '-1'`, this.Concurrency))
+	}
+	if !(this.Concurrency < 17) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Concurrency", fmt.Errorf(`value '%v' must be less than '17'`, this.Concurrency))
+	}
+	return nil
+}
+func (this *BackfillWeatherResponse) Validate() error {
+	return nil
+}
 func (this *ReportRequest) Validate() error {
 	if this.Duration != nil {
 		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Duration); err != nil {
@@ -182,12 +422,171 @@ func (this *ReportRequest) Validate() error {
 	}
 	return nil
 }
+func (this *GetPersonalRecordsRequest) Validate() error {
+	return nil
+}
 func (this *ReportResponse) Validate() error {
+	for _, item := range this.Buckets {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Buckets", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetPersonalRecordsResponse) Validate() error {
+	if this.FastestPace != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FastestPace); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FastestPace", err)
+		}
+	}
+	if this.LongestDistance != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LongestDistance); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LongestDistance", err)
+		}
+	}
+	if this.LongestDuration != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LongestDuration); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LongestDuration", err)
+		}
+	}
+	if this.BiggestWeek != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.BiggestWeek); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("BiggestWeek", err)
+		}
+	}
+	if this.BiggestMonth != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.BiggestMonth); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("BiggestMonth", err)
+		}
+	}
+	if this.CurrentStreak != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CurrentStreak); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CurrentStreak", err)
+		}
+	}
+	if this.LongestStreak != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LongestStreak); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LongestStreak", err)
+		}
+	}
+	return nil
+}
+func (this *CreateTeamRequest) Validate() error {
+	if this.Name == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Name", fmt.Errorf(`value '%v' must not be an empty string`, this.Name))
+	}
+	return nil
+}
+func (this *CreateTeamResponse) Validate() error {
+	return nil
+}
+func (this *GetTeamRequest) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *GetTeamResponse) Validate() error {
+	if this.Team != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Team); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Team", err)
+		}
+	}
+	return nil
+}
+func (this *ListTeamsResponse) Validate() error {
+	for _, item := range this.Teams {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Teams", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DeleteTeamRequest) Validate() error {
+	if this.Id == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Id", fmt.Errorf(`value '%v' must not be an empty string`, this.Id))
+	}
+	return nil
+}
+func (this *InviteToTeamRequest) Validate() error {
+	if this.TeamId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TeamId", fmt.Errorf(`value '%v' must not be an empty string`, this.TeamId))
+	}
+	if this.Email == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Email", fmt.Errorf(`value '%v' must not be an empty string`, this.Email))
+	}
+	return nil
+}
+func (this *AcceptTeamInvitationRequest) Validate() error {
+	if this.TeamId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TeamId", fmt.Errorf(`value '%v' must not be an empty string`, this.TeamId))
+	}
+	return nil
+}
+func (this *LeaveTeamRequest) Validate() error {
+	if this.TeamId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TeamId", fmt.Errorf(`value '%v' must not be an empty string`, this.TeamId))
+	}
 	return nil
 }
 func (this *User) Validate() error {
 	return nil
 }
+func (this *Permission) Validate() error {
+	if this.Item == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Item", fmt.Errorf(`value '%v' must not be an empty string`, this.Item))
+	}
+	return nil
+}
+func (this *EffectivePermission) Validate() error {
+	if this.Permission != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Permission); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Permission", err)
+		}
+	}
+	return nil
+}
+func (this *Team) Validate() error {
+	for _, item := range this.Invitations {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Invitations", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *TeamInvitation) Validate() error {
+	return nil
+}
+func (this *AuditEvent) Validate() error {
+	if this.Target != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Target); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Target", err)
+		}
+	}
+	return nil
+}
+func (this *AuditTarget) Validate() error {
+	return nil
+}
+func (this *RoleDefinition) Validate() error {
+	for _, item := range this.Permissions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Permissions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *JSONWebKey) Validate() error {
+	return nil
+}
 func (this *DetailedUser) Validate() error {
 	return nil
 }
@@ -207,6 +606,56 @@ func (this *Tracking) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Weather", err)
 		}
 	}
+	for _, item := range this.Route {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Route", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *TrackingUpdate) Validate() error {
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	if !(this.Distance >= 0) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Distance", fmt.Errorf(`value '%v' must be greater than or equal to '0'`, this.Distance))
+	}
+	if this.Location != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Location); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Location", err)
+		}
+	}
+	return nil
+}
+func (this *ReportBucket) Validate() error {
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	return nil
+}
+func (this *TrackingRecord) Validate() error {
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	if this.Pace != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Pace); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Pace", err)
+		}
+	}
+	return nil
+}
+func (this *PeriodRecord) Validate() error {
+	return nil
+}
+func (this *Streak) Validate() error {
 	return nil
 }
 func (this *Location) Validate() error {
@@ -224,6 +673,29 @@ func (this *Location) Validate() error {
 	}
 	return nil
 }
+func (this *RoutePoint) Validate() error {
+	if !(this.Latitude >= -90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be greater than or equal to '-90'`, this.Latitude))
+	}
+	if !(this.Latitude <= 90) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Latitude", fmt.Errorf(`value '%v' must be lower than or equal to '90'`, this.Latitude))
+	}
+	if !(this.Longitude >= -180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be greater than or equal to '-180'`, this.Longitude))
+	}
+	if !(this.Longitude <= 180) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Longitude", fmt.Errorf(`value '%v' must be lower than or equal to '180'`, this.Longitude))
+	}
+	if nil == this.Time {
+		return github_com_mwitkow_go_proto_validators.FieldError("Time", fmt.Errorf("message must exist"))
+	}
+	if this.Time != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Time); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+		}
+	}
+	return nil
+}
 func (this *Weather) Validate() error {
 	return nil
 }
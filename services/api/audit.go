@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	requestIDHeader    = "x-request-id"
+	forwardedForHeader = "x-forwarded-for"
+)
+
+var (
+	// allTrackingsResource is the target of reads of all users' trackings.
+	allTrackingsResource = storage.Resource{Scope: storage.TrackingScope, Item: "*"}
+	// allUsersResource is the target of actions, which aren't bound to a user.
+	allUsersResource = storage.Resource{Scope: storage.UserScope, Item: "*"}
+)
+
+// audit saves the event of the privileged action made by the principal.
+// Before and after are states of the target, nil if it doesn't exist. The
+// action is already done, so failures are only logged.
+func (s *APIServer) audit(
+	ctx context.Context,
+	action storage.AuditAction,
+	target storage.Resource,
+	before, after interface{},
+) {
+	event := storage.NewAuditEvent(principal(ctx).ID, action, target)
+	event.RequestID = requestID(ctx)
+	event.ClientIP = s.clientIP(ctx)
+	event.PeerIP = peerIP(ctx)
+	if err := event.SetDiff(before, after); err != nil {
+		s.logger.WithField("err", err).Error("cannot diff audit event states")
+	}
+	if err := s.store.SaveAuditEvent(event); err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("event", event).
+			Error("cannot save audit event")
+	}
+}
+
+// requestID returns id of the request set by the gateway or the client.
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(requestIDHeader); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func userResource(user *storage.User) storage.Resource {
+	return storage.Resource{Scope: storage.UserScope, Item: user.ID.String()}
+}
+
+// roleResource is in permissions scope, as roles are managed by it.
+func roleResource(role *storage.Role) storage.Resource {
+	return storage.Resource{Scope: storage.PermissionsScope, Item: role.ID.String()}
+}
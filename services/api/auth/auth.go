@@ -2,8 +2,8 @@ package auth
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"fmt"
+	"crypto/rand"
+	"encoding/base64"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,31 +20,55 @@ const (
 
 	accessTokenExpirationTime  = 10 * time.Minute
 	refreshTokenExpirationTime = 7 * 24 * time.Hour
+
+	passwordResetExpirationTime     = time.Hour
+	emailVerificationExpirationTime = 24 * time.Hour
+
+	oneTimeTokenSize = 32
 )
 
 type Service interface {
 	GenerateToken(ctx context.Context, user *storage.User) (*storage.Token, error)
 	VerifyToken(ctx context.Context, accessToken string) (*storage.Claims, error)
 	ParseAuthorizationHeader(ctx context.Context) (*storage.Claims, error)
+	// RefreshToken rotates refresh token: the old one can't be used anymore.
+	// Presenting already rotated token revokes all tokens of its family.
 	RefreshToken(ctx context.Context, refreshToken string) (*storage.Token, error)
+	// RevokeToken revokes refresh token and access token with claims, which
+	// are the claims of the current session.
+	RevokeToken(ctx context.Context, claims *storage.Claims, refreshToken string) error
+	// RevokeAllTokens revokes all tokens of the user issued before now.
+	RevokeAllTokens(ctx context.Context, userID uuid.UUID) error
+	// RevokeOtherSessions revokes refresh tokens of the user except the one
+	// of the session with claims. Access tokens of other sessions stay valid
+	// until they expire.
+	RevokeOtherSessions(ctx context.Context, claims *storage.Claims) error
+	// JSONWebKeys returns public keys, which tokens are verified with.
+	JSONWebKeys(ctx context.Context) []*JSONWebKey
+	// IssueOneTimeToken generates the token to be sent by email. Previous
+	// tokens of the user with the same purpose can't be used anymore.
+	IssueOneTimeToken(ctx context.Context, userID uuid.UUID, purpose storage.TokenPurpose) (string, error)
+	// ConsumeOneTimeToken checks the token and returns id of its user. The
+	// token can't be used again.
+	ConsumeOneTimeToken(ctx context.Context, token string, purpose storage.TokenPurpose) (uuid.UUID, error)
 }
 
 type ServiceImp struct {
-	privateKey *ecdsa.PrivateKey
-	store      storage.Storage
-	logger     *log.Logger
+	keys   *KeyRing
+	store  storage.Storage
+	logger *log.Logger
 }
 
-func New(privateKey *ecdsa.PrivateKey, store storage.Storage, logger *log.Logger) Service {
+func New(keys *KeyRing, store storage.Storage, logger *log.Logger) Service {
 	return &ServiceImp{
-		privateKey: privateKey,
-		store:      store,
-		logger:     logger,
+		keys:   keys,
+		store:  store,
+		logger: logger,
 	}
 }
 
 func (s *ServiceImp) GenerateToken(ctx context.Context, user *storage.User) (*storage.Token, error) {
-	token, err := s.generateToken(user.ID)
+	token, err := s.generateToken(user.ID, uuid.New())
 	if err != nil {
 		return nil, err
 	}
@@ -56,77 +80,200 @@ func (s *ServiceImp) GenerateToken(ctx context.Context, user *storage.User) (*st
 }
 
 func (s *ServiceImp) VerifyToken(ctx context.Context, accessToken string) (*storage.Claims, error) {
-	token, err := jwt.ParseWithClaims(accessToken, &storage.Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	claims, err := s.parseToken(accessToken, storage.AccessType)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRevocation(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func (s *ServiceImp) RefreshToken(ctx context.Context, refreshToken string) (*storage.Token, error) {
+	claims, err := s.parseToken(refreshToken, storage.RefreshType)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRevocation(claims); err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	// Tokens issued before rotation have no family, they start a new one.
+	family, err := uuid.Parse(claims.Family)
+	if err != nil {
+		family = uuid.New()
+	}
+
+	token, err := s.generateToken(userID, family)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.RotateToken(&storage.Token{Refresh: refreshToken}, token)
+	if err == storage.ErrNotFound {
+		if claims.Family != "" {
+			s.logger.
+				WithField("user_id", claims.UserID).
+				WithField("family", claims.Family).
+				Warn("refresh token reuse, revoke token family")
+			if err := s.store.DeleteTokensByFamily(family); err != nil {
+				return nil, err
+			}
 		}
 
-		return s.privateKey.Public(), nil
-	})
+		return nil, ErrTokenReused
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if !token.Valid {
-		return nil, ErrInvalidToken
+	return token, nil
+}
+
+func (s *ServiceImp) RevokeToken(ctx context.Context, claims *storage.Claims, refreshToken string) error {
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	token, err := s.store.GetToken(refreshToken)
+	if err != nil {
+		return err
+	}
+	if token.UserID != userID {
+		return storage.ErrNotFound
+	}
+	if err := s.store.DeleteToken(token); err != nil {
+		return err
+	}
+	// tokens issued before jti was added can't be revoked one by one
+	if claims.Id == "" {
+		return nil
 	}
 
-	claim, ok := token.Claims.(*storage.Claims)
-	if !ok {
-		return nil, ErrInvalidToken
+	return s.store.SaveRevocation(&storage.Revocation{
+		TokenID:   claims.Id,
+		UserID:    userID,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	})
+}
+
+func (s *ServiceImp) RevokeAllTokens(ctx context.Context, userID uuid.UUID) error {
+	// iat has seconds precision, so tokens issued within the current second
+	// stay valid. Otherwise the user couldn't sign in again right away.
+	now := time.Now()
+	err := s.store.SaveRevocation(&storage.Revocation{
+		UserID:       userID,
+		IssuedBefore: now.Truncate(time.Second),
+		ExpiresAt:    now.Add(refreshTokenExpirationTime),
+	})
+	if err != nil {
+		return err
 	}
 
-	return claim, nil
+	return s.store.DeleteTokensByUser(userID)
 }
 
-// TODO(boodyvo): validate signature of refresh token
-func (s *ServiceImp) RefreshToken(ctx context.Context, refreshToken string) (*storage.Token, error) {
-	tokenOld, err := s.store.GetToken(refreshToken)
+func (s *ServiceImp) RevokeOtherSessions(ctx context.Context, claims *storage.Claims) error {
+	userID, err := uuid.Parse(claims.UserID)
 	if err != nil {
-		return nil, err
+		return ErrInvalidToken
 	}
-	token, err := s.generateToken(tokenOld.UserID)
+	tokens, err := s.store.ListTokensByUser(userID)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if err := s.store.SaveToken(token); err != nil {
+	// access tokens issued before they had family match no session, so all
+	// sessions are revoked
+	for _, token := range tokens {
+		if claims.Family != "" && token.Family.String() == claims.Family {
+			continue
+		}
+		if err := s.store.DeleteToken(token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ServiceImp) checkRevocation(claims *storage.Claims) error {
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	revoked, err := s.store.IsTokenRevoked(userID, claims.Id, time.Unix(claims.IssuedAt, 0))
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return ErrTokenRevoked
+	}
+
+	return nil
+}
+
+func (s *ServiceImp) parseToken(tokenString string, tokenType storage.TokenType) (*storage.Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &storage.Claims{}, s.keys.verificationKey)
+	if validationErr, ok := err.(*jwt.ValidationError); ok && validationErr.Inner == ErrUnknownKey {
+		return nil, ErrUnknownKey
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	return token, nil
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	claim, ok := token.Claims.(*storage.Claims)
+	if !ok || claim.Type != tokenType {
+		return nil, ErrInvalidToken
+	}
+
+	return claim, nil
 }
 
-func (s *ServiceImp) generateToken(userID uuid.UUID) (*storage.Token, error) {
-	expiresAtAccess := time.Now().Add(accessTokenExpirationTime)
+func (s *ServiceImp) generateToken(userID, family uuid.UUID) (*storage.Token, error) {
+	issuedAt := time.Now()
+	expiresAtAccess := issuedAt.Add(accessTokenExpirationTime)
 	claimsAccess := &storage.Claims{
 		UserID: userID.String(),
 		Type:   storage.AccessType,
+		Family: family.String(),
 		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			IssuedAt:  issuedAt.Unix(),
 			ExpiresAt: expiresAtAccess.Unix(),
 		},
 	}
-	tokenJWTAccess := jwt.NewWithClaims(jwt.SigningMethodES384, claimsAccess)
-	accessToken, err := tokenJWTAccess.SignedString(s.privateKey)
+	accessToken, err := s.keys.sign(claimsAccess)
 	if err != nil {
 		return nil, err
 	}
 
-	expiresAtRefresh := time.Now().Add(refreshTokenExpirationTime)
+	expiresAtRefresh := issuedAt.Add(refreshTokenExpirationTime)
 	claimsRefresh := &storage.Claims{
 		UserID: userID.String(),
 		Type:   storage.RefreshType,
+		Family: family.String(),
 		StandardClaims: jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			IssuedAt:  issuedAt.Unix(),
 			ExpiresAt: expiresAtRefresh.Unix(),
 		},
 	}
-	tokenJWTRefresh := jwt.NewWithClaims(jwt.SigningMethodES384, claimsRefresh)
-	refreshToken, err := tokenJWTRefresh.SignedString(s.privateKey)
+	refreshToken, err := s.keys.sign(claimsRefresh)
 	if err != nil {
 		return nil, err
 	}
 
 	token := &storage.Token{
 		UserID:    userID,
+		Family:    family,
 		Access:    accessToken,
 		Refresh:   refreshToken,
 		ExpiresAt: expiresAtAccess,
@@ -143,3 +290,58 @@ func (s *ServiceImp) ParseAuthorizationHeader(ctx context.Context) (*storage.Cla
 
 	return s.VerifyToken(ctx, accessToken)
 }
+
+func (s *ServiceImp) JSONWebKeys(ctx context.Context) []*JSONWebKey {
+	return s.keys.JSONWebKeys()
+}
+
+func (s *ServiceImp) IssueOneTimeToken(
+	ctx context.Context,
+	userID uuid.UUID,
+	purpose storage.TokenPurpose,
+) (string, error) {
+	ttl := passwordResetExpirationTime
+	if purpose == storage.EmailVerificationPurpose {
+		ttl = emailVerificationExpirationTime
+	}
+	buf := make([]byte, oneTimeTokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	if err := s.store.DeleteOneTimeTokens(userID, purpose); err != nil {
+		return "", err
+	}
+	err := s.store.SaveOneTimeToken(&storage.OneTimeToken{
+		Hash:      storage.HashOneTimeToken(token),
+		UserID:    userID,
+		Purpose:   purpose,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *ServiceImp) ConsumeOneTimeToken(
+	ctx context.Context,
+	token string,
+	purpose storage.TokenPurpose,
+) (uuid.UUID, error) {
+	saved, err := s.store.ConsumeOneTimeToken(storage.HashOneTimeToken(token))
+	if err == storage.ErrNotFound {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// token of another purpose is consumed too, it's leaked anyway
+	if saved.Purpose != purpose || time.Now().After(saved.ExpiresAt) {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	return saved.UserID, nil
+}
@@ -1,10 +1,19 @@
 package auth
 
 import (
+	"errors"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var (
 	ErrInvalidToken = status.Error(codes.InvalidArgument, "invalid token")
+	ErrTokenReused  = status.Error(codes.Unauthenticated, "refresh token was already used")
+	ErrTokenRevoked = status.Error(codes.Unauthenticated, "token was revoked")
+	ErrUnknownKey   = status.Error(codes.Unauthenticated, "token is signed by unknown key")
+
+	ErrInvalidOneTimeToken = status.Error(codes.InvalidArgument, "token is invalid or expired")
+
+	ErrInvalidKey = errors.New("invalid private key")
 )
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/dgrijalva/jwt-go"
+)
+
+// Key is a signing key of the key ring. Retired keys are neither used for
+// signing nor for verification, they are kept only to be listed in config
+// until all tokens signed by them expire.
+type Key struct {
+	ID         string
+	PrivateKey *ecdsa.PrivateKey
+	Retired    bool
+}
+
+// NewKey returns key with ID computed as RFC 7638 thumbprint of its public key.
+func NewKey(privateKey *ecdsa.PrivateKey) *Key {
+	jwk := newJSONWebKey("", &privateKey.PublicKey)
+	thumbprint := sha256.Sum256([]byte(fmt.Sprintf(
+		`{"crv":"%s","kty":"%s","x":"%s","y":"%s"}`, jwk.Curve, jwk.KeyType, jwk.X, jwk.Y,
+	)))
+
+	return &Key{
+		ID:         base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+		PrivateKey: privateKey,
+	}
+}
+
+// ParsePrivateKey parses PEM encoded EC private key.
+func ParsePrivateKey(encoded string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// KeyRing signs tokens with the active key and verifies them with any
+// non-retired key, so keys could be rotated without invalidating sessions.
+type KeyRing struct {
+	active *Key
+	keys   map[string]*Key
+}
+
+func NewKeyRing(activeID string, keys ...*Key) (*KeyRing, error) {
+	ring := &KeyRing{keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		if _, ok := ring.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicated key id %q", key.ID)
+		}
+		ring.keys[key.ID] = key
+	}
+
+	active, ok := ring.keys[activeID]
+	if !ok || active.Retired {
+		return nil, fmt.Errorf("active key %q is not found", activeID)
+	}
+	ring.active = active
+
+	return ring, nil
+}
+
+func (k *KeyRing) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES384, claims)
+	token.Header["kid"] = k.active.ID
+
+	return token.SignedString(k.active.PrivateKey)
+}
+
+// verificationKey is jwt.Keyfunc. Tokens without kid were signed before the
+// key ring was introduced, they are verified by the active key.
+func (k *KeyRing) verificationKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		return &k.active.PrivateKey.PublicKey, nil
+	}
+	key, ok := k.keys[kid]
+	if !ok || key.Retired {
+		return nil, ErrUnknownKey
+	}
+
+	return &key.PrivateKey.PublicKey, nil
+}
+
+// JSONWebKeys returns public keys, which are trusted for verification.
+func (k *KeyRing) JSONWebKeys() []*JSONWebKey {
+	keys := []*JSONWebKey{newJSONWebKey(k.active.ID, &k.active.PrivateKey.PublicKey)}
+	for _, key := range k.keys {
+		if key.Retired || key == k.active {
+			continue
+		}
+		keys = append(keys, newJSONWebKey(key.ID, &key.PrivateKey.PublicKey))
+	}
+
+	return keys
+}
+
+// JSONWebKey is a public key in RFC 7517 format.
+type JSONWebKey struct {
+	KeyType   string
+	ID        string
+	Use       string
+	Algorithm string
+	Curve     string
+	X         string
+	Y         string
+}
+
+func newJSONWebKey(id string, publicKey *ecdsa.PublicKey) *JSONWebKey {
+	size := (publicKey.Curve.Params().BitSize + 7) / 8
+
+	return &JSONWebKey{
+		KeyType:   "EC",
+		ID:        id,
+		Use:       "sig",
+		Algorithm: jwt.SigningMethodES384.Alg(),
+		Curve:     publicKey.Curve.Params().Name,
+		X:         base64.RawURLEncoding.EncodeToString(padCoordinate(publicKey.X.Bytes(), size)),
+		Y:         base64.RawURLEncoding.EncodeToString(padCoordinate(publicKey.Y.Bytes(), size)),
+	}
+}
+
+// padCoordinate left pads coordinate with zeros up to the curve size as
+// RFC 7518 requires.
+func padCoordinate(coordinate []byte, size int) []byte {
+	if len(coordinate) >= size {
+		return coordinate
+	}
+
+	return append(make([]byte, size-len(coordinate)), coordinate...)
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) *Key {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err, "cannot generate private key")
+
+	return NewKey(privateKey)
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	r := require.New(t)
+	s := newTestService(t)
+	ctx := context.Background()
+
+	old := s.keys.active
+	token, err := s.generateToken(uuid.New(), uuid.New())
+	r.NoError(err)
+	parsed, _, err := new(jwt.Parser).ParseUnverified(token.Access, &jwt.StandardClaims{})
+	r.NoError(err)
+	r.Equal(old.ID, parsed.Header["kid"])
+
+	key := newTestKey(t)
+	s.keys, err = NewKeyRing(key.ID, old, key)
+	r.NoError(err)
+	_, err = s.VerifyToken(ctx, token.Access)
+	r.NoError(err, "token signed by previous key is not verified")
+
+	rotated, err := s.generateToken(uuid.New(), uuid.New())
+	r.NoError(err)
+	parsed, _, err = new(jwt.Parser).ParseUnverified(rotated.Access, &jwt.StandardClaims{})
+	r.NoError(err)
+	r.Equal(key.ID, parsed.Header["kid"])
+	r.Len(s.keys.JSONWebKeys(), 2)
+
+	old.Retired = true
+	_, err = s.VerifyToken(ctx, token.Access)
+	r.Equal(ErrUnknownKey, err)
+	_, err = s.VerifyToken(ctx, rotated.Access)
+	r.NoError(err)
+	r.Len(s.keys.JSONWebKeys(), 1)
+
+	_, err = NewKeyRing(old.ID, old, key)
+	r.Error(err, "retired key is active")
+}
+
+func TestKeyRingLegacyToken(t *testing.T) {
+	r := require.New(t)
+	s := newTestService(t)
+
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodES384, jwt.StandardClaims{}).
+		SignedString(s.keys.active.PrivateKey)
+	r.NoError(err)
+	_, err = jwt.Parse(legacy, s.keys.verificationKey)
+	r.NoError(err, "token without kid is not verified by active key")
+
+	_, err = jwt.Parse(legacy, func(token *jwt.Token) (interface{}, error) {
+		token.Header["kid"] = "unknown"
+		return s.keys.verificationKey(token)
+	})
+	r.Error(err)
+}
+
+func TestJSONWebKey(t *testing.T) {
+	r := require.New(t)
+	key := newTestKey(t)
+
+	jwk := newJSONWebKey(key.ID, &key.PrivateKey.PublicKey)
+	r.Equal("EC", jwk.KeyType)
+	r.Equal("P-384", jwk.Curve)
+	r.Equal("ES384", jwk.Algorithm)
+	r.Len(jwk.X, 64, "coordinate is not padded to the curve size")
+	r.Len(jwk.Y, 64, "coordinate is not padded to the curve size")
+	r.Equal(key.ID, NewKey(key.PrivateKey).ID, "key id is not stable")
+	r.NotEqual(key.ID, newTestKey(t).ID)
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	defaultBackfillRate        = 1
+	defaultBackfillConcurrency = 1
+	// minBackfillRate keeps the interval of lookups from overflowing
+	minBackfillRate = 0.01
+)
+
+type backfillResult struct {
+	trackingID uuid.UUID
+	err        error
+}
+
+// BackfillWeather looks up weather again for trackings with missing weather.
+// Lookups are made by request.Concurrency workers, and all of them together
+// make at most request.Rate lookups per second.
+func (s *APIServer) BackfillWeather(request *pb.BackfillWeatherRequest, stream pb.APIService_BackfillWeatherServer) error {
+	s.logger.
+		WithField("request", request).
+		Info("Get backfill weather request")
+	if err := request.Validate(); err != nil {
+		return ErrInvalidInputData
+	}
+	if request.Rate != 0 && request.Rate < minBackfillRate {
+		return ErrInvalidInputData
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	filter := &storage.TrackingFilter{Query: request.Query, MissingWeather: true, PerRequest: 1}
+	missing, err := s.store.ListTrackings(filter)
+	if err != nil {
+		return ErrInvalidFilter
+	}
+	s.audit(ctx, storage.BackfillWeatherAuditAction, allTrackingsResource, nil, nil)
+
+	progress := &pb.BackfillWeatherResponse{Total: missing.Total}
+	if err := stream.Send(progress); err != nil {
+		return err
+	}
+
+	rate := request.Rate
+	if rate == 0 {
+		rate = defaultBackfillRate
+	}
+	concurrency := int(request.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultBackfillConcurrency
+	}
+	// every lookup waits for the tick, so the rate is shared by workers
+	limiter := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer limiter.Stop()
+
+	trackings := make(chan *storage.Tracking)
+	walkErr := make(chan error, 1)
+	go func() {
+		defer close(trackings)
+		filter.Cursor = ""
+		walkErr <- s.walkTrackings(s.store.ListTrackings, filter, func(tracking *storage.Tracking) error {
+			select {
+			case trackings <- tracking:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	results := make(chan *backfillResult)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for tracking := range trackings {
+				select {
+				case <-limiter.C:
+				case <-ctx.Done():
+					return
+				}
+				result := &backfillResult{
+					trackingID: tracking.ID,
+					err:        s.backfillTracking(tracking),
+				}
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		progress.TrackingId = result.trackingID.String()
+		progress.Error = ""
+		if result.err != nil {
+			progress.Failed++
+			progress.Error = result.err.Error()
+		} else {
+			progress.Done++
+		}
+		if err := stream.Send(progress); err != nil {
+			return err
+		}
+	}
+
+	if err := <-walkErr; err != nil {
+		s.logger.WithField("err", err).Error("error during weather backfill")
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *APIServer) backfillTracking(tracking *storage.Tracking) error {
+	weatherData, err := s.weather.GetWeather(tracking.Date, tracking.Location)
+	if err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("tracking", tracking).
+			Info("cannot backfill weather for tracking")
+
+		return err
+	}
+
+	return s.store.SetTrackingWeather(tracking.ID, weatherData)
+}
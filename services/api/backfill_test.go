@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"gopkg.in/mgo.v2/bson"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/services/api/mailer"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+type weatherFunc func(time time.Time, location storage.Location) (*storage.Weather, error)
+
+func (f weatherFunc) GetWeather(time time.Time, location storage.Location) (*storage.Weather, error) {
+	return f(time, location)
+}
+
+type backfillStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*pb.BackfillWeatherResponse
+}
+
+func (s *backfillStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *backfillStream) Send(response *pb.BackfillWeatherResponse) error {
+	res := *response
+	s.responses = append(s.responses, &res)
+
+	return nil
+}
+
+func TestBackfillWeather(t *testing.T) {
+	r := require.New(t)
+	_, store, authService := newTestInterceptor(t)
+
+	var mu sync.Mutex
+	lookups := 0
+	service := weatherFunc(func(_ time.Time, location storage.Location) (*storage.Weather, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		lookups++
+		if location.Latitude < 0 {
+			return nil, errors.New("unavailable")
+		}
+
+		return &storage.Weather{Temperature: float32(location.Latitude)}, nil
+	})
+	server := New(store, authService, service, mailer.NewFile("", log.New()), nil, "", DefaultSignInLimits, nil, log.New()).(*APIServer)
+
+	admin := storage.NewUser("admin@gmail.com", "")
+	ctx := context.WithValue(context.Background(), principalKey{}, admin)
+	trackings := make([]*storage.Tracking, 0)
+	for _, latitude := range []float64{10, 20, -30, 40} {
+		tracking := &storage.Tracking{
+			ID:       uuid.New(),
+			UserID:   uuid.New(),
+			Cursor:   bson.NewObjectId(),
+			Date:     time.Now(),
+			Distance: float32(latitude),
+			Location: storage.Location{Latitude: latitude},
+			Weather:  &storage.Weather{},
+		}
+		if latitude == 40 {
+			tracking.Weather.Temperature = 1
+		}
+		r.NoError(store.SaveTracking(tracking))
+		trackings = append(trackings, tracking)
+	}
+
+	stream := &backfillStream{ctx: ctx}
+	r.NoError(server.BackfillWeather(&pb.BackfillWeatherRequest{Query: "distance gt 15.5", Rate: 50, Concurrency: 2}, stream))
+	last := stream.responses[len(stream.responses)-1]
+	r.Equal(int64(1), last.Total, "query is ignored")
+	r.Equal(int64(1), last.Done)
+	r.Equal(trackings[1].ID.String(), last.TrackingId)
+
+	stream = &backfillStream{ctx: ctx}
+	r.NoError(server.BackfillWeather(&pb.BackfillWeatherRequest{Rate: 50, Concurrency: 2}, stream))
+	r.Len(stream.responses, 3)
+	r.Equal(&pb.BackfillWeatherResponse{Total: 2}, stream.responses[0])
+	last = stream.responses[len(stream.responses)-1]
+	r.Equal(int64(2), last.Total)
+	r.Equal(int64(1), last.Done)
+	r.Equal(int64(1), last.Failed)
+	r.Equal(3, lookups, "weather is looked up for trackings with weather")
+
+	for i, temperature := range []float32{10, 20, 0, 1} {
+		saved, err := store.GetTracking(trackings[i].ID)
+		r.NoError(err)
+		r.Equal(temperature, saved.Weather.Temperature)
+	}
+
+	events, err := store.ListAuditEvents(&storage.AuditEventFilter{TargetItem: allTrackingsResource.Item})
+	r.NoError(err)
+	r.Len(events.Events, 2)
+	r.Equal(storage.BackfillWeatherAuditAction, events.Events[0].Action)
+
+	err = server.BackfillWeather(&pb.BackfillWeatherRequest{Concurrency: 100}, &backfillStream{ctx: ctx})
+	r.Equal(ErrInvalidInputData, err)
+	err = server.BackfillWeather(&pb.BackfillWeatherRequest{Rate: 1e-10}, &backfillStream{ctx: ctx})
+	r.Equal(ErrInvalidInputData, err, "rate with overflowing interval is allowed")
+	err = server.BackfillWeather(&pb.BackfillWeatherRequest{Query: "invalid query"}, &backfillStream{ctx: ctx})
+	r.Equal(ErrInvalidFilter, err)
+}
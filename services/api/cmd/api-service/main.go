@@ -1,20 +1,25 @@
 package main
 
 import (
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/oklog/run"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/boodyvo/jogging-api/lib"
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 	"github.com/boodyvo/jogging-api/services/api"
 	"github.com/boodyvo/jogging-api/services/api/auth"
+	"github.com/boodyvo/jogging-api/services/api/mailer"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/memory"
 	"github.com/boodyvo/jogging-api/services/api/storage/mongo"
+	"github.com/boodyvo/jogging-api/services/api/storage/postgres"
 	"github.com/boodyvo/jogging-api/services/api/weather"
+	"github.com/boodyvo/jogging-api/services/enricher/broker"
 )
 
 func main() {
@@ -28,25 +33,110 @@ func main() {
 		logger.Fatal("cannot parse the config", err)
 	}
 
-	store, err := mongo.New(config.MongoUrl, config.DatabaseName)
-	if err != nil {
-		logger.Fatal("cannot create a storage", err)
+	var store storage.Storage
+	switch config.Storage {
+	case "memory":
+		store = memory.New()
+	case "postgres":
+		store, err = postgres.New(config.PostgresDSN)
+		if err != nil {
+			logger.Fatal("cannot create a storage", err)
+		}
+	default:
+		store, err = mongo.New(config.MongoUrl, config.DatabaseName, logger)
+		if err != nil {
+			logger.Fatal("cannot create a storage", err)
+		}
+	}
+
+	if err := storage.SeedRoles(store); err != nil {
+		logger.Fatal("cannot seed roles", err)
 	}
 
 	// TODO(boodyvo): Read from env/file, not from flag
-	block, _ := pem.Decode([]byte(config.PrivateKey))
-	x509Encoded := block.Bytes
-	privateKey, err := x509.ParseECPrivateKey(x509Encoded)
+	keys, err := newKeyRing(config)
 	if err != nil {
-		logger.Fatal("cannot parse private key", err)
+		logger.Fatal("cannot create key ring", err)
 	}
 
-	authServer := auth.New(privateKey, store, logger)
-	weatherServer := weather.NewService(config.WeatherAppID, logger)
+	authServer := auth.New(keys, store, logger)
+	var weatherCacheStore storage.Storage
+	if config.WeatherCachePersistent {
+		weatherCacheStore = store
+	}
+	providers, err := weather.NewProviders(
+		strings.Split(config.WeatherProviders, ","),
+		map[string]string{
+			weather.MeteostatProvider:      config.MeteostatKey,
+			weather.OpenWeatherMapProvider: config.OpenWeatherMapKey,
+		},
+		logger,
+	)
+	if err != nil {
+		logger.Fatal("cannot create weather providers", err)
+	}
+	if len(providers) == 0 {
+		logger.Fatal("no weather providers")
+	}
+	cachedProviders := make([]weather.StationService, 0, len(providers))
+	for _, provider := range providers {
+		cache := weather.NewCachedService(provider, config.WeatherCacheSize, weatherCacheStore, logger)
+		cachedProviders = append(cachedProviders, cache)
+
+		statsQuit := make(chan struct{})
+		group.Add(func() error {
+			cache.LogStats(statsQuit)
+
+			return nil
+		}, func(err error) {
+			close(statsQuit)
+		})
+	}
+	weatherServer := weather.NewChain(logger, cachedProviders...)
+
+	var mail mailer.Mailer
+	switch config.Mailer {
+	case "file":
+		logger.Warn("emails with links are written to the file, use it only for local testing")
+		mail = mailer.NewFile(config.MailFile, logger)
+	case "log":
+		logger.Warn("emails with links are written to the log, use it only for local testing")
+		mail = mailer.NewFile("", logger)
+	default:
+		mail, err = mailer.NewSMTP(config.SMTPAddr, config.SMTPFrom, config.SMTPUser, config.SMTPPassword)
+		if err != nil {
+			logger.Fatalf("cannot create a mailer, set --smtp_addr or --mailer=log for local testing: %v", err)
+		}
+	}
+
+	signInLimits := api.DefaultSignInLimits
+	signInLimits.Account.FreeFailures = config.SignInAccountFailures
+	signInLimits.Address.FreeFailures = config.SignInAddressFailures
+
+	var events broker.Broker
+	if config.NatsURL != "" {
+		events, err = broker.NewNATS(config.NatsURL)
+		if err != nil {
+			logger.Fatal("cannot connect to the broker", err)
+		}
+	}
+
+	trustedProxies, err := api.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		logger.Fatal("cannot parse trusted proxies", err)
+	}
 
-	server := api.New(store, authServer, weatherServer, logger)
+	server := api.New(store, authServer, weatherServer, mail, events, config.PublicURL, signInLimits, trustedProxies, logger)
 
-	s := grpc.NewServer()
+	interceptor, err := api.NewAuthInterceptor(store, authServer, config.RequireVerifiedEmail, logger)
+	if err != nil {
+		logger.Fatal("cannot create auth interceptor", err)
+	}
+	s := grpc.NewServer(
+		grpc.MaxRecvMsgSize(lib.MaxMessageSize),
+		grpc.UnaryInterceptor(interceptor.Unary),
+		grpc.StreamInterceptor(interceptor.Stream),
+	)
 	pb.RegisterAPIServiceServer(s, server)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Port))
@@ -54,13 +144,17 @@ func main() {
 		logger.Fatalf("failed to listen: %v", err)
 	}
 
-	// TODO(boodyvo): Separate into another service with message broker
-	group.Add(func() error {
-		err := server.Start()
-		logger.Infof("finish server: %v", err)
+	if events == nil {
+		worker := weather.NewWorker(store, weatherServer, config.WeatherWorkers, logger)
+		group.Add(func() error {
+			err := worker.Run()
+			logger.Infof("finish weather worker: %v", err)
 
-		return err
-	}, func(err error) {})
+			return err
+		}, func(err error) {
+			worker.Stop()
+		})
+	}
 
 	logger.Infof("start listening api-service on port %d", config.Port)
 	group.Add(func() error {
@@ -72,3 +166,33 @@ func main() {
 
 	logger.Infof("api-service terminated: %v", group.Run())
 }
+
+func newKeyRing(config *Config) (*auth.KeyRing, error) {
+	privateKey, err := auth.ParsePrivateKey(config.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse private key: %w", err)
+	}
+	defaultKey := auth.NewKey(privateKey)
+	keys := []*auth.Key{defaultKey}
+	for id, encoded := range config.PrivateKeys {
+		privateKey, err := auth.ParsePrivateKey(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse private key %q: %w", id, err)
+		}
+		keys = append(keys, &auth.Key{ID: id, PrivateKey: privateKey})
+	}
+	for _, key := range keys {
+		for _, id := range config.RetiredKeyIDs {
+			if key.ID == id {
+				key.Retired = true
+			}
+		}
+	}
+
+	activeID := config.ActiveKeyID
+	if activeID == "" {
+		activeID = defaultKey.ID
+	}
+
+	return auth.NewKeyRing(activeID, keys...)
+}
@@ -3,9 +3,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc/metadata"
 
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 
@@ -38,6 +40,45 @@ var userCommand = &cli.Command{
 	},
 }
 
+var weatherCommand = &cli.Command{
+	Name:  "weather",
+	Usage: "Operation with weather of trackings",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "backfill",
+			Usage: "Look up weather again for trackings with missing weather",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "email",
+					Value: "",
+					Usage: "email of admin user",
+				},
+				&cli.StringFlag{
+					Name:  "password",
+					Value: "",
+					Usage: "password of admin user",
+				},
+				&cli.StringFlag{
+					Name:  "query",
+					Value: "",
+					Usage: "backfill only trackings matching the query",
+				},
+				&cli.Float64Flag{
+					Name:  "rate",
+					Value: 1,
+					Usage: "max weather lookups per second",
+				},
+				&cli.IntFlag{
+					Name:  "concurrency",
+					Value: 1,
+					Usage: "max weather lookups at once",
+				},
+			},
+			Action: backfillWeather,
+		},
+	},
+}
+
 func createAdminUser(ctx *cli.Context) error {
 	rpcaddr := ctx.String("rpcaddr")
 
@@ -61,6 +102,43 @@ func createAdminUser(ctx *cli.Context) error {
 	return nil
 }
 
+func backfillWeather(ctx *cli.Context) error {
+	rpcaddr := ctx.String("rpcaddr")
+
+	c, err := client.New(context.Background(), rpcaddr)
+	if err != nil {
+		return fmt.Errorf("unable to connect to api: %v", err)
+	}
+
+	signIn, err := c.SignIn(context.Background(), &pb.SignInRequest{
+		Email:    ctx.String("email"),
+		Password: ctx.String("password"),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot sign in: %v", err)
+	}
+
+	authCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+signIn.AccessToken)
+	stream, err := c.BackfillWeather(authCtx, &pb.BackfillWeatherRequest{
+		Query:       ctx.String("query"),
+		Rate:        ctx.Float64("rate"),
+		Concurrency: int32(ctx.Int("concurrency")),
+	})
+	if err != nil {
+		return fmt.Errorf("cannot backfill weather: %v", err)
+	}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("cannot backfill weather: %v", err)
+		}
+		printRespJSON(resp)
+	}
+}
+
 func printRespJSON(resp proto.Message) {
 	jsonMarshaler := &jsonpb.Marshaler{
 		EmitDefaults: true,
@@ -17,6 +17,7 @@ func main() {
 	app.Compiled = time.Now()
 	app.Commands = []*cli.Command{
 		userCommand,
+		weatherCommand,
 	}
 	app.Flags = []cli.Flag{
 		&cli.StringFlag{
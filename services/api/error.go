@@ -6,13 +6,26 @@ import (
 )
 
 var (
-	ErrInvalidInputData  = status.Error(codes.InvalidArgument, "invalid input data")
-	ErrInvalidEmail      = status.Error(codes.InvalidArgument, "email is invalid format")
-	ErrUserNotFound      = status.Error(codes.NotFound, "user not found")
-	ErrUserAlreadyExists = status.Error(codes.InvalidArgument, "user already exists")
-	ErrTrackingNotFound  = status.Error(codes.NotFound, "tracking not found")
-	ErrTokenNotFound     = status.Error(codes.NotFound, "token not found")
-	ErrUnauthorized      = status.Error(codes.Unauthenticated, "cannot parse authorization token")
-	ErrForbidden         = status.Error(codes.PermissionDenied, "forbidden")
-	ErrInvalidFilter     = status.Error(codes.InvalidArgument, "cannot parse filter")
+	ErrInvalidInputData     = status.Error(codes.InvalidArgument, "invalid input data")
+	ErrInvalidEmail         = status.Error(codes.InvalidArgument, "email is invalid format")
+	ErrUserNotFound         = status.Error(codes.NotFound, "user not found")
+	ErrUserAlreadyExists    = status.Error(codes.InvalidArgument, "user already exists")
+	ErrTrackingNotFound     = status.Error(codes.NotFound, "tracking not found")
+	ErrTokenNotFound        = status.Error(codes.NotFound, "token not found")
+	ErrUnauthorized         = status.Error(codes.Unauthenticated, "cannot parse authorization token")
+	ErrForbidden            = status.Error(codes.PermissionDenied, "forbidden")
+	ErrInvalidFilter        = status.Error(codes.InvalidArgument, "cannot parse filter")
+	ErrRoleNotFound         = status.Error(codes.NotFound, "role not found")
+	ErrRoleAlreadyExists    = status.Error(codes.AlreadyExists, "role already exists")
+	ErrBuiltInRole          = status.Error(codes.FailedPrecondition, "built-in role cannot be changed")
+	ErrPermissionNotFound   = status.Error(codes.NotFound, "permission not found")
+	ErrTeamNotFound         = status.Error(codes.NotFound, "team not found")
+	ErrInvitationNotFound   = status.Error(codes.NotFound, "invitation not found")
+	ErrTeamOwner            = status.Error(codes.FailedPrecondition, "team owner cannot leave the team")
+	ErrEmailNotVerified     = status.Error(codes.PermissionDenied, "email is not verified")
+	ErrEmailAlreadyVerified = status.Error(codes.FailedPrecondition, "email is already verified")
+	ErrMailNotSent          = status.Error(codes.Unavailable, "cannot send email")
+	ErrWrongPassword        = status.Error(codes.InvalidArgument, "current password is wrong")
+	ErrInvalidCredentials   = status.Error(codes.Unauthenticated, "invalid email or password")
+	ErrSignInLocked         = status.Error(codes.ResourceExhausted, "too many failed sign in attempts, try again later")
 )
@@ -0,0 +1,290 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/descriptor"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/services/api/auth"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+type principalKey struct{}
+
+type claimsKey struct{}
+
+// principal returns the user, who calls the method. It's set by
+// AuthInterceptor for every non-public method.
+func principal(ctx context.Context) *storage.User {
+	user, _ := ctx.Value(principalKey{}).(*storage.User)
+
+	return user
+}
+
+// principalClaims returns claims of the access token of the principal.
+func principalClaims(ctx context.Context) *storage.Claims {
+	claims, _ := ctx.Value(claimsKey{}).(*storage.Claims)
+
+	return claims
+}
+
+// authRule is pb.AuthRule resolved for the method.
+type authRule struct {
+	public bool
+	// permission is nil if any signed in user could call the method
+	permission *storage.Permission
+	// itemField is the name of the request struct field with the item id
+	itemField string
+	// allowUnverified lets users with unverified email call the method
+	allowUnverified bool
+}
+
+// AuthInterceptor authorizes calls by the auth rule of the method declared
+// in the proto file and puts the principal to the context.
+type AuthInterceptor struct {
+	auth   auth.Service
+	store  storage.Storage
+	logger *log.Logger
+	rules  map[string]*authRule
+	// requireVerifiedEmail restricts users with unverified email to methods,
+	// which allow them.
+	requireVerifiedEmail bool
+}
+
+func NewAuthInterceptor(
+	store storage.Storage,
+	auth auth.Service,
+	requireVerifiedEmail bool,
+	logger *log.Logger,
+) (*AuthInterceptor, error) {
+	rules, err := loadAuthRules()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthInterceptor{
+		auth:                 auth,
+		store:                store,
+		logger:               logger,
+		rules:                rules,
+		requireVerifiedEmail: requireVerifiedEmail,
+	}, nil
+}
+
+func (i *AuthInterceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := i.authorize(ctx, info.FullMethod, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (i *AuthInterceptor) Stream(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	rule, ok := i.rules[info.FullMethod]
+	if !ok || rule.itemField == "" {
+		ctx, err := i.authorize(stream.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+
+	// the item is known only after the request is received
+	return handler(srv, &authorizingStream{
+		WrappedServerStream: grpc_middleware.WrapServerStream(stream),
+		interceptor:         i,
+		method:              info.FullMethod,
+	})
+}
+
+func (i *AuthInterceptor) authorize(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	rule, ok := i.rules[method]
+	if !ok {
+		i.logger.WithField("method", method).Error("method has no auth rule")
+
+		return nil, ErrForbidden
+	}
+	if rule.public {
+		return ctx, nil
+	}
+
+	claims, err := i.auth.ParseAuthorizationHeader(ctx)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+	id, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	user, err := i.store.GetUser(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if i.requireVerifiedEmail && !user.EmailVerified && !rule.allowUnverified {
+		return nil, ErrEmailNotVerified
+	}
+	if err := storage.LoadRoles(i.store, user); err != nil {
+		return nil, err
+	}
+
+	if rule.permission != nil {
+		permission := *rule.permission
+		if rule.itemField != "" {
+			permission.Resource.Item = reflect.ValueOf(req).Elem().FieldByName(rule.itemField).String()
+		}
+		// owner is looked up only when roles and ACL don't grant the permission
+		if !user.HasPermission(permission) {
+			owner, err := i.owner(permission.Resource)
+			if err != nil {
+				return nil, err
+			}
+			if err := storage.LoadTeams(i.store, user); err != nil {
+				return nil, err
+			}
+			if !user.HasPermissionFor(permission, owner) {
+				return nil, ErrForbidden
+			}
+		}
+	}
+
+	ctx = context.WithValue(ctx, claimsKey{}, claims)
+
+	return context.WithValue(ctx, principalKey{}, user), nil
+}
+
+// owner returns id of the user, who owns the resource, or uuid.Nil if the
+// resource doesn't exist.
+func (i *AuthInterceptor) owner(resource storage.Resource) (uuid.UUID, error) {
+	id, err := uuid.Parse(resource.Item)
+	if err != nil {
+		return uuid.Nil, nil
+	}
+
+	switch resource.Scope {
+	case storage.UserScope:
+		return id, nil
+	case storage.TrackingScope:
+		tracking, err := i.store.GetTracking(id)
+		if err == storage.ErrNotFound {
+			return uuid.Nil, nil
+		}
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		return tracking.UserID, nil
+	default:
+		return uuid.Nil, nil
+	}
+}
+
+// authorizingStream authorizes the call on the first received message.
+type authorizingStream struct {
+	*grpc_middleware.WrappedServerStream
+	interceptor *AuthInterceptor
+	method      string
+	authorized  bool
+}
+
+func (s *authorizingStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.authorized {
+		return nil
+	}
+
+	ctx, err := s.interceptor.authorize(s.WrappedServerStream.Context(), s.method, m)
+	if err != nil {
+		return err
+	}
+	s.WrappedContext = ctx
+	s.authorized = true
+
+	return nil
+}
+
+// loadAuthRules reads auth rules of APIService methods from the descriptor
+// of api.proto.
+func loadAuthRules() (map[string]*authRule, error) {
+	file, _ := descriptor.ForMessage(&pb.AuthRule{})
+	rules := make(map[string]*authRule)
+	for _, service := range file.GetService() {
+		for _, method := range service.GetMethod() {
+			fullMethod := fmt.Sprintf("/%s.%s/%s", file.GetPackage(), service.GetName(), method.GetName())
+			if method.GetOptions() == nil {
+				return nil, fmt.Errorf("method %s has no auth rule", fullMethod)
+			}
+			ext, err := proto.GetExtension(method.GetOptions(), pb.E_Auth)
+			if err != nil {
+				return nil, fmt.Errorf("method %s has no auth rule: %w", fullMethod, err)
+			}
+
+			rule, err := newAuthRule(ext.(*pb.AuthRule), strings.TrimPrefix(method.GetInputType(), "."))
+			if err != nil {
+				return nil, fmt.Errorf("invalid auth rule of method %s: %w", fullMethod, err)
+			}
+			rules[fullMethod] = rule
+		}
+	}
+
+	return rules, nil
+}
+
+func newAuthRule(rule *pb.AuthRule, requestType string) (*authRule, error) {
+	if rule.Public {
+		return &authRule{public: true}, nil
+	}
+	if rule.Action == pb.Action_ACTION_UNSPECIFIED {
+		return &authRule{allowUnverified: rule.AllowUnverified}, nil
+	}
+
+	permission, err := storage.PermissionFromProto(rule.Action, rule.Scope, "*")
+	if err != nil {
+		return nil, err
+	}
+	if rule.ItemField == "" {
+		return &authRule{permission: &permission, allowUnverified: rule.AllowUnverified}, nil
+	}
+
+	requestStruct := proto.MessageType(requestType)
+	if requestStruct == nil {
+		return nil, fmt.Errorf("unknown request type %s", requestType)
+	}
+	for _, prop := range proto.GetProperties(requestStruct.Elem()).Prop {
+		if prop.OrigName != rule.ItemField {
+			continue
+		}
+		field, _ := requestStruct.Elem().FieldByName(prop.Name)
+		if field.Type.Kind() != reflect.String {
+			return nil, fmt.Errorf("item field %s is not a string", rule.ItemField)
+		}
+
+		return &authRule{permission: &permission, itemField: prop.Name, allowUnverified: rule.AllowUnverified}, nil
+	}
+
+	return nil, fmt.Errorf("request has no item field %s", rule.ItemField)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/mgo.v2/bson"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/services/api/auth"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/memory"
+)
+
+func newTestInterceptor(t *testing.T) (*AuthInterceptor, storage.Storage, auth.Service) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err, "cannot generate private key")
+	key := auth.NewKey(privateKey)
+	keys, err := auth.NewKeyRing(key.ID, key)
+	require.NoError(t, err, "cannot create key ring")
+
+	store := memory.New()
+	require.NoError(t, storage.SeedRoles(store), "cannot seed roles")
+	authService := auth.New(keys, store, log.New())
+	interceptor, err := NewAuthInterceptor(store, authService, false, log.New())
+	require.NoError(t, err, "cannot create interceptor")
+
+	return interceptor, store, authService
+}
+
+func TestAuthRules(t *testing.T) {
+	r := require.New(t)
+	interceptor, _, _ := newTestInterceptor(t)
+
+	r.True(interceptor.rules["/api.APIService/SignIn"].public)
+	r.True(interceptor.rules["/api.APIService/SignUp"].public)
+	r.False(interceptor.rules["/api.APIService/GetUser"].public)
+	r.Nil(interceptor.rules["/api.APIService/GetUser"].permission)
+
+	rule := interceptor.rules["/api.APIService/GetTracking"]
+	r.Equal(storage.NewPermission(storage.ReadAction, storage.TrackingScope, "*"), *rule.permission)
+	r.Equal("Id", rule.itemField)
+
+	_, err := newAuthRule(&pb.AuthRule{
+		Action:    pb.Action_ACTION_READ,
+		Scope:     pb.Scope_SCOPE_TRACKINGS,
+		ItemField: "unknown",
+	}, "api.GetTrackingRequest")
+	r.Error(err, "unknown item field is accepted")
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	r := require.New(t)
+	interceptor, store, authService := newTestInterceptor(t)
+
+	owner := storage.NewUser("owner@gmail.com", "password")
+	r.NoError(store.SaveUser(owner))
+	other := storage.NewUser("other@gmail.com", "password")
+	r.NoError(store.SaveUser(other))
+	admin := storage.NewAdmin("admin@gmail.com", "password")
+	r.NoError(store.SaveUser(admin))
+	tracking := &storage.Tracking{ID: uuid.New(), UserID: owner.ID, Cursor: bson.NewObjectId()}
+	r.NoError(store.SaveTracking(tracking))
+
+	withToken := func(user *storage.User) context.Context {
+		token, err := authService.GenerateToken(context.Background(), user)
+		r.NoError(err)
+
+		return metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("authorization", "Bearer "+token.Access),
+		)
+	}
+	call := func(ctx context.Context, method string, req interface{}) (*storage.User, error) {
+		var user *storage.User
+		_, err := interceptor.Unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				user = principal(ctx)
+
+				return &empty.Empty{}, nil
+			},
+		)
+
+		return user, err
+	}
+
+	user, err := call(context.Background(), "/api.APIService/SignIn", &pb.SignInRequest{})
+	r.NoError(err)
+	r.Nil(user, "public method has principal")
+
+	_, err = call(context.Background(), "/api.APIService/GetUser", &empty.Empty{})
+	r.Equal(ErrUnauthorized, err)
+
+	user, err = call(withToken(owner), "/api.APIService/GetUser", &empty.Empty{})
+	r.NoError(err)
+	r.Equal(owner.ID, user.ID)
+
+	request := &pb.GetTrackingRequest{Id: tracking.ID.String()}
+	user, err = call(withToken(owner), "/api.APIService/GetTracking", request)
+	r.NoError(err)
+	r.Equal(owner.ID, user.ID)
+	_, err = call(withToken(other), "/api.APIService/GetTracking", request)
+	r.Equal(ErrForbidden, err)
+	_, err = call(withToken(admin), "/api.APIService/GetTracking", request)
+	r.NoError(err)
+	_, err = call(withToken(owner), "/api.APIService/GetTracking", &pb.GetTrackingRequest{Id: uuid.New().String()})
+	r.Equal(ErrForbidden, err, "unknown tracking is allowed")
+
+	team := storage.NewTeam("team", other.ID)
+	team.Invite(owner.ID, storage.MemberTeamRole)
+	team.Accept(owner.ID)
+	r.NoError(store.SaveTeam(team))
+	_, err = call(withToken(other), "/api.APIService/GetTracking", request)
+	r.NoError(err, "coach cannot read tracking of member")
+	_, err = call(withToken(other), "/api.APIService/DeleteTracking", &pb.DeleteTrackingRequest{Id: tracking.ID.String()})
+	r.Equal(ErrForbidden, err, "coach can delete tracking of member")
+
+	_, err = call(withToken(owner), "/api.APIService/GetUserByID", &pb.GetUserRequest{Id: owner.ID.String()})
+	r.NoError(err)
+	_, err = call(withToken(owner), "/api.APIService/GetUserByID", &pb.GetUserRequest{Id: other.ID.String()})
+	r.Equal(ErrForbidden, err)
+	_, err = call(withToken(owner), "/api.APIService/ListTrackings", &pb.ListTrackingsRequest{})
+	r.Equal(ErrForbidden, err)
+	_, err = call(withToken(owner), "/api.APIService/ChangeUserPassword", &pb.ChangeUserPasswordRequest{UserId: owner.ID.String()})
+	r.Equal(ErrForbidden, err, "user can change own password without the current one")
+	_, err = call(withToken(admin), "/api.APIService/ChangeUserPassword", &pb.ChangeUserPasswordRequest{UserId: owner.ID.String()})
+	r.NoError(err)
+
+	interceptor.requireVerifiedEmail = true
+	_, err = call(withToken(owner), "/api.APIService/CreateTracking", &pb.CreateTrackingRequest{})
+	r.Equal(ErrEmailNotVerified, err, "unverified user is not restricted")
+	_, err = call(withToken(owner), "/api.APIService/ResendVerification", &empty.Empty{})
+	r.NoError(err)
+	_, err = call(context.Background(), "/api.APIService/VerifyEmail", &pb.VerifyEmailRequest{})
+	r.NoError(err)
+	owner.EmailVerified = true
+	r.NoError(store.UpdateUser(owner))
+	_, err = call(withToken(owner), "/api.APIService/CreateTracking", &pb.CreateTrackingRequest{})
+	r.NoError(err)
+	interceptor.requireVerifiedEmail = false
+
+	_, err = call(withToken(owner), "/api.APIService/Unknown", &empty.Empty{})
+	r.Equal(ErrForbidden, err, "method without rule is allowed")
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/boodyvo/jogging-api/services/api/mailer"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	passwordResetPath     = "/reset-password"
+	emailVerificationPath = "/verify-email"
+)
+
+// sendOneTimeToken issues the token with the purpose and mails the link with
+// it to the user.
+func (s *APIServer) sendOneTimeToken(ctx context.Context, user *storage.User, purpose storage.TokenPurpose) error {
+	token, err := s.auth.IssueOneTimeToken(ctx, user.ID, purpose)
+	if err != nil {
+		return err
+	}
+
+	var message *mailer.Message
+	switch purpose {
+	case storage.PasswordResetPurpose:
+		message = &mailer.Message{
+			To:      user.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf(
+				"Follow the link to set a new password:\n\n%s\n\n"+
+					"The link expires in an hour. Ignore this email if you didn't request it.\n",
+				s.link(passwordResetPath, token),
+			),
+		}
+	default:
+		message = &mailer.Message{
+			To:      user.Email,
+			Subject: "Verify your email",
+			Body: fmt.Sprintf(
+				"Follow the link to verify your email:\n\n%s\n\nThe link expires in a day.\n",
+				s.link(emailVerificationPath, token),
+			),
+		}
+	}
+
+	return s.mailer.Send(ctx, message)
+}
+
+func (s *APIServer) link(path, token string) string {
+	return s.publicURL + path + "?" + url.Values{"token": {token}}.Encode()
+}
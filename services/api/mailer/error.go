@@ -0,0 +1,9 @@
+package mailer
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidMessage = errors.New("message headers contain line breaks")
+)
@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// FileMailer doesn't send messages, but appends them to the file as JSON
+// lines or writes them to the log. It's intended for local development and
+// tests.
+type FileMailer struct {
+	mu     sync.Mutex
+	path   string
+	logger *log.Logger
+}
+
+// NewFile creates mailer, which appends messages to the file at path. With
+// empty path messages are written to the log.
+func NewFile(path string, logger *log.Logger) Mailer {
+	return &FileMailer{
+		path:   path,
+		logger: logger,
+	}
+}
+
+func (m *FileMailer) Send(_ context.Context, message *Message) error {
+	if m.path == "" {
+		m.logger.
+			WithField("message", message).
+			Info("send mail")
+
+		return nil
+	}
+
+	buf, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(buf, '\n')); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
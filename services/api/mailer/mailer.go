@@ -0,0 +1,15 @@
+package mailer
+
+import (
+	"context"
+)
+
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+type Mailer interface {
+	Send(ctx context.Context, message *Message) error
+}
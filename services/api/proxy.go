@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// DefaultTrustedProxies trust the gateway running on the same host.
+var DefaultTrustedProxies = []string{"127.0.0.0/8", "::1/128"}
+
+// TrustedProxies are networks of proxies, e.g. the gateway, which append
+// the address of their client to X-Forwarded-For. Entries added before the
+// last trusted proxy are set by the client and could be forged.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses addresses and networks in CIDR notation.
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+
+			continue
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %v", value, err)
+		}
+		proxies = append(proxies, network)
+	}
+
+	return proxies, nil
+}
+
+// Contains reports whether the address is of a trusted proxy.
+func (p TrustedProxies) Contains(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clientIP returns the address of the original client. It's the peer
+// address, unless the peer is a trusted proxy. Then X-Forwarded-For is
+// walked from the end, where proxies append addresses, to the first entry
+// which isn't a trusted proxy.
+func (s *APIServer) clientIP(ctx context.Context) string {
+	ip := peerIP(ctx)
+	if !s.trustedProxies.Contains(ip) {
+		return ip
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get(forwardedForHeader) {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !s.trustedProxies.Contains(hop) {
+			break
+		}
+	}
+
+	return ip
+}
+
+// peerIP returns the address of the caller, it's empty for calls without
+// peer, e.g. in tests.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
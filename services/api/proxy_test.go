@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// clientContext is the context of the call from the peer with the
+// X-Forwarded-For entries.
+func clientContext(peerIP string, forwardedFor ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 41234},
+	})
+	if len(forwardedFor) == 0 {
+		return ctx
+	}
+	md := metadata.MD{}
+	for _, value := range forwardedFor {
+		md.Append(forwardedForHeader, value)
+	}
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	r := require.New(t)
+
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.1", " 172.16.0.0/12", "::1"})
+	r.NoError(err)
+	r.True(proxies.Contains("10.0.0.1"))
+	r.False(proxies.Contains("10.0.0.2"))
+	r.True(proxies.Contains("172.20.1.5"))
+	r.True(proxies.Contains("::1"))
+	r.False(proxies.Contains("not an ip"))
+
+	_, err = ParseTrustedProxies([]string{"gateway"})
+	r.Error(err)
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	r.Error(err)
+}
+
+func TestClientIP(t *testing.T) {
+	r := require.New(t)
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	r.NoError(err)
+	server := &APIServer{trustedProxies: proxies}
+
+	r.Equal("", server.clientIP(context.Background()))
+	r.Equal("203.0.113.7", server.clientIP(clientContext("203.0.113.7")))
+	r.Equal(
+		"203.0.113.7",
+		server.clientIP(clientContext("203.0.113.7", "198.51.100.1")),
+		"X-Forwarded-For of untrusted peer is used",
+	)
+	r.Equal(
+		"203.0.113.7",
+		server.clientIP(clientContext("10.0.0.2", "198.51.100.1, 203.0.113.7")),
+		"forged entry before the one of the gateway is used",
+	)
+	r.Equal(
+		"203.0.113.7",
+		server.clientIP(clientContext("10.0.0.2", "198.51.100.1", "203.0.113.7, 10.0.0.3")),
+		"entry of the trusted proxy is used",
+	)
+	r.Equal(
+		"10.0.0.3",
+		server.clientIP(clientContext("10.0.0.2", "forged, 10.0.0.3")),
+		"invalid entry is used",
+	)
+	r.Equal("10.0.0.2", server.clientIP(clientContext("10.0.0.2")))
+}
@@ -1,9 +1,10 @@
 package api
 
 import (
+	"bufio"
 	"context"
-
-	"github.com/boodyvo/jogging-api/services/api/weather"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -12,71 +13,66 @@ import (
 
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 	"github.com/boodyvo/jogging-api/services/api/auth"
+	"github.com/boodyvo/jogging-api/services/api/mailer"
 	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/trackfile"
+	"github.com/boodyvo/jogging-api/services/api/weather"
+	"github.com/boodyvo/jogging-api/services/enricher"
+	"github.com/boodyvo/jogging-api/services/enricher/broker"
+)
+
+const (
+	walkTrackingsPerRequest = 100
+	// exportChunkSize is max size of the file part in a single export message.
+	exportChunkSize = 32 << 10
 )
 
 type Server interface {
-	Start() error
-	Stop() error
 	pb.APIServiceServer
 }
 
 type APIServer struct {
-	auth    auth.Service
-	store   storage.Storage
-	logger  *log.Logger
+	auth   auth.Service
+	store  storage.Storage
+	logger *log.Logger
+	mailer mailer.Mailer
+	// weather is used by backfill only, weather of new trackings is set by
+	// weather jobs or by enricher-service
 	weather weather.Service
-
-	wq   chan *storage.Tracking
-	quit chan struct{}
+	// events wake up enricher-service to run saved weather jobs, they are run
+	// by weather.Worker without it
+	events broker.Broker
+	// publicURL is the base of links sent by email
+	publicURL    string
+	signInLimits SignInLimits
+	// trustedProxies are the only callers, whose X-Forwarded-For is used
+	trustedProxies TrustedProxies
 }
 
-func New(store storage.Storage, auth auth.Service, weather weather.Service, logger *log.Logger) Server {
+func New(
+	store storage.Storage,
+	auth auth.Service,
+	weather weather.Service,
+	mailer mailer.Mailer,
+	events broker.Broker,
+	publicURL string,
+	signInLimits SignInLimits,
+	trustedProxies TrustedProxies,
+	logger *log.Logger,
+) Server {
 	return &APIServer{
-		auth:    auth,
-		weather: weather,
-		store:   store,
-		logger:  logger,
-		wq:      make(chan *storage.Tracking, 100),
-		quit:    make(chan struct{}),
-	}
-}
-
-func (s *APIServer) Start() error {
-	for {
-		select {
-		case tracking := <-s.wq:
-			weatherData, err := s.weather.GetWeather(tracking.Date, tracking.Location)
-			if err != nil {
-				log.
-					WithField("err", err).
-					WithField("tracking", tracking).
-					Error("cannot get weather for tracking")
-
-				continue
-			}
-
-			tracking.Weather = weatherData
-			if err := s.store.UpdateTracking(tracking); err != nil {
-				log.
-					WithField("err", err).
-					WithField("tracking", tracking).
-					Error("cannot save weather for tracking")
-
-				continue
-			}
-		case <-s.quit:
-			return nil
-		}
+		auth:           auth,
+		weather:        weather,
+		mailer:         mailer,
+		events:         events,
+		publicURL:      strings.TrimSuffix(publicURL, "/"),
+		signInLimits:   signInLimits,
+		trustedProxies: trustedProxies,
+		store:          store,
+		logger:         logger,
 	}
 }
 
-func (s *APIServer) Stop() error {
-	s.quit <- struct{}{}
-
-	return nil
-}
-
 func (s *APIServer) CreateAdmin(_ context.Context, request *pb.CreateAdminRequest) (*pb.CreateAdminResponse, error) {
 	s.logger.
 		Info("Get create admin request")
@@ -95,6 +91,7 @@ func (s *APIServer) CreateAdmin(_ context.Context, request *pb.CreateAdminReques
 		return nil, ErrUserAlreadyExists
 	}
 	user := storage.NewAdmin(request.Email, hashedPassword)
+	user.EmailVerified = true
 	if err := s.store.SaveUser(user); err != nil {
 		return nil, err
 	}
@@ -112,10 +109,36 @@ func (s *APIServer) AddPermission(ctx context.Context, request *pb.AddPermission
 		return nil, ErrInvalidInputData
 	}
 
-	err := s.checkPermission(ctx, storage.UpdateAction, storage.PermissionsScope, "*")
+	permission, err := storage.PermissionFromProto(request.Action, request.Scope, request.Item)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(request.UserId)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	user, err := s.store.GetUser(id)
 	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	before := storage.AuditUser(user)
+	user.AddPermission(permission)
+	if err := s.store.UpdateUser(user); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, storage.AddPermissionAuditAction, userResource(user), before, storage.AuditUser(user))
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) RemovePermission(ctx context.Context, request *pb.RemovePermissionRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get remove permission request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
 	permission, err := storage.PermissionFromProto(request.Action, request.Scope, request.Item)
 	if err != nil {
 		return nil, err
@@ -128,30 +151,71 @@ func (s *APIServer) AddPermission(ctx context.Context, request *pb.AddPermission
 	if err != nil {
 		return nil, ErrUserNotFound
 	}
-	user.AddPermission(permission)
+	if !user.HasACLPermission(permission) {
+		return nil, ErrPermissionNotFound
+	}
+	before := storage.AuditUser(user)
+	user.RemovePermission(permission)
 	if err := s.store.UpdateUser(user); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, storage.RemovePermissionAuditAction, userResource(user), before, storage.AuditUser(user))
+	if err := s.auth.RevokeAllTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
 
 	return &empty.Empty{}, nil
 }
 
-func (s *APIServer) AddRole(ctx context.Context, request *pb.AddRoleRequest) (*empty.Empty, error) {
+func (s *APIServer) ListEffectivePermissions(
+	ctx context.Context,
+	request *pb.ListEffectivePermissionsRequest,
+) (*pb.ListEffectivePermissionsResponse, error) {
 	s.logger.
 		WithField("request", request).
-		Info("Get add role request")
+		Info("Get list effective permissions request")
 	if err := request.Validate(); err != nil {
 		return nil, ErrInvalidInputData
 	}
 
-	err := s.checkPermission(ctx, storage.UpdateAction, storage.PermissionsScope, "*")
+	id, err := uuid.Parse(request.UserId)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	user, err := s.store.GetUser(id)
 	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if err := storage.LoadRoles(s.store, user); err != nil {
 		return nil, err
 	}
-	role, err := storage.RoleFromProto(request.Role)
+
+	grants := user.EffectivePermissions()
+	permissions := make([]*pb.EffectivePermission, 0, len(grants))
+	for _, grant := range grants {
+		permissions = append(permissions, grant.ToProto())
+	}
+
+	return &pb.ListEffectivePermissionsResponse{
+		Permissions: permissions,
+	}, nil
+}
+
+func (s *APIServer) AddRole(ctx context.Context, request *pb.AddRoleRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get add role request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	roleID, err := requestedRoleID(request.RoleId, request.Role)
 	if err != nil {
 		return nil, err
 	}
+	if _, err := s.store.GetRole(roleID); err != nil {
+		return nil, ErrRoleNotFound
+	}
 	id, err := uuid.Parse(request.UserId)
 	if err != nil {
 		return nil, ErrUserNotFound
@@ -160,10 +224,12 @@ func (s *APIServer) AddRole(ctx context.Context, request *pb.AddRoleRequest) (*e
 	if err != nil {
 		return nil, ErrUserNotFound
 	}
-	user.AddRole(role)
+	before := storage.AuditUser(user)
+	user.AddRole(roleID)
 	if err := s.store.UpdateUser(user); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, storage.AddRoleAuditAction, userResource(user), before, storage.AuditUser(user))
 
 	return &empty.Empty{}, nil
 }
@@ -176,11 +242,7 @@ func (s *APIServer) RemoveRole(ctx context.Context, request *pb.RemoveRoleReques
 		return nil, ErrInvalidInputData
 	}
 
-	err := s.checkPermission(ctx, storage.UpdateAction, storage.PermissionsScope, "*")
-	if err != nil {
-		return nil, err
-	}
-	role, err := storage.RoleFromProto(request.Role)
+	roleID, err := requestedRoleID(request.RoleId, request.Role)
 	if err != nil {
 		return nil, err
 	}
@@ -192,15 +254,158 @@ func (s *APIServer) RemoveRole(ctx context.Context, request *pb.RemoveRoleReques
 	if err != nil {
 		return nil, ErrUserNotFound
 	}
-	user.RemoveRole(role)
+	before := storage.AuditUser(user)
+	user.RemoveRole(roleID)
 	if err := s.store.UpdateUser(user); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, storage.RemoveRoleAuditAction, userResource(user), before, storage.AuditUser(user))
+	if err := s.auth.RevokeAllTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) CreateRole(ctx context.Context, request *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get create role request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	permissions, err := storage.PermissionsFromProto(request.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	role := storage.NewRole(request.Name, permissions)
+	if err := s.store.SaveRole(role); err != nil {
+		if err == storage.ErrAlreadyExists {
+			return nil, ErrRoleAlreadyExists
+		}
+		return nil, err
+	}
+	s.audit(ctx, storage.CreateRoleAuditAction, roleResource(role), nil, role)
+
+	return &pb.CreateRoleResponse{
+		Id: role.ID.String(),
+	}, nil
+}
+
+func (s *APIServer) UpdateRole(ctx context.Context, request *pb.UpdateRoleRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get update role request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	role, err := s.getRole(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if role.BuiltIn {
+		return nil, ErrBuiltInRole
+	}
+	permissions, err := storage.PermissionsFromProto(request.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	before := *role
+	role.Name = request.Name
+	role.Permissions = permissions
+	if err := s.store.UpdateRole(role); err != nil {
+		if err == storage.ErrAlreadyExists {
+			return nil, ErrRoleAlreadyExists
+		}
+		return nil, err
+	}
+	s.audit(ctx, storage.UpdateRoleAuditAction, roleResource(role), &before, role)
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) DeleteRole(ctx context.Context, request *pb.DeleteRoleRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get delete role request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	role, err := s.getRole(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if role.BuiltIn {
+		return nil, ErrBuiltInRole
+	}
+	if err := s.store.DeleteRole(role.ID); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, storage.DeleteRoleAuditAction, roleResource(role), role, nil)
 
 	return &empty.Empty{}, nil
 }
 
-func (s *APIServer) SignUp(_ context.Context, request *pb.SignUpRequest) (*pb.SignUpResponse, error) {
+func (s *APIServer) GetRole(ctx context.Context, request *pb.GetRoleRequest) (*pb.GetRoleResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get get role request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	role, err := s.getRole(request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRoleResponse{
+		Role: role.ToProto(),
+	}, nil
+}
+
+func (s *APIServer) ListRoles(ctx context.Context, _ *empty.Empty) (*pb.ListRolesResponse, error) {
+	s.logger.
+		Info("Get list roles request")
+
+	roles, err := s.store.ListRoles()
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListRolesResponse{Roles: make([]*pb.RoleDefinition, 0, len(roles))}
+	for _, role := range roles {
+		response.Roles = append(response.Roles, role.ToProto())
+	}
+
+	return response, nil
+}
+
+func (s *APIServer) ListAuditEvents(
+	ctx context.Context,
+	request *pb.ListAuditEventsRequest,
+) (*pb.ListAuditEventsResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get list audit events request")
+
+	filter, err := storage.AuditEventFilterFromProto(request)
+	if err != nil {
+		return nil, ErrInvalidFilter
+	}
+	events, err := s.store.ListAuditEvents(filter)
+	if err != nil {
+		s.logger.WithField("err", err).Error("error during list audit events")
+
+		return nil, ErrInvalidFilter
+	}
+
+	return storage.ProtoFromListAuditEventsResponse(events), nil
+}
+
+func (s *APIServer) SignUp(ctx context.Context, request *pb.SignUpRequest) (*pb.SignUpResponse, error) {
 	s.logger.
 		Info("Get sign up request")
 	if err := request.Validate(); err != nil {
@@ -221,6 +426,13 @@ func (s *APIServer) SignUp(_ context.Context, request *pb.SignUpRequest) (*pb.Si
 	if err := s.store.SaveUser(user); err != nil {
 		return nil, err
 	}
+	// user could request the email again, so sign up doesn't fail
+	if err := s.sendOneTimeToken(ctx, user, storage.EmailVerificationPurpose); err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("user_id", user.ID).
+			Error("cannot send verification email")
+	}
 
 	return &pb.SignUpResponse{
 		Id: user.ID.String(),
@@ -231,12 +443,32 @@ func (s *APIServer) SignIn(ctx context.Context, request *pb.SignInRequest) (*pb.
 	s.logger.
 		Info("Get sign in request")
 
+	// responses and their timing are the same for unknown email and wrong
+	// password, and attempts are counted for unknown emails too, so users
+	// can't be found out by sign in
+	keys := s.signInKeys(request.Email, s.clientIP(ctx))
+	if err := s.checkSignInLock(keys); err != nil {
+		return nil, err
+	}
 	user, err := s.store.GetUserByEmail(request.Email)
-	if err != nil {
-		return nil, ErrUserNotFound
+	if err != nil && err != storage.ErrNotFound {
+		return nil, err
 	}
-	if !compare(user.Password, request.Password) {
-		return nil, ErrUserNotFound
+	hashedPassword := dummyPassword
+	if user != nil {
+		hashedPassword = user.Password
+	}
+	if !compare(hashedPassword, request.Password) || user == nil {
+		if err := s.recordSignInFailure(keys); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrInvalidCredentials
+	}
+	// failures of the address stay, so the address can't reset them by
+	// signing in to its own account
+	if err := s.store.DeleteLoginAttempts(keys[0].key); err != nil {
+		return nil, err
 	}
 
 	token, err := s.auth.GenerateToken(ctx, user)
@@ -254,25 +486,50 @@ func (s *APIServer) SignIn(ctx context.Context, request *pb.SignInRequest) (*pb.
 	}, nil
 }
 
-func (s *APIServer) GetUser(ctx context.Context, _ *empty.Empty) (*pb.GetUserResponse, error) {
+func (s *APIServer) UnlockSignIn(ctx context.Context, request *pb.UnlockSignInRequest) (*empty.Empty, error) {
 	s.logger.
-		Info("Get get user request")
-
-	claims, err := s.auth.ParseAuthorizationHeader(ctx)
-	if err != nil {
-		return nil, err
+		WithField("request", request).
+		Info("Get unlock sign in request")
+	if request.UserId == "" && request.Ip == "" {
+		return nil, ErrInvalidInputData
 	}
-	id, err := uuid.Parse(claims.UserID)
-	if err != nil {
-		return nil, ErrUserNotFound
+
+	target := allUsersResource
+	state := map[string]interface{}{}
+	if request.UserId != "" {
+		id, err := uuid.Parse(request.UserId)
+		if err != nil {
+			return nil, ErrUserNotFound
+		}
+		user, err := s.store.GetUser(id)
+		if err != nil {
+			return nil, ErrUserNotFound
+		}
+		for _, key := range s.signInKeys(user.Email, "") {
+			if err := s.store.DeleteLoginAttempts(key.key); err != nil {
+				return nil, err
+			}
+		}
+		target = userResource(user)
+		state["user_id"] = user.ID
 	}
-	user, err := s.store.GetUser(id)
-	if err != nil {
-		return nil, ErrUserNotFound
+	if request.Ip != "" {
+		if err := s.store.DeleteLoginAttempts(storage.AddressLoginKey(request.Ip)); err != nil {
+			return nil, err
+		}
+		state["ip"] = request.Ip
 	}
+	s.audit(ctx, storage.UnlockSignInAuditAction, target, nil, state)
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) GetUser(ctx context.Context, _ *empty.Empty) (*pb.GetUserResponse, error) {
+	s.logger.
+		Info("Get get user request")
 
 	return &pb.GetUserResponse{
-		User: user.ToProto(),
+		User: principal(ctx).ToProto(),
 	}, nil
 }
 
@@ -281,11 +538,6 @@ func (s *APIServer) GetUserByID(ctx context.Context, request *pb.GetUserRequest)
 		WithField("request", request).
 		Info("Get get user by id request")
 
-	err := s.checkPermission(ctx, storage.ReadAction, storage.UserScope, request.Id)
-	if err != nil {
-		return nil, err
-	}
-
 	idRes, err := uuid.Parse(request.Id)
 	if err != nil {
 		return nil, ErrUserNotFound
@@ -305,11 +557,6 @@ func (s *APIServer) ListUsers(ctx context.Context, request *pb.ListUsersRequest)
 		WithField("request", request).
 		Info("Get list users request")
 
-	err := s.checkPermission(ctx, storage.ReadAction, storage.UserScope, "*")
-	if err != nil {
-		return nil, err
-	}
-
 	filter, err := storage.UserFilterFromProto(request)
 	if err != nil {
 		return nil, ErrInvalidFilter
@@ -330,11 +577,6 @@ func (s *APIServer) ListUsersDetailed(ctx context.Context, request *pb.ListUsers
 		WithField("request", request).
 		Info("Get list detailed users request")
 
-	err := s.checkPermission(ctx, storage.ReadAction, storage.UserScope, "*")
-	if err != nil {
-		return nil, err
-	}
-
 	filter, err := storage.UserFilterFromProto(request)
 	if err != nil {
 		return nil, ErrInvalidFilter
@@ -354,15 +596,11 @@ func (s *APIServer) DeleteUser(ctx context.Context, _ *empty.Empty) (*empty.Empt
 	s.logger.
 		Info("Get delete user request")
 
-	claims, err := s.auth.ParseAuthorizationHeader(ctx)
-	if err != nil {
+	user := principal(ctx)
+	if err := s.store.DeleteUser(user.ID); err != nil {
 		return nil, err
 	}
-	id, err := uuid.Parse(claims.UserID)
-	if err != nil {
-		return nil, ErrUserNotFound
-	}
-	if err := s.store.DeleteUser(id); err != nil {
+	if err := s.auth.RevokeAllTokens(ctx, user.ID); err != nil {
 		return nil, err
 	}
 
@@ -374,87 +612,438 @@ func (s *APIServer) DeleteUserByID(ctx context.Context, request *pb.DeleteUserRe
 		WithField("request", request).
 		Info("Get delete user by id request")
 
-	err := s.checkPermission(ctx, storage.DeleteAction, storage.UserScope, request.Id)
+	idRes, err := uuid.Parse(request.Id)
 	if err != nil {
-		return nil, err
+		return nil, ErrUserNotFound
 	}
-
-	idRes, err := uuid.Parse(request.Id)
+	user, err := s.store.GetUser(idRes)
 	if err != nil {
 		return nil, ErrUserNotFound
 	}
 	if err := s.store.DeleteUser(idRes); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, storage.DeleteUserAuditAction, userResource(user), storage.AuditUser(user), nil)
+	if err := s.auth.RevokeAllTokens(ctx, idRes); err != nil {
+		return nil, err
+	}
 
 	return &empty.Empty{}, nil
 }
 
-func (s *APIServer) RefreshToken(ctx context.Context, request *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
-	token, err := s.auth.RefreshToken(ctx, request.RefreshToken)
-	if err != nil {
-		return nil, ErrTokenNotFound
+func (s *APIServer) ChangePassword(ctx context.Context, request *pb.ChangePasswordRequest) (*empty.Empty, error) {
+	s.logger.
+		Info("Get change password request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
 	}
 
-	return &pb.RefreshTokenResponse{
-		AccessToken:  token.Access,
-		RefreshToken: token.Refresh,
-		ExpireAt: &timestamp.Timestamp{
-			Seconds: token.ExpiresAt.Unix(),
-			Nanos:   int32(token.ExpiresAt.Nanosecond()),
-		},
-	}, nil
+	user := principal(ctx)
+	if !compare(user.Password, request.CurrentPassword) {
+		return nil, ErrWrongPassword
+	}
+	if err := s.setPassword(user, request.NewPassword); err != nil {
+		return nil, err
+	}
+	if err := s.auth.RevokeOtherSessions(ctx, principalClaims(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
 }
 
-func (s *APIServer) CreateTracking(ctx context.Context, request *pb.CreateTrackingRequest) (*pb.CreateTrackingResponse, error) {
+func (s *APIServer) ChangeEmail(ctx context.Context, request *pb.ChangeEmailRequest) (*empty.Empty, error) {
 	s.logger.
-		WithField("request", request).
-		Info("Get create tracking request")
-
+		Info("Get change email request")
 	if err := request.Validate(); err != nil {
 		return nil, ErrInvalidInputData
 	}
 
-	user, err := s.checkAuthorization(ctx)
-	if err != nil {
+	user := principal(ctx)
+	if !compare(user.Password, request.CurrentPassword) {
+		return nil, ErrWrongPassword
+	}
+	if err := s.setEmail(ctx, user, request.Email); err != nil {
 		return nil, err
 	}
 
-	tracking, err := storage.NewTrackingFromProto(request)
-	if err != nil {
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) ChangeUserPassword(
+	ctx context.Context,
+	request *pb.ChangeUserPasswordRequest,
+) (*empty.Empty, error) {
+	s.logger.
+		WithField("user_id", request.UserId).
+		Info("Get change user password request")
+	if err := request.Validate(); err != nil {
 		return nil, ErrInvalidInputData
 	}
-	tracking.UserID = user.ID
-	user.AddTrackingPermission(tracking.ID)
 
-	// TODO(boodyvo): Not atomic. Could be as transaction.
+	id, err := uuid.Parse(request.UserId)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	user, err := s.store.GetUser(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if err := s.checkCanManage(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.setPassword(user, request.NewPassword); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, storage.ChangePasswordAuditAction, userResource(user), nil, nil)
+	if err := s.auth.RevokeAllTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) ChangeUserEmail(ctx context.Context, request *pb.ChangeUserEmailRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get change user email request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	id, err := uuid.Parse(request.UserId)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	user, err := s.store.GetUser(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if err := s.checkCanManage(ctx, user); err != nil {
+		return nil, err
+	}
+	before := storage.AuditUser(user)
+	if err := s.setEmail(ctx, user, request.Email); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, storage.ChangeEmailAuditAction, userResource(user), before, storage.AuditUser(user))
+
+	return &empty.Empty{}, nil
+}
+
+// checkCanManage returns ErrForbidden if the user holds a permission the
+// principal lacks. Otherwise e.g. a manager could take over an admin account
+// by changing its password or email.
+func (s *APIServer) checkCanManage(ctx context.Context, user *storage.User) error {
+	if err := storage.LoadRoles(s.store, user); err != nil {
+		return err
+	}
+	actor := principal(ctx)
+	for _, grant := range user.EffectivePermissions() {
+		if !actor.HasPermission(grant.Permission) {
+			return ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// setPassword saves new password of the user. Password reset links sent
+// before can't be used anymore.
+func (s *APIServer) setPassword(user *storage.User, password string) error {
+	hashedPassword, err := hash(password)
+	if err != nil {
+		return err
+	}
+	user.Password = hashedPassword
+	if err := s.store.UpdateUser(user); err != nil {
+		return err
+	}
+
+	return s.store.DeleteOneTimeTokens(user.ID, storage.PasswordResetPurpose)
+}
+
+// setEmail saves new email of the user and sends the verification link to
+// it. Links sent to the previous email can't be used anymore.
+func (s *APIServer) setEmail(ctx context.Context, user *storage.User, email string) error {
+	if !isValidEmail(email) {
+		return ErrInvalidEmail
+	}
+	if email == user.Email {
+		return nil
+	}
+	user.Email = email
+	user.EmailVerified = false
 	if err := s.store.UpdateUser(user); err != nil {
+		if err == storage.ErrAlreadyExists {
+			return ErrUserAlreadyExists
+		}
+		return err
+	}
+	if err := s.store.DeleteOneTimeTokens(user.ID, storage.PasswordResetPurpose); err != nil {
+		return err
+	}
+	if err := s.sendOneTimeToken(ctx, user, storage.EmailVerificationPurpose); err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("user_id", user.ID).
+			Error("cannot send verification email")
+	}
+
+	return nil
+}
+
+func (s *APIServer) RefreshToken(ctx context.Context, request *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	token, err := s.auth.RefreshToken(ctx, request.RefreshToken)
+	if err != nil {
+		return nil, ErrTokenNotFound
+	}
+
+	return &pb.RefreshTokenResponse{
+		AccessToken:  token.Access,
+		RefreshToken: token.Refresh,
+		ExpireAt: &timestamp.Timestamp{
+			Seconds: token.ExpiresAt.Unix(),
+			Nanos:   int32(token.ExpiresAt.Nanosecond()),
+		},
+	}, nil
+}
+
+func (s *APIServer) Logout(ctx context.Context, request *pb.LogoutRequest) (*empty.Empty, error) {
+	s.logger.
+		Info("Get logout request")
+
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	if err := s.auth.RevokeToken(ctx, principalClaims(ctx), request.RefreshToken); err != nil {
+		if err == storage.ErrNotFound {
+			return nil, ErrTokenNotFound
+		}
 		return nil, err
 	}
-	if err := s.store.SaveTracking(tracking); err != nil {
-		// TODO(boodyvo): Need to remove permissions for unsaved tracking
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) LogoutAllSessions(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	s.logger.
+		Info("Get logout all sessions request")
+
+	user := principal(ctx)
+	if err := s.auth.RevokeAllTokens(ctx, user.ID); err != nil {
 		return nil, err
 	}
-	err = s.setWeather(ctx, tracking)
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) RequestPasswordReset(
+	ctx context.Context,
+	request *pb.RequestPasswordResetRequest,
+) (*empty.Empty, error) {
+	s.logger.
+		Info("Get request password reset request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	// the response is the same for unknown emails, so users can't be found
+	// out by email
+	user, err := s.store.GetUserByEmail(request.Email)
+	if err == storage.ErrNotFound {
+		return &empty.Empty{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sendOneTimeToken(ctx, user, storage.PasswordResetPurpose); err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("user_id", user.ID).
+			Error("cannot send password reset email")
+
+		return nil, ErrMailNotSent
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) ResetPassword(ctx context.Context, request *pb.ResetPasswordRequest) (*empty.Empty, error) {
+	s.logger.
+		Info("Get reset password request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	id, err := s.auth.ConsumeOneTimeToken(ctx, request.Token, storage.PasswordResetPurpose)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.store.GetUser(id)
+	if err != nil {
+		return nil, auth.ErrInvalidOneTimeToken
+	}
+	hashedPassword, err := hash(request.Password)
+	if err != nil {
+		return nil, err
+	}
+	user.Password = hashedPassword
+	// the link was received by email, so it's verified too
+	user.EmailVerified = true
+	if err := s.store.UpdateUser(user); err != nil {
+		return nil, err
+	}
+	if err := s.auth.RevokeAllTokens(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) VerifyEmail(ctx context.Context, request *pb.VerifyEmailRequest) (*empty.Empty, error) {
+	s.logger.
+		Info("Get verify email request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	id, err := s.auth.ConsumeOneTimeToken(ctx, request.Token, storage.EmailVerificationPurpose)
 	if err != nil {
+		return nil, err
+	}
+	user, err := s.store.GetUser(id)
+	if err != nil {
+		return nil, auth.ErrInvalidOneTimeToken
+	}
+	user.EmailVerified = true
+	if err := s.store.UpdateUser(user); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) ResendVerification(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	s.logger.
+		Info("Get resend verification request")
+
+	user := principal(ctx)
+	if user.EmailVerified {
+		return nil, ErrEmailAlreadyVerified
+	}
+	if err := s.sendOneTimeToken(ctx, user, storage.EmailVerificationPurpose); err != nil {
 		s.logger.
 			WithField("err", err).
-			WithField("tracking", tracking).
-			Info("error while setting weather")
+			WithField("user_id", user.ID).
+			Error("cannot send verification email")
+
+		return nil, ErrMailNotSent
 	}
 
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) GetJWKS(ctx context.Context, _ *empty.Empty) (*pb.GetJWKSResponse, error) {
+	s.logger.
+		Info("Get jwks request")
+
+	keys := s.auth.JSONWebKeys(ctx)
+	response := &pb.GetJWKSResponse{Keys: make([]*pb.JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, &pb.JSONWebKey{
+			Kty: key.KeyType,
+			Kid: key.ID,
+			Use: key.Use,
+			Alg: key.Algorithm,
+			Crv: key.Curve,
+			X:   key.X,
+			Y:   key.Y,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *APIServer) CreateTracking(ctx context.Context, request *pb.CreateTrackingRequest) (*pb.CreateTrackingResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get create tracking request")
+
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	user := principal(ctx)
+
+	tracking, err := storage.NewTrackingFromProto(request)
+	if err != nil {
+		return nil, ErrInvalidInputData
+	}
+	tracking.UserID = user.ID
+	if err := s.store.SaveTrackingWithWeatherJob(tracking, storage.NewWeatherJob(tracking.ID)); err != nil {
+		return nil, err
+	}
+	s.wakeWeatherJob(tracking)
+
 	return &pb.CreateTrackingResponse{Id: tracking.ID.String()}, nil
 }
 
+func (s *APIServer) ImportTrackings(ctx context.Context, request *pb.ImportTrackingsRequest) (*pb.ImportTrackingsResponse, error) {
+	files := request.Files
+	if len(request.File) > 0 || len(files) == 0 {
+		files = append([][]byte{request.File}, files...)
+	}
+	s.logger.
+		WithField("files", len(files)).
+		Info("Get import trackings request")
+
+	user := principal(ctx)
+
+	// all files are checked before saving, so the import isn't saved partly
+	var trackings []*storage.Tracking
+	for _, file := range files {
+		activities, err := trackfile.Parse(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, activity := range activities {
+			if err := activity.Validate(); err != nil {
+				return nil, err
+			}
+			tracking := activity.ToTracking()
+			tracking.UserID = user.ID
+			trackings = append(trackings, tracking)
+		}
+	}
+
+	jobs := make([]*storage.WeatherJob, 0, len(trackings))
+	for _, tracking := range trackings {
+		jobs = append(jobs, storage.NewWeatherJob(tracking.ID))
+	}
+	if err := s.store.SaveTrackingsWithWeatherJobs(trackings, jobs); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(trackings))
+	for _, tracking := range trackings {
+		ids = append(ids, tracking.ID.String())
+		s.wakeWeatherJob(tracking)
+	}
+
+	return &pb.ImportTrackingsResponse{Ids: ids}, nil
+}
+
 func (s *APIServer) GetTracking(ctx context.Context, request *pb.GetTrackingRequest) (*pb.GetTrackingResponse, error) {
 	s.logger.
 		WithField("request", request).
 		Info("Get get tracking request")
 
-	err := s.checkPermission(ctx, storage.ReadAction, storage.TrackingScope, request.Id)
-	if err != nil {
-		return nil, err
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
 	}
+
 	id, err := uuid.Parse(request.Id)
 	if err != nil {
 		return nil, ErrUserNotFound
@@ -463,20 +1052,22 @@ func (s *APIServer) GetTracking(ctx context.Context, request *pb.GetTrackingRequ
 	if err != nil {
 		return nil, err
 	}
+	response := tracking.ToProto()
+	if request.IncludeRoute {
+		response.Route = tracking.Route.Simplify(request.RouteTolerance).ToProto()
+	}
 
 	return &pb.GetTrackingResponse{
-		Tracking: tracking.ToProto(),
+		Tracking: response,
 	}, nil
 }
 
-func (s *APIServer) DeleteTracking(ctx context.Context, request *pb.DeleteTrackingRequest) (*empty.Empty, error) {
+func (s *APIServer) UpdateTracking(ctx context.Context, request *pb.UpdateTrackingRequest) (*empty.Empty, error) {
 	s.logger.
 		WithField("request", request).
-		Info("Get delete tracking request")
-
-	err := s.checkPermission(ctx, storage.DeleteAction, storage.TrackingScope, request.Id)
-	if err != nil {
-		return nil, err
+		Info("Get update tracking request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
 	}
 
 	id, err := uuid.Parse(request.Id)
@@ -485,39 +1076,75 @@ func (s *APIServer) DeleteTracking(ctx context.Context, request *pb.DeleteTracki
 	}
 	tracking, err := s.store.GetTracking(id)
 	if err != nil {
-		return nil, err
+		return nil, ErrTrackingNotFound
 	}
-	user, err := s.store.GetUser(tracking.UserID)
+	weatherChanged, err := tracking.ApplyUpdateFromProto(request.Tracking, request.UpdateMask)
 	if err != nil {
-		return nil, ErrUserNotFound
+		return nil, ErrInvalidInputData
 	}
-	user.AddTrackingPermission(tracking.ID)
-
-	if err := s.store.DeleteTracking(tracking.ID); err != nil {
-		return nil, err
+	if weatherChanged {
+		err = s.store.UpdateTrackingWithWeatherJob(tracking, storage.NewWeatherJob(tracking.ID))
+	} else {
+		err = s.store.UpdateTracking(tracking)
 	}
-	if err := s.store.UpdateUser(user); err != nil {
+	if err != nil {
 		return nil, err
 	}
+	if weatherChanged {
+		s.wakeWeatherJob(tracking)
+	}
 
 	return &empty.Empty{}, nil
 }
 
-func (s *APIServer) ListTrackingsForUser(ctx context.Context, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error) {
+func (s *APIServer) DeleteTracking(ctx context.Context, request *pb.DeleteTrackingRequest) (*empty.Empty, error) {
 	s.logger.
 		WithField("request", request).
-		Info("Get list trackings request")
+		Info("Get delete tracking request")
 
-	user, err := s.checkAuthorization(ctx)
+	id, err := uuid.Parse(request.Id)
 	if err != nil {
+		return nil, ErrTrackingNotFound
+	}
+	tracking, err := s.store.GetTracking(id)
+	if err != nil {
+		return nil, ErrTrackingNotFound
+	}
+	if err := s.store.DeleteTracking(id); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, storage.DeleteTrackingAuditAction, storage.Resource{
+		Scope: storage.TrackingScope,
+		Item:  tracking.ID.String(),
+	}, storage.AuditTracking(tracking), nil)
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) ListTrackingsForUser(ctx context.Context, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get list trackings request")
+
+	user := principal(ctx)
 
 	filter, err := storage.TrackingFilterFromProtoForUser(request, user)
 	if err != nil {
 		return nil, ErrInvalidFilter
 	}
-	trackings, err := s.store.ListTrackingsForUser(filter)
+	list := s.store.ListTrackingsForUser
+	if request.TeamId != "" {
+		team, err := s.getTeam(request.TeamId, user)
+		if err != nil {
+			return nil, err
+		}
+		if !team.IsCoach(user.ID) {
+			return nil, ErrForbidden
+		}
+		filter.UserIDs = team.Members
+		list = s.store.ListTrackingsForUsers
+	}
+	trackings, err := list(filter)
 	if err != nil {
 		s.logger.WithField("err", err).Error("error during list trackings")
 
@@ -533,11 +1160,6 @@ func (s *APIServer) ListTrackings(ctx context.Context, request *pb.ListTrackings
 		WithField("request", request).
 		Info("Get list all trackings request")
 
-	err := s.checkPermission(ctx, storage.ReadAction, storage.TrackingScope, "*")
-	if err != nil {
-		return nil, err
-	}
-
 	filter, err := storage.TrackingFilterFromProto(request)
 	if err != nil {
 		return nil, ErrInvalidFilter
@@ -548,25 +1170,82 @@ func (s *APIServer) ListTrackings(ctx context.Context, request *pb.ListTrackings
 
 		return nil, ErrInvalidFilter
 	}
+	s.audit(ctx, storage.ListAllTrackingsAuditAction, allTrackingsResource, nil, nil)
 	response := storage.ProtoFromListTrackingsResponse(trackings)
 
 	return response, nil
 }
 
-func (s *APIServer) Report(ctx context.Context, request *pb.ReportRequest) (*pb.ReportResponse, error) {
+func (s *APIServer) ExportTrackings(request *pb.ExportTrackingsRequest, stream pb.APIService_ExportTrackingsServer) error {
 	s.logger.
 		WithField("request", request).
-		Info("Get report request")
+		Info("Get export trackings request")
 
-	user, err := s.checkAuthorization(ctx)
+	ctx := stream.Context()
+	format, err := trackfile.FormatFromProto(request.Format)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	listRequest := &pb.ListTrackingsRequest{Query: request.Query}
+	var filter *storage.TrackingFilter
+	var list func(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error)
+	user := principal(ctx)
+	if request.AllUsers {
+		if !user.HasPermission(storage.ReadTrackingsPermission) {
+			return ErrForbidden
+		}
+		if filter, err = storage.TrackingFilterFromProto(listRequest); err != nil {
+			return ErrInvalidFilter
+		}
+		list = s.store.ListTrackings
+		s.audit(ctx, storage.ListAllTrackingsAuditAction, allTrackingsResource, nil, nil)
+	} else {
+		if filter, err = storage.TrackingFilterFromProtoForUser(listRequest, user); err != nil {
+			return ErrInvalidFilter
+		}
+		list = s.store.ListTrackingsForUser
 	}
 
+	writer := bufio.NewWriterSize(&exportWriter{stream: stream}, exportChunkSize)
+	encoder, err := trackfile.NewEncoder(format, writer)
+	if err != nil {
+		return err
+	}
+	err = s.walkTrackings(func(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+		response, err := list(filter)
+		if err != nil {
+			s.logger.WithField("err", err).Error("error during export trackings")
+
+			return nil, ErrInvalidFilter
+		}
+
+		return response, nil
+	}, filter, encoder.Encode)
+	if err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+func (s *APIServer) Report(ctx context.Context, request *pb.ReportRequest) (*pb.ReportResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get report request")
+
+	user := principal(ctx)
+
 	filter, err := storage.NewReportFilterFromProtoForUser(request, user)
 	if err != nil {
 		return nil, ErrInvalidFilter
 	}
+	if filter.UserID, err = s.trackingsOwner(user, request.UserId); err != nil {
+		return nil, err
+	}
 	report, err := s.store.GetReport(filter)
 	if err != nil {
 		s.logger.WithField("err", err).Error("error during getting report")
@@ -577,40 +1256,333 @@ func (s *APIServer) Report(ctx context.Context, request *pb.ReportRequest) (*pb.
 	return report.ToProto(), nil
 }
 
-// TODO(boodyvo): Implement message queue
-func (s *APIServer) setWeather(_ context.Context, tracking *storage.Tracking) error {
-	s.wq <- tracking
+func (s *APIServer) GetPersonalRecords(
+	ctx context.Context,
+	request *pb.GetPersonalRecordsRequest,
+) (*pb.GetPersonalRecordsResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get personal records request")
 
-	return nil
+	owner, err := s.trackingsOwner(principal(ctx), request.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	records := storage.NewPersonalRecords()
+	err = s.walkTrackings(s.store.ListTrackingsForUser, &storage.TrackingFilter{UserID: owner}, func(tracking *storage.Tracking) error {
+		records.Add(tracking)
+
+		return nil
+	})
+	if err != nil {
+		s.logger.WithField("err", err).Error("error during getting personal records")
+
+		return nil, err
+	}
+	records.Finish(time.Now())
+	for _, period := range []*storage.PeriodRecord{records.BiggestWeek, records.BiggestMonth} {
+		if period == nil {
+			continue
+		}
+		filter := &storage.TrackingFilter{UserID: owner, Query: period.TrackingsQuery()}
+		err := s.walkTrackings(s.store.ListTrackingsForUser, filter, func(tracking *storage.Tracking) error {
+			period.TrackingIDs = append(period.TrackingIDs, tracking.ID)
+
+			return nil
+		})
+		if err != nil {
+			s.logger.WithField("err", err).Error("error during getting trackings of personal records")
+
+			return nil, err
+		}
+	}
+
+	return records.ToProto(), nil
+}
+
+func (s *APIServer) CreateTeam(ctx context.Context, request *pb.CreateTeamRequest) (*pb.CreateTeamResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get create team request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	team := storage.NewTeam(request.Name, principal(ctx).ID)
+	if err := s.store.SaveTeam(team); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTeamResponse{Id: team.ID.String()}, nil
 }
 
-func (s *APIServer) checkPermission(ctx context.Context, action storage.Action, scope storage.Scope, item string) error {
-	user, err := s.checkAuthorization(ctx)
+func (s *APIServer) GetTeam(ctx context.Context, request *pb.GetTeamRequest) (*pb.GetTeamResponse, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get get team request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	team, err := s.getTeam(request.Id, principal(ctx))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	permission := storage.NewPermission(action, scope, item)
-	if !user.HasPermission(permission) {
-		return ErrForbidden
+	return &pb.GetTeamResponse{Team: team.ToProto()}, nil
+}
+
+func (s *APIServer) ListTeams(ctx context.Context, _ *empty.Empty) (*pb.ListTeamsResponse, error) {
+	s.logger.
+		Info("Get list teams request")
+
+	teams, err := s.store.ListTeamsForUser(principal(ctx).ID)
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListTeamsResponse{
+		Teams: make([]*pb.Team, 0, len(teams)),
+	}
+	for _, team := range teams {
+		response.Teams = append(response.Teams, team.ToProto())
 	}
 
-	return nil
+	return response, nil
 }
 
-func (s *APIServer) checkAuthorization(ctx context.Context) (*storage.User, error) {
-	claims, err := s.auth.ParseAuthorizationHeader(ctx)
+func (s *APIServer) DeleteTeam(ctx context.Context, request *pb.DeleteTeamRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get delete team request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	user := principal(ctx)
+	team, err := s.getTeam(request.Id, user)
 	if err != nil {
-		return nil, ErrUnauthorized
+		return nil, err
+	}
+	if team.OwnerID != user.ID {
+		return nil, ErrForbidden
+	}
+	if err := s.store.DeleteTeam(team.ID); err != nil {
+		return nil, err
 	}
-	id, err := uuid.Parse(claims.UserID)
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) InviteToTeam(ctx context.Context, request *pb.InviteToTeamRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get invite to team request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	role, err := storage.TeamRoleFromProto(request.Role)
 	if err != nil {
-		return nil, ErrUserNotFound
+		return nil, ErrInvalidInputData
 	}
-	user, err := s.store.GetUser(id)
+	user := principal(ctx)
+	team, err := s.getTeam(request.TeamId, user)
 	if err != nil {
-		return nil, ErrUserNotFound
+		return nil, err
+	}
+	if team.OwnerID != user.ID {
+		return nil, ErrForbidden
+	}
+	// the response is the same for unknown emails, so users can't be found
+	// out by email
+	invited, err := s.store.GetUserByEmail(request.Email)
+	if err == storage.ErrNotFound {
+		return &empty.Empty{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	team.Invite(invited.ID, role)
+	if err := s.store.UpdateTeam(team); err != nil {
+		return nil, err
 	}
 
-	return user, nil
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) AcceptTeamInvitation(
+	ctx context.Context,
+	request *pb.AcceptTeamInvitationRequest,
+) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get accept team invitation request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	user := principal(ctx)
+	team, err := s.getTeam(request.TeamId, user)
+	if err != nil {
+		return nil, err
+	}
+	if !team.Accept(user.ID) {
+		return nil, ErrInvitationNotFound
+	}
+	if err := s.store.UpdateTeam(team); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *APIServer) LeaveTeam(ctx context.Context, request *pb.LeaveTeamRequest) (*empty.Empty, error) {
+	s.logger.
+		WithField("request", request).
+		Info("Get leave team request")
+	if err := request.Validate(); err != nil {
+		return nil, ErrInvalidInputData
+	}
+
+	user := principal(ctx)
+	team, err := s.getTeam(request.TeamId, user)
+	if err != nil {
+		return nil, err
+	}
+	if team.OwnerID == user.ID {
+		return nil, ErrTeamOwner
+	}
+	team.Leave(user.ID)
+	if err := s.store.UpdateTeam(team); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// getTeam returns the team, if the user is in it. Teams of other users are
+// not found, so their ids are not disclosed.
+func (s *APIServer) getTeam(id string, user *storage.User) (*storage.Team, error) {
+	teamID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrTeamNotFound
+	}
+	team, err := s.store.GetTeam(teamID)
+	if err != nil {
+		return nil, ErrTeamNotFound
+	}
+	if !team.Includes(user.ID) {
+		return nil, ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+// trackingsOwner returns id of the user, whose trackings are requested, and
+// checks that the user could read them. It's the user itself by default.
+func (s *APIServer) trackingsOwner(user *storage.User, id string) (uuid.UUID, error) {
+	if id == "" {
+		return user.ID, nil
+	}
+	owner, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, ErrUserNotFound
+	}
+	if err := storage.LoadTeams(s.store, user); err != nil {
+		return uuid.Nil, err
+	}
+	if !user.HasPermissionFor(storage.ReadTrackingsPermission, owner) {
+		return uuid.Nil, ErrForbidden
+	}
+
+	return owner, nil
+}
+
+// walkTrackings calls fn for every tracking matching the filter. Pages are
+// requested by cursor one by one, so the whole history is never loaded at once.
+func (s *APIServer) walkTrackings(
+	list func(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error),
+	filter *storage.TrackingFilter,
+	fn func(tracking *storage.Tracking) error,
+) error {
+	filter.PerRequest = walkTrackingsPerRequest
+	for {
+		response, err := list(filter)
+		if err != nil {
+			return err
+		}
+		for _, tracking := range response.Trackings {
+			if err := fn(tracking); err != nil {
+				return err
+			}
+		}
+		if len(response.Trackings) < walkTrackingsPerRequest {
+			return nil
+		}
+		filter.Cursor = response.Trackings[len(response.Trackings)-1].Cursor.Hex()
+	}
+}
+
+// exportWriter sends everything written to it as export stream messages.
+type exportWriter struct {
+	stream pb.APIService_ExportTrackingsServer
+}
+
+func (w *exportWriter) Write(data []byte) (int, error) {
+	// stream message could be kept after Send returns, so data is copied
+	chunk := make([]byte, len(data))
+	copy(chunk, data)
+	if err := w.stream.Send(&pb.ExportTrackingsResponse{Data: chunk}); err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+func (s *APIServer) getRole(id string) (*storage.Role, error) {
+	roleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrRoleNotFound
+	}
+	role, err := s.store.GetRole(roleID)
+	if err != nil {
+		return nil, ErrRoleNotFound
+	}
+
+	return role, nil
+}
+
+// requestedRoleID returns id of the role set in request either by id or as
+// built-in role.
+func requestedRoleID(id string, builtIn pb.Role) (uuid.UUID, error) {
+	if id == "" {
+		role, err := storage.RoleFromProto(builtIn)
+		if err != nil {
+			return uuid.Nil, err
+		}
+
+		return role.ID, nil
+	}
+	roleID, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, ErrRoleNotFound
+	}
+
+	return roleID, nil
+}
+
+// wakeWeatherJob publishes the event for enricher-service if the broker is
+// set, otherwise the saved job is run by weather.Worker. Jobs of lost events
+// are run by the next poll, so publish failures are only logged.
+func (s *APIServer) wakeWeatherJob(tracking *storage.Tracking) {
+	if s.events == nil {
+		return
+	}
+	if err := enricher.PublishTrackingCreated(s.events, tracking.ID); err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("tracking_id", tracking.ID).
+			Warn("cannot publish tracking created event")
+	}
 }
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/lib"
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/services/api/mailer"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/trackfile"
+)
+
+func TestChangeUserCredentials(t *testing.T) {
+	r := require.New(t)
+	_, store, authService := newTestInterceptor(t)
+	server := New(store, authService, nil, mailer.NewFile("", log.New()), nil, "", DefaultSignInLimits, nil, log.New()).(*APIServer)
+
+	admin := storage.NewAdmin("admin@gmail.com", "password")
+	r.NoError(store.SaveUser(admin))
+	manager := storage.NewUser("manager@gmail.com", "password")
+	manager.AddRole(storage.ManagerRole.ID)
+	r.NoError(store.SaveUser(manager))
+	user := storage.NewUser("user@gmail.com", "password")
+	r.NoError(store.SaveUser(user))
+	for _, principal := range []*storage.User{admin, manager} {
+		r.NoError(storage.LoadRoles(store, principal))
+	}
+	asManager := context.WithValue(context.Background(), principalKey{}, manager)
+	asAdmin := context.WithValue(context.Background(), principalKey{}, admin)
+
+	_, err := server.ChangeUserPassword(asManager, &pb.ChangeUserPasswordRequest{
+		UserId:      admin.ID.String(),
+		NewPassword: "new password",
+	})
+	r.Equal(ErrForbidden, err, "manager changes password of admin")
+	_, err = server.ChangeUserEmail(asManager, &pb.ChangeUserEmailRequest{
+		UserId: admin.ID.String(),
+		Email:  "manager2@gmail.com",
+	})
+	r.Equal(ErrForbidden, err, "manager changes email of admin")
+	saved, err := store.GetUser(admin.ID)
+	r.NoError(err)
+	r.Equal(admin.Email, saved.Email)
+	r.Equal(admin.Password, saved.Password)
+
+	_, err = server.ChangeUserPassword(asManager, &pb.ChangeUserPasswordRequest{
+		UserId:      user.ID.String(),
+		NewPassword: "new password",
+	})
+	r.NoError(err)
+	_, err = server.ChangeUserEmail(asAdmin, &pb.ChangeUserEmailRequest{
+		UserId: manager.ID.String(),
+		Email:  "manager2@gmail.com",
+	})
+	r.NoError(err)
+}
+
+func TestGetPersonalRecords(t *testing.T) {
+	r := require.New(t)
+	_, store, authService := newTestInterceptor(t)
+	server := New(store, authService, nil, mailer.NewFile("", log.New()), nil, "", DefaultSignInLimits, nil, log.New()).(*APIServer)
+
+	user := storage.NewUser("user@gmail.com", "password")
+	r.NoError(store.SaveUser(user))
+	// Monday
+	week := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	trackings := []*storage.Tracking{
+		{Date: week, Distance: 5000},
+		{Date: week.AddDate(0, 0, 6).Add(23 * time.Hour), Distance: 5000},
+		{Date: week.AddDate(0, 0, 7), Distance: 1000},
+		{Date: week.Add(-time.Hour), Distance: 1000},
+	}
+	for _, tracking := range trackings {
+		tracking.ID = uuid.New()
+		tracking.UserID = user.ID
+		tracking.Cursor = bson.NewObjectId()
+		r.NoError(store.SaveTracking(tracking))
+	}
+	ctx := context.WithValue(context.Background(), principalKey{}, user)
+
+	response, err := server.GetPersonalRecords(ctx, &pb.GetPersonalRecordsRequest{})
+	r.NoError(err)
+	r.Equal(week.Format(lib.DateFormat), response.BiggestWeek.StartDate)
+	r.ElementsMatch([]string{trackings[0].ID.String(), trackings[1].ID.String()}, response.BiggestWeek.TrackingIds)
+	r.Equal("2020-03-01", response.BiggestMonth.StartDate)
+	ids := make([]string, 0, len(trackings))
+	for _, tracking := range trackings {
+		ids = append(ids, tracking.ID.String())
+	}
+	r.ElementsMatch(ids, response.BiggestMonth.TrackingIds)
+}
+
+func importGPX(points ...string) []byte {
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk><trkseg>` + strings.Join(points, "") + `</trkseg></trk>
+</gpx>`)
+}
+
+func TestImportTrackings(t *testing.T) {
+	r := require.New(t)
+	_, store, authService := newTestInterceptor(t)
+	server := New(store, authService, nil, mailer.NewFile("", log.New()), nil, "", DefaultSignInLimits, nil, log.New()).(*APIServer)
+
+	user := storage.NewUser("user@gmail.com", "password")
+	r.NoError(store.SaveUser(user))
+	ctx := context.WithValue(context.Background(), principalKey{}, user)
+	valid := importGPX(
+		`<trkpt lat="0" lon="0"><time>2020-03-02T07:30:00Z</time></trkpt>`,
+		`<trkpt lat="0" lon="0.01"><time>2020-03-02T07:35:00Z</time></trkpt>`,
+	)
+	standing := importGPX(
+		`<trkpt lat="0" lon="0"><time>2020-03-02T07:30:00Z</time></trkpt>`,
+		`<trkpt lat="0" lon="0"><time>2020-03-02T07:35:00Z</time></trkpt>`,
+	)
+	listTrackings := func() []*storage.Tracking {
+		response, err := store.ListTrackingsForUser(&storage.TrackingFilter{UserID: user.ID})
+		r.NoError(err)
+
+		return response.Trackings
+	}
+
+	_, err := server.ImportTrackings(ctx, &pb.ImportTrackingsRequest{Files: [][]byte{valid, standing}})
+	r.Equal(trackfile.ErrInvalidActivity, err)
+	r.Empty(listTrackings(), "import is saved partly")
+	_, err = server.ImportTrackings(ctx, &pb.ImportTrackingsRequest{Files: [][]byte{valid, []byte("date,distance")}})
+	r.Equal(trackfile.ErrUnknownFormat, err)
+	r.Empty(listTrackings(), "import is saved partly")
+
+	response, err := server.ImportTrackings(ctx, &pb.ImportTrackingsRequest{File: valid, Files: [][]byte{valid}})
+	r.NoError(err)
+	r.Len(response.Ids, 2)
+	r.Len(listTrackings(), 2)
+	for _, id := range response.Ids {
+		_, err := store.GetWeatherJob(uuid.MustParse(id))
+		r.NoError(err)
+	}
+}
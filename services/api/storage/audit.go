@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+)
+
+type AuditAction string
+
+const (
+	AddPermissionAuditAction    AuditAction = "add_permission"
+	RemovePermissionAuditAction AuditAction = "remove_permission"
+	AddRoleAuditAction          AuditAction = "add_role"
+	RemoveRoleAuditAction       AuditAction = "remove_role"
+	CreateRoleAuditAction       AuditAction = "create_role"
+	UpdateRoleAuditAction       AuditAction = "update_role"
+	DeleteRoleAuditAction       AuditAction = "delete_role"
+	DeleteUserAuditAction       AuditAction = "delete_user"
+	ChangePasswordAuditAction   AuditAction = "change_password"
+	ChangeEmailAuditAction      AuditAction = "change_email"
+	UnlockSignInAuditAction     AuditAction = "unlock_sign_in"
+	DeleteTrackingAuditAction   AuditAction = "delete_tracking"
+	ListAllTrackingsAuditAction AuditAction = "list_all_trackings"
+	BackfillWeatherAuditAction  AuditAction = "backfill_weather"
+)
+
+// AuditEvent records a privileged action. Before and After keep JSON of
+// only the fields changed by the action, they are empty for created or
+// deleted targets respectively.
+type AuditEvent struct {
+	ID        uuid.UUID   `json:"id" bson:"_id"`
+	ActorID   uuid.UUID   `json:"actor_id" bson:"actor_id"`
+	Action    AuditAction `json:"action" bson:"action"`
+	Target    Resource    `json:"target" bson:"target"`
+	Before    string      `json:"before" bson:"before"`
+	After     string      `json:"after" bson:"after"`
+	RequestID string      `json:"request_id" bson:"request_id"`
+	ClientIP  string      `json:"client_ip" bson:"client_ip"`
+	// PeerIP is the address of the caller, it differs from ClientIP for
+	// calls through trusted proxies
+	PeerIP    string        `json:"peer_ip" bson:"peer_ip"`
+	CreatedAt time.Time     `json:"created_at" bson:"created_at"`
+	Cursor    bson.ObjectId `json:"-" bson:"cursor"`
+}
+
+func NewAuditEvent(actor uuid.UUID, action AuditAction, target Resource) *AuditEvent {
+	return &AuditEvent{
+		ID:        uuid.New(),
+		ActorID:   actor,
+		Action:    action,
+		Target:    target,
+		CreatedAt: time.Now(),
+		Cursor:    bson.NewObjectId(),
+	}
+}
+
+// SetDiff stores fields of before and after states, which differ. States
+// are compared by their JSON, nil state means the target doesn't exist.
+func (e *AuditEvent) SetDiff(before, after interface{}) error {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return err
+	}
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return err
+	}
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; ok && reflect.DeepEqual(value, afterValue) {
+			delete(beforeFields, key)
+			delete(afterFields, key)
+		}
+	}
+
+	if e.Before, err = marshalFields(beforeFields); err != nil {
+		return err
+	}
+	e.After, err = marshalFields(afterFields)
+
+	return err
+}
+
+func (e *AuditEvent) ToProto() *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:      e.ID.String(),
+		ActorId: e.ActorID.String(),
+		Action:  string(e.Action),
+		Target: &pb.AuditTarget{
+			Scope: string(e.Target.Scope),
+			Item:  e.Target.Item,
+		},
+		Before:    e.Before,
+		After:     e.After,
+		RequestId: e.RequestID,
+		ClientIp:  e.ClientIP,
+		PeerIp:    e.PeerIP,
+		CreatedAt: e.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// AuditUser is the state of the user in audit events. Password is never
+// included, roles and ACL are copied, so the user could be changed after.
+func AuditUser(user *User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":    user.ID,
+		"email": user.Email,
+		"roles": append([]uuid.UUID{}, user.Roles...),
+		"acl":   append([]Permission{}, user.ACL...),
+	}
+}
+
+// AuditTracking is the state of the tracking in audit events. Route is
+// omitted, it could be large.
+func AuditTracking(tracking *Tracking) *Tracking {
+	res := *tracking
+	res.Route = nil
+
+	return &res
+}
+
+type AuditEventFilter struct {
+	PerRequest int64
+	Cursor     string
+	// ActorID and TargetItem are skipped if empty
+	ActorID    uuid.UUID
+	TargetItem string
+}
+
+func AuditEventFilterFromProto(request *pb.ListAuditEventsRequest) (*AuditEventFilter, error) {
+	filter := &AuditEventFilter{
+		Cursor:     request.Cursor,
+		PerRequest: request.PerReq,
+		TargetItem: request.TargetId,
+	}
+	if request.ActorId != "" {
+		id, err := uuid.Parse(request.ActorId)
+		if err != nil {
+			return nil, err
+		}
+		filter.ActorID = id
+	}
+
+	return filter, nil
+}
+
+type ListAuditEventsResponse struct {
+	Total  int64
+	Events []*AuditEvent
+}
+
+func ProtoFromListAuditEventsResponse(response *ListAuditEventsResponse) *pb.ListAuditEventsResponse {
+	cursor := ""
+	events := make([]*pb.AuditEvent, 0, len(response.Events))
+	for _, event := range response.Events {
+		events = append(events, event.ToProto())
+		cursor = event.Cursor.Hex()
+	}
+	return &pb.ListAuditEventsResponse{
+		Cursor: cursor,
+		Total:  response.Total,
+		Events: events,
+	}
+}
+
+func jsonFields(state interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if state == nil {
+		return fields, nil
+	}
+	if value := reflect.ValueOf(state); (value.Kind() == reflect.Ptr || value.Kind() == reflect.Map) && value.IsNil() {
+		return fields, nil
+	}
+	buf, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(buf, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func marshalFields(fields map[string]interface{}) (string, error) {
+	if len(fields) == 0 {
+		return "", nil
+	}
+	buf, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
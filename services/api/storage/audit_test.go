@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEventDiff(t *testing.T) {
+	r := require.New(t)
+	user := NewUser("user@gmail.com", "password")
+
+	event := NewAuditEvent(user.ID, AddPermissionAuditAction, Resource{Scope: UserScope, Item: user.ID.String()})
+	before := AuditUser(user)
+	user.AddPermission(ReadTrackingsPermission)
+	r.NoError(event.SetDiff(before, AuditUser(user)))
+	r.JSONEq(`{"acl":[]}`, event.Before)
+	r.JSONEq(`{"acl":[{"action":"read","resource":{"scope":"tracking","item":"*"}}]}`, event.After)
+
+	r.NoError(event.SetDiff(AuditUser(user), nil))
+	r.Contains(event.Before, user.Email)
+	r.NotContains(event.Before, "password")
+	r.Empty(event.After)
+
+	var role *Role
+	r.NoError(event.SetDiff(role, &UserRole))
+	r.Empty(event.Before)
+	r.Contains(event.After, UserRole.Name)
+}
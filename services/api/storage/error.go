@@ -8,7 +8,13 @@ import (
 var (
 	ErrInvalidCursor = status.Error(codes.NotFound, "invalid cursor")
 	ErrNotFound      = status.Error(codes.NotFound, "not found")
+	ErrAlreadyExists = status.Error(codes.AlreadyExists, "already exists")
 	ErrUnknownRole   = status.Error(codes.NotFound, "unknown role")
 	ErrUnknownScope  = status.Error(codes.NotFound, "unknown scope")
 	ErrUnknownAction = status.Error(codes.NotFound, "unknown action")
+	ErrUnknownField  = status.Error(codes.InvalidArgument, "unknown field in update mask")
+
+	ErrUnknownGranularity = status.Error(codes.InvalidArgument, "unknown granularity")
+	ErrInvalidRoute       = status.Error(codes.InvalidArgument, "invalid route")
+	ErrUnknownTeamRole    = status.Error(codes.InvalidArgument, "unknown team role")
 )
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobStatus string
+
+const (
+	// PendingJobStatus job runs at RunAt. It's pending while leased too,
+	// so the job of the crashed worker runs again after the lease.
+	PendingJobStatus JobStatus = "pending"
+	// DeadJobStatus job is out of attempts and never runs again.
+	DeadJobStatus JobStatus = "dead"
+)
+
+// WeatherJob sets weather of the tracking. There is one job per tracking, so
+// its ID is the tracking ID.
+type WeatherJob struct {
+	ID       uuid.UUID `json:"id" bson:"_id"`
+	Status   JobStatus `json:"status" bson:"status"`
+	Attempts int       `json:"attempts" bson:"attempts"`
+	// RunAt is the time the job is due. Lease moves it to the lease end.
+	RunAt time.Time `json:"run_at" bson:"run_at"`
+	// LeaseID is set by the lease, only its holder could ack or update the
+	// job.
+	LeaseID   uuid.UUID `json:"lease_id" bson:"lease_id"`
+	LastError string    `json:"last_error" bson:"last_error"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+func NewWeatherJob(trackingID uuid.UUID) *WeatherJob {
+	now := time.Now()
+
+	return &WeatherJob{
+		ID:        trackingID,
+		Status:    PendingJobStatus,
+		RunAt:     now,
+		CreatedAt: now,
+	}
+}
@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"time"
+)
+
+// LoginAttempts counts failed sign ins by the key, which identifies the
+// account or the client address. The counter starts over after ExpiresAt.
+type LoginAttempts struct {
+	Key           string    `json:"key" bson:"_id"`
+	Failures      int       `json:"failures" bson:"failures"`
+	LastFailureAt time.Time `json:"last_failure_at" bson:"last_failure_at"`
+	ExpiresAt     time.Time `json:"expires_at" bson:"expires_at"`
+}
+
+func AccountLoginKey(email string) string {
+	return "account:" + email
+}
+
+func AddressLoginKey(ip string) string {
+	return "ip:" + ip
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const defaultAuditEventsPerRequest = 10
+
+func (d *database) SaveAuditEvent(event *storage.AuditEvent) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	res := *event
+	d.auditEvents = append(d.auditEvents, &res)
+
+	return nil
+}
+
+func (d *database) ListAuditEvents(filter *storage.AuditEventFilter) (*storage.ListAuditEventsResponse, error) {
+	if filter.Cursor != "" && !bson.IsObjectIdHex(filter.Cursor) {
+		return nil, storage.ErrInvalidCursor
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	matched := make([]*storage.AuditEvent, 0)
+	for _, event := range d.auditEvents {
+		if filter.ActorID != uuid.Nil && event.ActorID != filter.ActorID {
+			continue
+		}
+		if filter.TargetItem != "" && event.Target.Item != filter.TargetItem {
+			continue
+		}
+		matched = append(matched, event)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Cursor < matched[j].Cursor
+	})
+
+	limit := defaultAuditEventsPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+	events := make([]*storage.AuditEvent, 0, limit)
+	for _, event := range matched {
+		if len(events) == limit {
+			break
+		}
+		if filter.Cursor != "" && event.Cursor <= bson.ObjectIdHex(filter.Cursor) {
+			continue
+		}
+		res := *event
+		events = append(events, &res)
+	}
+
+	return &storage.ListAuditEventsResponse{
+		Total:  int64(len(matched)),
+		Events: events,
+	}, nil
+}
@@ -0,0 +1,10 @@
+package memory
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrUnsupportedQuery = status.Error(codes.InvalidArgument, "unsupported query")
+)
@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveWeatherJob(job *storage.WeatherJob) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	res := *job
+	d.weatherJobs[job.ID] = &res
+
+	return nil
+}
+
+func (d *database) LeaseWeatherJob(now, leaseUntil time.Time) (*storage.WeatherJob, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var due *storage.WeatherJob
+	for _, job := range d.weatherJobs {
+		if job.Status != storage.PendingJobStatus || job.RunAt.After(now) {
+			continue
+		}
+		if due == nil || job.RunAt.Before(due.RunAt) {
+			due = job
+		}
+	}
+	if due == nil {
+		return nil, storage.ErrNotFound
+	}
+	due.Attempts++
+	due.RunAt = leaseUntil
+	due.LeaseID = uuid.New()
+	res := *due
+
+	return &res, nil
+}
+
+func (d *database) AckWeatherJob(job *storage.WeatherJob) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	saved, ok := d.weatherJobs[job.ID]
+	if !ok || saved.LeaseID != job.LeaseID {
+		return storage.ErrNotFound
+	}
+	delete(d.weatherJobs, job.ID)
+
+	return nil
+}
+
+func (d *database) UpdateWeatherJob(job *storage.WeatherJob) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	saved, ok := d.weatherJobs[job.ID]
+	if !ok || saved.LeaseID != job.LeaseID {
+		return storage.ErrNotFound
+	}
+	res := *job
+	d.weatherJobs[job.ID] = &res
+
+	return nil
+}
+
+func (d *database) GetWeatherJob(id uuid.UUID) (*storage.WeatherJob, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	job, ok := d.weatherJobs[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	res := *job
+
+	return &res, nil
+}
@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) RecordLoginFailure(key string, at, expiresAt time.Time) (*storage.LoginAttempts, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	attempts, ok := d.loginAttempts[key]
+	if !ok || !attempts.ExpiresAt.After(at) {
+		attempts = &storage.LoginAttempts{Key: key}
+		d.loginAttempts[key] = attempts
+	}
+	attempts.Failures++
+	attempts.LastFailureAt = at
+	attempts.ExpiresAt = expiresAt
+	res := *attempts
+
+	return &res, nil
+}
+
+func (d *database) GetLoginAttempts(key string) (*storage.LoginAttempts, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	attempts, ok := d.loginAttempts[key]
+	if !ok || !attempts.ExpiresAt.After(time.Now()) {
+		return nil, storage.ErrNotFound
+	}
+	res := *attempts
+
+	return &res, nil
+}
+
+func (d *database) DeleteLoginAttempts(key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.loginAttempts, key)
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package memory
+
+import (
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// match evaluates query built by filterparser against item the way MongoDB
+// does. Item is converted to its bson document first, so field names and
+// value types are the same as stored in the mongo collection.
+func match(item interface{}, query bson.D) (bool, error) {
+	if len(query) == 0 {
+		return true, nil
+	}
+
+	raw, err := bson.Marshal(item)
+	if err != nil {
+		return false, err
+	}
+	doc := bson.M{}
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return false, err
+	}
+
+	return matchDocument(doc, query)
+}
+
+func matchDocument(doc bson.M, query bson.D) (bool, error) {
+	for _, elem := range query {
+		ok, err := matchElem(doc, elem)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func matchElem(doc bson.M, elem bson.DocElem) (bool, error) {
+	switch elem.Name {
+	case "$and", "$or":
+		expressions, ok := elem.Value.([]bson.D)
+		if !ok {
+			return false, ErrUnsupportedQuery
+		}
+		for _, expression := range expressions {
+			ok, err := matchDocument(doc, expression)
+			if err != nil {
+				return false, err
+			}
+			if elem.Name == "$or" && ok {
+				return true, nil
+			}
+			if elem.Name == "$and" && !ok {
+				return false, nil
+			}
+		}
+
+		return elem.Name == "$and", nil
+	}
+
+	conditions, ok := elem.Value.(bson.D)
+	if !ok {
+		return false, ErrUnsupportedQuery
+	}
+	value := lookup(doc, elem.Name)
+	for _, condition := range conditions {
+		res, comparable := compare(value, condition.Value)
+
+		var ok bool
+		switch condition.Name {
+		case "$eq":
+			ok = comparable && res == 0
+		case "$ne":
+			ok = !comparable || res != 0
+		case "$gt":
+			ok = comparable && res > 0
+		case "$lt":
+			ok = comparable && res < 0
+		default:
+			return false, ErrUnsupportedQuery
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// lookup resolves dotted path like "location.latitude" in the document.
+func lookup(doc bson.M, path string) interface{} {
+	var value interface{} = doc
+	for _, key := range strings.Split(path, ".") {
+		sub, ok := value.(bson.M)
+		if !ok {
+			return nil
+		}
+		value = sub[key]
+	}
+
+	return value
+}
+
+// compare returns -1, 0 or 1 comparing a and b. The second result is false
+// when values have different types and cannot be compared.
+func compare(a, b interface{}) (int, bool) {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	switch x := a.(type) {
+	case time.Time:
+		y, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case x.Before(y):
+			return -1, true
+		case x.After(y):
+			return 1, true
+		default:
+			return 0, true
+		}
+	case string:
+		y, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+
+		return strings.Compare(x, y), true
+	}
+
+	return 0, false
+}
+
+// toFloat brings numbers to the same type, as mongo compares numbers by value.
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case time.Duration:
+		return float64(v), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// database keeps all data in process memory. It is safe for concurrent use
+// and intended for tests and local development, where MongoDB is not available.
+type database struct {
+	mu sync.RWMutex
+
+	users     map[uuid.UUID]*storage.User
+	roles     map[uuid.UUID]*storage.Role
+	trackings map[uuid.UUID]*storage.Tracking
+	teams     map[uuid.UUID]*storage.Team
+	tokens    map[string]*storage.Token
+
+	oneTimeTokens map[string]*storage.OneTimeToken
+	loginAttempts map[string]*storage.LoginAttempts
+	weatherJobs   map[uuid.UUID]*storage.WeatherJob
+	weatherCache  map[string]*storage.WeatherCacheEntry
+
+	revocations []storage.Revocation
+	auditEvents []*storage.AuditEvent
+}
+
+func New() storage.Storage {
+	return &database{
+		users:     make(map[uuid.UUID]*storage.User),
+		roles:     make(map[uuid.UUID]*storage.Role),
+		trackings: make(map[uuid.UUID]*storage.Tracking),
+		teams:     make(map[uuid.UUID]*storage.Team),
+		tokens:    make(map[string]*storage.Token),
+
+		oneTimeTokens: make(map[string]*storage.OneTimeToken),
+		loginAttempts: make(map[string]*storage.LoginAttempts),
+		weatherJobs:   make(map[uuid.UUID]*storage.WeatherJob),
+		weatherCache:  make(map[string]*storage.WeatherCacheEntry),
+	}
+}
@@ -0,0 +1,592 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func newTracking(userID uuid.UUID, date time.Time, distance float32, longitude float64) *storage.Tracking {
+	return &storage.Tracking{
+		ID:       uuid.New(),
+		UserID:   userID,
+		Cursor:   bson.NewObjectId(),
+		Date:     date,
+		Time:     time.Hour,
+		Distance: distance,
+		Location: storage.Location{
+			Longitude: longitude,
+			Latitude:  50,
+		},
+		Weather: &storage.Weather{},
+	}
+}
+
+func TestUsers(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	user := storage.NewUser("first@gmail.com", "password")
+	r.NoError(store.SaveUser(user))
+	r.Equal(storage.ErrAlreadyExists, store.SaveUser(storage.NewUser("first@gmail.com", "password")))
+
+	user.Roles = append(user.Roles, storage.AdminRole.ID)
+	saved, err := store.GetUserByEmail("first@gmail.com")
+	r.NoError(err)
+	r.Equal([]uuid.UUID{storage.UserRole.ID}, saved.Roles, "stored user changed without update")
+
+	r.NoError(store.UpdateUser(user))
+	saved, err = store.GetUser(user.ID)
+	r.NoError(err)
+	r.Equal(user.Roles, saved.Roles)
+	list, err := store.ListUsers(&storage.UserFilter{PerRequest: -1})
+	r.NoError(err)
+	r.Len(list.Users, 1)
+
+	r.NoError(store.DeleteUser(user.ID))
+	_, err = store.GetUser(user.ID)
+	r.Equal(storage.ErrNotFound, err)
+	r.Equal(storage.ErrNotFound, store.UpdateUser(user))
+}
+
+func TestRoles(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	r.NoError(storage.SeedRoles(store))
+	r.NoError(storage.SeedRoles(store), "seeding is not idempotent")
+	roles, err := store.ListRoles()
+	r.NoError(err)
+	r.Len(roles, len(storage.BuiltInRoles))
+
+	role := storage.NewRole("coach", []storage.Permission{storage.ReadTrackingsPermission})
+	r.NoError(store.SaveRole(role))
+	r.Equal(storage.ErrAlreadyExists, store.SaveRole(storage.NewRole("coach", nil)))
+
+	user := storage.NewUser("coach@gmail.com", "password")
+	user.AddRole(role.ID)
+	r.NoError(storage.LoadRoles(store, user))
+	r.True(user.HasPermission(storage.ReadTrackingsPermission))
+
+	role.Permissions = nil
+	r.NoError(store.UpdateRole(role))
+	r.NoError(storage.LoadRoles(store, user))
+	r.False(user.HasPermission(storage.ReadTrackingsPermission), "role update is not applied to holder")
+
+	r.NoError(store.DeleteRole(role.ID))
+	_, err = store.GetRole(role.ID)
+	r.Equal(storage.ErrNotFound, err)
+	r.Equal(storage.ErrNotFound, store.UpdateRole(role))
+	r.NoError(storage.LoadRoles(store, user), "deleted role is not skipped")
+}
+
+func TestTeams(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	coach := storage.NewUser("coach@gmail.com", "password")
+	member := storage.NewUser("member@gmail.com", "password")
+	team := storage.NewTeam("team", coach.ID)
+	team.Invite(member.ID, storage.MemberTeamRole)
+	r.NoError(store.SaveTeam(team))
+	r.NoError(store.SaveTeam(storage.NewTeam("another", uuid.New())))
+
+	teams, err := store.ListTeamsForUser(member.ID)
+	r.NoError(err)
+	r.Len(teams, 1, "invited user has no team")
+	r.NoError(storage.LoadTeams(store, coach))
+	r.False(coach.Coaches(member.ID), "invited user is coached")
+
+	r.True(team.Accept(member.ID))
+	r.NoError(store.UpdateTeam(team))
+	r.NoError(storage.LoadTeams(store, coach))
+	r.True(coach.Coaches(member.ID))
+	r.True(coach.HasPermissionFor(storage.ReadTrackingsPermission, member.ID))
+	r.False(coach.HasPermissionFor(storage.DeleteTrackingsPermission, member.ID), "coach can delete trackings")
+
+	trackingOfMember := newTracking(member.ID, time.Now(), 10, 20)
+	r.NoError(store.SaveTracking(trackingOfMember))
+	r.NoError(store.SaveTracking(newTracking(uuid.New(), time.Now(), 10, 20)))
+	list, err := store.ListTrackingsForUsers(&storage.TrackingFilter{UserIDs: team.Members})
+	r.NoError(err)
+	r.Equal(int64(1), list.Total)
+	r.Equal(trackingOfMember.ID, list.Trackings[0].ID)
+
+	r.True(team.Leave(member.ID))
+	r.False(team.Leave(member.ID), "user left twice")
+	r.NoError(store.UpdateTeam(team))
+	r.NoError(storage.LoadTeams(store, coach))
+	r.False(coach.HasPermissionFor(storage.ReadTrackingsPermission, member.ID), "access is kept after leaving")
+
+	r.NoError(store.DeleteTeam(team.ID))
+	_, err = store.GetTeam(team.ID)
+	r.Equal(storage.ErrNotFound, err)
+	r.Equal(storage.ErrNotFound, store.UpdateTeam(team))
+}
+
+func TestAuditEvents(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	actor := uuid.New()
+	target := storage.Resource{Scope: storage.UserScope, Item: uuid.New().String()}
+	for i := 0; i < 15; i++ {
+		r.NoError(store.SaveAuditEvent(storage.NewAuditEvent(actor, storage.AddRoleAuditAction, target)))
+	}
+	r.NoError(store.SaveAuditEvent(storage.NewAuditEvent(uuid.New(), storage.DeleteUserAuditAction, target)))
+
+	first, err := store.ListAuditEvents(&storage.AuditEventFilter{ActorID: actor})
+	r.NoError(err)
+	r.Equal(int64(15), first.Total)
+	r.Len(first.Events, defaultAuditEventsPerRequest)
+	negative, err := store.ListAuditEvents(&storage.AuditEventFilter{ActorID: actor, PerRequest: -1})
+	r.NoError(err)
+	r.Len(negative.Events, defaultAuditEventsPerRequest, "negative per request isn't default")
+
+	second, err := store.ListAuditEvents(&storage.AuditEventFilter{
+		ActorID: actor,
+		Cursor:  first.Events[len(first.Events)-1].Cursor.Hex(),
+	})
+	r.NoError(err)
+	r.Len(second.Events, 5)
+	r.True(second.Events[0].Cursor > first.Events[len(first.Events)-1].Cursor, "events are not ordered")
+
+	byTarget, err := store.ListAuditEvents(&storage.AuditEventFilter{TargetItem: target.Item, PerRequest: 20})
+	r.NoError(err)
+	r.Len(byTarget.Events, 16)
+
+	_, err = store.ListAuditEvents(&storage.AuditEventFilter{Cursor: "invalid"})
+	r.Equal(storage.ErrInvalidCursor, err)
+}
+
+func TestListTrackings(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	userID := uuid.New()
+	date := time.Date(2020, 3, 20, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 15; i++ {
+		r.NoError(store.SaveTracking(newTracking(userID, date.AddDate(0, 0, i), float32(i), 20)))
+	}
+	r.NoError(store.SaveTracking(newTracking(uuid.New(), date, 100, 20)))
+
+	all, err := store.ListTrackings(&storage.TrackingFilter{})
+	r.NoError(err)
+	r.Equal(int64(16), all.Total)
+	r.Len(all.Trackings, defaultTrackingPerRequest)
+	negative, err := store.ListTrackings(&storage.TrackingFilter{PerRequest: -1})
+	r.NoError(err)
+	r.Len(negative.Trackings, defaultTrackingPerRequest, "negative per request isn't default")
+
+	first, err := store.ListTrackingsForUser(&storage.TrackingFilter{UserID: userID, PerRequest: 10})
+	r.NoError(err)
+	r.Equal(int64(15), first.Total)
+	r.Len(first.Trackings, 10)
+	cursor := storage.ProtoFromListTrackingsResponse(first).Cursor
+
+	second, err := store.ListTrackingsForUser(&storage.TrackingFilter{UserID: userID, PerRequest: 10, Cursor: cursor})
+	r.NoError(err)
+	r.Equal(int64(15), second.Total)
+	r.Len(second.Trackings, 5)
+	for i, tracking := range append(first.Trackings, second.Trackings...) {
+		r.Equal(float32(i), tracking.Distance, "trackings are not ordered by cursor")
+	}
+
+	r.NoError(store.SetTrackingWeather(first.Trackings[0].ID, &storage.Weather{Temperature: 10}))
+	missing, err := store.ListTrackingsForUser(&storage.TrackingFilter{UserID: userID, MissingWeather: true})
+	r.NoError(err)
+	r.Equal(int64(14), missing.Total)
+
+	_, err = store.ListTrackings(&storage.TrackingFilter{Cursor: "invalid"})
+	r.Equal(storage.ErrInvalidCursor, err)
+}
+
+func TestListTrackingsQuery(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	userID := uuid.New()
+	date := time.Date(2020, 3, 20, 0, 0, 0, 0, time.UTC)
+	r.NoError(store.SaveTracking(newTracking(userID, date, 5, 20)))
+	r.NoError(store.SaveTracking(newTracking(userID, date.AddDate(0, 0, 1), 10.5, 30)))
+	r.NoError(store.SaveTracking(newTracking(userID, date.AddDate(0, 0, 2), 15, 40)))
+
+	type TestCase struct {
+		Name  string
+		Query string
+		Count int
+	}
+	tests := []TestCase{
+		{Name: "eq float", Query: "distance eq 10.5", Count: 1},
+		{Name: "ne", Query: "distance ne 10.5", Count: 2},
+		{Name: "gt date", Query: "date gt 2020-03-20", Count: 2},
+		{Name: "nested", Query: "location.longitude lt 35", Count: 2},
+		{Name: "duration", Query: "time eq 1h", Count: 3},
+		{Name: "and", Query: "(date gt 2020-03-20) and (distance lt 12)", Count: 1},
+		{Name: "or", Query: "(distance lt 6) or (distance gt 12)", Count: 2},
+		{Name: "weather", Query: "weather.temperature eq 0", Count: 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(tt *testing.T) {
+			res, err := store.ListTrackingsForUser(&storage.TrackingFilter{UserID: userID, Query: tc.Query})
+			require.NoError(tt, err)
+			require.Equal(tt, int64(tc.Count), res.Total)
+			require.Len(tt, res.Trackings, tc.Count)
+		})
+	}
+}
+
+func TestGetReport(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	userID := uuid.New()
+	date := time.Date(2020, 3, 20, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10; i++ {
+		r.NoError(store.SaveTracking(newTracking(userID, date.AddDate(0, 0, i), 3600, 20)))
+	}
+
+	report, err := store.GetReport(&storage.ReportFilter{UserID: userID, FromDate: date})
+	r.NoError(err)
+	r.Equal(float32(7*3600), report.Distance)
+	r.Equal(float32(1), report.AverageSpeed)
+
+	report, err = store.GetReport(&storage.ReportFilter{UserID: uuid.New(), FromDate: date})
+	r.NoError(err)
+	r.Equal(float32(0), report.Distance)
+}
+
+func TestGetReportBuckets(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	userID := uuid.New()
+	// Friday
+	date := time.Date(2020, 3, 20, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 14; i++ {
+		r.NoError(store.SaveTracking(newTracking(userID, date.AddDate(0, 0, i), float32(3600*(i+1)), 20)))
+	}
+	r.NoError(store.SaveTracking(newTracking(userID, date, 1800, 20)))
+
+	type TestCase struct {
+		Granularity storage.Granularity
+		Starts      []time.Time
+		Counts      []int64
+		Longest     []float32
+	}
+	tests := []TestCase{
+		{
+			Granularity: storage.WeekGranularity,
+			Starts:      []time.Time{date, date.AddDate(0, 0, 7)},
+			Counts:      []int64{8, 6},
+			Longest:     []float32{7 * 3600, 13 * 3600},
+		},
+		{
+			Granularity: storage.ISOWeekGranularity,
+			Starts:      []time.Time{date.AddDate(0, 0, -4), date.AddDate(0, 0, 3), date.AddDate(0, 0, 10)},
+			Counts:      []int64{4, 7, 3},
+			Longest:     []float32{3 * 3600, 10 * 3600, 13 * 3600},
+		},
+		{
+			Granularity: storage.MonthGranularity,
+			Starts:      []time.Time{time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)},
+			Counts:      []int64{13, 1},
+			Longest:     []float32{12 * 3600, 13 * 3600},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.Granularity), func(tt *testing.T) {
+			report, err := store.GetReport(&storage.ReportFilter{
+				UserID:      userID,
+				FromDate:    date,
+				Duration:    13 * 24 * time.Hour,
+				Granularity: tc.Granularity,
+			})
+			require.NoError(tt, err)
+			require.Len(tt, report.Buckets, len(tc.Starts))
+			for i, bucket := range report.Buckets {
+				require.Equal(tt, tc.Starts[i], bucket.StartDate)
+				require.Equal(tt, tc.Counts[i], bucket.Count)
+				require.Equal(tt, tc.Longest[i], bucket.LongestRun)
+				require.Equal(tt, time.Duration(tc.Counts[i])*time.Hour, bucket.Time)
+			}
+		})
+	}
+
+	report, err := store.GetReport(&storage.ReportFilter{
+		UserID:      userID,
+		FromDate:    date,
+		Granularity: storage.DayGranularity,
+	})
+	r.NoError(err)
+	r.Len(report.Buckets, 7)
+	r.Equal(int64(2), report.Buckets[0].Count)
+	r.Equal(float32(3600+1800)/float32(2*3600), report.Buckets[0].AverageSpeed)
+}
+
+func TestOneTimeTokens(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	user := storage.NewUser("user@gmail.com", "password")
+	r.NoError(store.SaveUser(user))
+	token := &storage.OneTimeToken{
+		Hash:      storage.HashOneTimeToken(uuid.New().String()),
+		UserID:    user.ID,
+		Purpose:   storage.PasswordResetPurpose,
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond),
+	}
+	r.NoError(store.SaveOneTimeToken(token))
+	r.Equal(storage.ErrAlreadyExists, store.SaveOneTimeToken(token))
+
+	saved, err := store.ConsumeOneTimeToken(token.Hash)
+	r.NoError(err)
+	r.Equal(token.UserID, saved.UserID)
+	r.Equal(token.Purpose, saved.Purpose)
+	r.True(token.ExpiresAt.Equal(saved.ExpiresAt))
+	_, err = store.ConsumeOneTimeToken(token.Hash)
+	r.Equal(storage.ErrNotFound, err)
+
+	r.NoError(store.SaveOneTimeToken(token))
+	r.NoError(store.DeleteOneTimeTokens(user.ID, storage.EmailVerificationPurpose))
+	r.NoError(store.DeleteOneTimeTokens(user.ID, storage.PasswordResetPurpose))
+	_, err = store.ConsumeOneTimeToken(token.Hash)
+	r.Equal(storage.ErrNotFound, err)
+
+	user.EmailVerified = true
+	r.NoError(store.UpdateUser(user))
+	savedUser, err := store.GetUser(user.ID)
+	r.NoError(err)
+	r.True(savedUser.EmailVerified)
+}
+
+func TestLoginAttempts(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	key := storage.AccountLoginKey(uuid.New().String())
+	_, err := store.GetLoginAttempts(key)
+	r.Equal(storage.ErrNotFound, err)
+
+	now := time.Now()
+	attempts, err := store.RecordLoginFailure(key, now, now.Add(time.Hour))
+	r.NoError(err)
+	r.Equal(1, attempts.Failures)
+	attempts, err = store.RecordLoginFailure(key, now, now.Add(time.Hour))
+	r.NoError(err)
+	r.Equal(2, attempts.Failures)
+	saved, err := store.GetLoginAttempts(key)
+	r.NoError(err)
+	r.Equal(2, saved.Failures)
+
+	expired := storage.AccountLoginKey(uuid.New().String())
+	_, err = store.RecordLoginFailure(expired, now.Add(-time.Hour), now.Add(-time.Minute))
+	r.NoError(err)
+	_, err = store.GetLoginAttempts(expired)
+	r.Equal(storage.ErrNotFound, err, "expired attempts are returned")
+	attempts, err = store.RecordLoginFailure(expired, now, now.Add(time.Hour))
+	r.NoError(err)
+	r.Equal(1, attempts.Failures, "expired attempts are counted")
+
+	r.NoError(store.DeleteLoginAttempts(key))
+	_, err = store.GetLoginAttempts(key)
+	r.Equal(storage.ErrNotFound, err)
+}
+
+func TestRotateTokens(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	newToken := func(userID, family uuid.UUID) *storage.Token {
+		return &storage.Token{
+			UserID:    userID,
+			Family:    family,
+			Access:    uuid.New().String(),
+			Refresh:   uuid.New().String(),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+	userID, family := uuid.New(), uuid.New()
+	old := newToken(userID, family)
+	r.NoError(store.SaveToken(old))
+
+	rotated := newToken(userID, family)
+	r.NoError(store.RotateToken(old, rotated))
+	_, err := store.GetToken(old.Refresh)
+	r.Equal(storage.ErrNotFound, err)
+	saved, err := store.GetToken(rotated.Refresh)
+	r.NoError(err)
+	r.Equal(family, saved.Family)
+
+	r.Equal(storage.ErrNotFound, store.RotateToken(old, newToken(userID, family)))
+
+	other := newToken(userID, uuid.New())
+	r.NoError(store.SaveToken(other))
+	r.NoError(store.DeleteTokensByFamily(family))
+	_, err = store.GetToken(rotated.Refresh)
+	r.Equal(storage.ErrNotFound, err)
+	_, err = store.GetToken(other.Refresh)
+	r.NoError(err)
+	tokens, err := store.ListTokensByUser(userID)
+	r.NoError(err)
+	r.Len(tokens, 1)
+	r.Equal(other.Refresh, tokens[0].Refresh)
+
+	r.NoError(store.DeleteTokensByUser(userID))
+	_, err = store.GetToken(other.Refresh)
+	r.Equal(storage.ErrNotFound, err)
+}
+
+func TestRevocations(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	userID := uuid.New()
+	now := time.Now().Truncate(time.Second)
+	r.NoError(store.SaveRevocation(&storage.Revocation{
+		TokenID:   "revoked",
+		UserID:    userID,
+		ExpiresAt: now.Add(time.Hour),
+	}))
+	r.NoError(store.SaveRevocation(&storage.Revocation{
+		TokenID:   "expired",
+		UserID:    userID,
+		ExpiresAt: now.Add(-time.Second),
+	}))
+
+	revoked, err := store.IsTokenRevoked(userID, "revoked", now)
+	r.NoError(err)
+	r.True(revoked)
+	revoked, err = store.IsTokenRevoked(userID, "expired", now)
+	r.NoError(err)
+	r.False(revoked, "expired revocation is applied")
+	revoked, err = store.IsTokenRevoked(userID, "", now.Add(-time.Hour))
+	r.NoError(err)
+	r.False(revoked, "token id revocation is applied to all tokens")
+
+	r.NoError(store.SaveRevocation(&storage.Revocation{
+		UserID:       userID,
+		IssuedBefore: now,
+		ExpiresAt:    now.Add(time.Hour),
+	}))
+	revoked, err = store.IsTokenRevoked(userID, "", now.Add(-time.Second))
+	r.NoError(err)
+	r.True(revoked)
+	revoked, err = store.IsTokenRevoked(userID, "other", now)
+	r.NoError(err)
+	r.False(revoked, "token issued after revocation is revoked")
+	revoked, err = store.IsTokenRevoked(uuid.New(), "other", now.Add(-time.Second))
+	r.NoError(err)
+	r.False(revoked, "revocation is applied to another user")
+}
+
+func TestWeatherJobs(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	tracking := newTracking(uuid.New(), time.Now(), 10, 20)
+	r.NoError(store.SaveTracking(tracking))
+	now := time.Now()
+	_, err := store.LeaseWeatherJob(now, now.Add(time.Minute))
+	r.Equal(storage.ErrNotFound, err)
+
+	r.NoError(store.SaveWeatherJob(storage.NewWeatherJob(tracking.ID)))
+	job, err := store.LeaseWeatherJob(now.Add(time.Second), now.Add(time.Minute))
+	r.NoError(err)
+	r.Equal(tracking.ID, job.ID)
+	r.Equal(1, job.Attempts)
+	_, err = store.LeaseWeatherJob(now.Add(time.Second), now.Add(time.Minute))
+	r.Equal(storage.ErrNotFound, err, "leased job is leased again")
+
+	lost, err := store.LeaseWeatherJob(now.Add(2*time.Minute), now.Add(3*time.Minute))
+	r.NoError(err, "job is not leased after the lease end")
+	r.Equal(2, lost.Attempts)
+	r.Equal(storage.ErrNotFound, store.AckWeatherJob(job), "job is acked by the expired lease")
+
+	lost.RunAt = now.Add(time.Hour)
+	lost.LastError = "cannot get weather"
+	r.NoError(store.UpdateWeatherJob(lost))
+	saved, err := store.GetWeatherJob(tracking.ID)
+	r.NoError(err)
+	r.Equal("cannot get weather", saved.LastError)
+
+	r.NoError(store.SetTrackingWeather(tracking.ID, &storage.Weather{Temperature: 15}))
+	savedTracking, err := store.GetTracking(tracking.ID)
+	r.NoError(err)
+	r.Equal(float32(15), savedTracking.Weather.Temperature)
+
+	r.NoError(store.AckWeatherJob(lost))
+	_, err = store.GetWeatherJob(tracking.ID)
+	r.Equal(storage.ErrNotFound, err)
+
+	r.Equal(storage.ErrAlreadyExists, store.SaveTrackingWithWeatherJob(tracking, storage.NewWeatherJob(tracking.ID)))
+	_, err = store.GetWeatherJob(tracking.ID)
+	r.Equal(storage.ErrNotFound, err, "job is saved without its tracking")
+	r.NoError(store.UpdateTrackingWithWeatherJob(tracking, storage.NewWeatherJob(tracking.ID)))
+	_, err = store.GetWeatherJob(tracking.ID)
+	r.NoError(err)
+
+	created := newTracking(uuid.New(), time.Now(), 10, 20)
+	r.Equal(storage.ErrNotFound, store.UpdateTrackingWithWeatherJob(created, storage.NewWeatherJob(created.ID)))
+	_, err = store.GetWeatherJob(created.ID)
+	r.Equal(storage.ErrNotFound, err, "job is saved without its tracking")
+	r.NoError(store.SaveTrackingWithWeatherJob(created, storage.NewWeatherJob(created.ID)))
+	_, err = store.GetTracking(created.ID)
+	r.NoError(err)
+	_, err = store.GetWeatherJob(created.ID)
+	r.NoError(err)
+
+	imported := newTracking(uuid.New(), time.Now(), 10, 20)
+	r.Equal(storage.ErrAlreadyExists, store.SaveTrackingsWithWeatherJobs(
+		[]*storage.Tracking{imported, created},
+		[]*storage.WeatherJob{storage.NewWeatherJob(imported.ID), storage.NewWeatherJob(created.ID)},
+	))
+	_, err = store.GetTracking(imported.ID)
+	r.Equal(storage.ErrNotFound, err, "trackings are saved partly")
+	_, err = store.GetWeatherJob(imported.ID)
+	r.Equal(storage.ErrNotFound, err, "job is saved without its tracking")
+}
+
+func TestWeatherCache(t *testing.T) {
+	r := require.New(t)
+	store := New()
+
+	key := "history:" + uuid.New().String() + ":2020-03-20"
+	_, err := store.GetWeatherCacheEntry(key)
+	r.Equal(storage.ErrNotFound, err)
+
+	r.NoError(store.SaveWeatherCacheEntry(&storage.WeatherCacheEntry{
+		Key:       key,
+		Weather:   &storage.Weather{Temperature: 15},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+	entry, err := store.GetWeatherCacheEntry(key)
+	r.NoError(err)
+	r.Equal(float32(15), entry.Weather.Temperature)
+
+	station := "station:" + uuid.New().String()
+	r.NoError(store.SaveWeatherCacheEntry(&storage.WeatherCacheEntry{
+		Key:       station,
+		StationID: "48694",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+	entry, err = store.GetWeatherCacheEntry(station)
+	r.NoError(err)
+	r.Equal("48694", entry.StationID)
+	r.Nil(entry.Weather)
+
+	r.NoError(store.SaveWeatherCacheEntry(&storage.WeatherCacheEntry{
+		Key:       key,
+		Weather:   &storage.Weather{Temperature: 15},
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}))
+	_, err = store.GetWeatherCacheEntry(key)
+	r.Equal(storage.ErrNotFound, err, "expired entry is returned")
+}
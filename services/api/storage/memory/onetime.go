@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveOneTimeToken(token *storage.OneTimeToken) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.oneTimeTokens[token.Hash]; ok {
+		return storage.ErrAlreadyExists
+	}
+	res := *token
+	d.oneTimeTokens[token.Hash] = &res
+
+	return nil
+}
+
+func (d *database) ConsumeOneTimeToken(hash string) (*storage.OneTimeToken, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	token, ok := d.oneTimeTokens[hash]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	delete(d.oneTimeTokens, hash)
+
+	return token, nil
+}
+
+func (d *database) DeleteOneTimeTokens(userID uuid.UUID, purpose storage.TokenPurpose) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for hash, token := range d.oneTimeTokens {
+		if token.UserID == userID && token.Purpose == purpose {
+			delete(d.oneTimeTokens, hash)
+		}
+	}
+
+	return nil
+}
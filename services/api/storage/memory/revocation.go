@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveRevocation(revocation *storage.Revocation) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// expired revocations are removed on write, as TTL index does in mongo
+	now := time.Now()
+	revocations := d.revocations[:0]
+	for _, existing := range d.revocations {
+		if existing.ExpiresAt.After(now) {
+			revocations = append(revocations, existing)
+		}
+	}
+	d.revocations = append(revocations, *revocation)
+
+	return nil
+}
+
+func (d *database) IsTokenRevoked(userID uuid.UUID, tokenID string, issuedAt time.Time) (bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	now := time.Now()
+	for _, revocation := range d.revocations {
+		if !revocation.ExpiresAt.After(now) {
+			continue
+		}
+		if revocation.TokenID != "" && revocation.TokenID == tokenID {
+			return true, nil
+		}
+		if revocation.TokenID == "" && revocation.UserID == userID && issuedAt.Before(revocation.IssuedBefore) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
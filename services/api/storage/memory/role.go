@@ -0,0 +1,93 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveRole(role *storage.Role) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.roles[role.ID]; ok {
+		return storage.ErrAlreadyExists
+	}
+	if d.hasRoleName(role.Name, role.ID) {
+		return storage.ErrAlreadyExists
+	}
+	d.roles[role.ID] = copyRole(role)
+
+	return nil
+}
+
+func (d *database) UpdateRole(role *storage.Role) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.roles[role.ID]; !ok {
+		return storage.ErrNotFound
+	}
+	if d.hasRoleName(role.Name, role.ID) {
+		return storage.ErrAlreadyExists
+	}
+	d.roles[role.ID] = copyRole(role)
+
+	return nil
+}
+
+func (d *database) DeleteRole(id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.roles, id)
+
+	return nil
+}
+
+func (d *database) GetRole(id uuid.UUID) (*storage.Role, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	role, ok := d.roles[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	return copyRole(role), nil
+}
+
+func (d *database) ListRoles() ([]*storage.Role, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	roles := make([]*storage.Role, 0, len(d.roles))
+	for _, role := range d.roles {
+		roles = append(roles, copyRole(role))
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+
+	return roles, nil
+}
+
+// hasRoleName emulates unique role name index. Must be called under lock.
+func (d *database) hasRoleName(name string, except uuid.UUID) bool {
+	for _, role := range d.roles {
+		if role.Name == name && role.ID != except {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyRole(role *storage.Role) *storage.Role {
+	res := *role
+	res.Permissions = append([]storage.Permission(nil), role.Permissions...)
+
+	return &res
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveTeam(team *storage.Team) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.teams[team.ID]; ok {
+		return storage.ErrAlreadyExists
+	}
+	d.teams[team.ID] = copyTeam(team)
+
+	return nil
+}
+
+func (d *database) UpdateTeam(team *storage.Team) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.teams[team.ID]; !ok {
+		return storage.ErrNotFound
+	}
+	d.teams[team.ID] = copyTeam(team)
+
+	return nil
+}
+
+func (d *database) DeleteTeam(id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.teams, id)
+
+	return nil
+}
+
+func (d *database) GetTeam(id uuid.UUID) (*storage.Team, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	team, ok := d.teams[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	return copyTeam(team), nil
+}
+
+func (d *database) ListTeamsForUser(userID uuid.UUID) ([]*storage.Team, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	teams := make([]*storage.Team, 0)
+	for _, team := range d.teams {
+		if team.Includes(userID) {
+			teams = append(teams, copyTeam(team))
+		}
+	}
+
+	return teams, nil
+}
+
+func copyTeam(team *storage.Team) *storage.Team {
+	res := *team
+	res.Coaches = append([]uuid.UUID(nil), team.Coaches...)
+	res.Members = append([]uuid.UUID(nil), team.Members...)
+	res.Invitations = append([]storage.Invitation(nil), team.Invitations...)
+
+	return &res
+}
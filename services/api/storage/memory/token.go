@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveToken(token *storage.Token) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.tokens[token.Refresh]; ok {
+		return storage.ErrAlreadyExists
+	}
+	res := *token
+	d.tokens[token.Refresh] = &res
+
+	return nil
+}
+
+func (d *database) DeleteToken(token *storage.Token) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.tokens, token.Refresh)
+
+	return nil
+}
+
+func (d *database) GetToken(refreshToken string) (*storage.Token, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	token, ok := d.tokens[refreshToken]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	res := *token
+
+	return &res, nil
+}
+
+func (d *database) RotateToken(old, new *storage.Token) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.tokens[old.Refresh]; !ok {
+		return storage.ErrNotFound
+	}
+	if _, ok := d.tokens[new.Refresh]; ok {
+		return storage.ErrAlreadyExists
+	}
+	delete(d.tokens, old.Refresh)
+	res := *new
+	d.tokens[new.Refresh] = &res
+
+	return nil
+}
+
+func (d *database) DeleteTokensByFamily(family uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for refresh, token := range d.tokens {
+		if token.Family == family {
+			delete(d.tokens, refresh)
+		}
+	}
+
+	return nil
+}
+
+func (d *database) ListTokensByUser(userID uuid.UUID) ([]*storage.Token, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	tokens := make([]*storage.Token, 0)
+	for _, token := range d.tokens {
+		if token.UserID == userID {
+			res := *token
+			tokens = append(tokens, &res)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (d *database) DeleteTokensByUser(userID uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for refresh, token := range d.tokens {
+		if token.UserID == userID {
+			delete(d.tokens, refresh)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package memory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/mongo/filterparser"
+)
+
+const (
+	defaultTrackingPerRequest = 10
+	defaultDuration           = 7 * 24 * time.Hour
+)
+
+func (d *database) SaveTracking(tracking *storage.Tracking) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.trackings[tracking.ID]; ok {
+		return storage.ErrAlreadyExists
+	}
+	d.trackings[tracking.ID] = copyTracking(tracking)
+
+	return nil
+}
+
+func (d *database) UpdateTracking(tracking *storage.Tracking) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.trackings[tracking.ID]; !ok {
+		return storage.ErrNotFound
+	}
+	d.trackings[tracking.ID] = copyTracking(tracking)
+
+	return nil
+}
+
+func (d *database) SaveTrackingWithWeatherJob(tracking *storage.Tracking, job *storage.WeatherJob) error {
+	return d.SaveTrackingsWithWeatherJobs([]*storage.Tracking{tracking}, []*storage.WeatherJob{job})
+}
+
+func (d *database) SaveTrackingsWithWeatherJobs(trackings []*storage.Tracking, jobs []*storage.WeatherJob) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ids := make(map[uuid.UUID]struct{}, len(trackings))
+	for _, tracking := range trackings {
+		if _, ok := d.trackings[tracking.ID]; ok {
+			return storage.ErrAlreadyExists
+		}
+		if _, ok := ids[tracking.ID]; ok {
+			return storage.ErrAlreadyExists
+		}
+		ids[tracking.ID] = struct{}{}
+	}
+	for i, tracking := range trackings {
+		d.trackings[tracking.ID] = copyTracking(tracking)
+		res := *jobs[i]
+		d.weatherJobs[res.ID] = &res
+	}
+
+	return nil
+}
+
+func (d *database) UpdateTrackingWithWeatherJob(tracking *storage.Tracking, job *storage.WeatherJob) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.trackings[tracking.ID]; !ok {
+		return storage.ErrNotFound
+	}
+	d.trackings[tracking.ID] = copyTracking(tracking)
+	res := *job
+	d.weatherJobs[job.ID] = &res
+
+	return nil
+}
+
+func (d *database) SetTrackingWeather(id uuid.UUID, weather *storage.Weather) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tracking, ok := d.trackings[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	res := *weather
+	tracking.Weather = &res
+
+	return nil
+}
+
+func (d *database) DeleteTracking(id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.trackings, id)
+
+	return nil
+}
+
+func (d *database) GetTracking(id uuid.UUID) (*storage.Tracking, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	tracking, ok := d.trackings[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	return copyTracking(tracking), nil
+}
+
+func (d *database) ListTrackings(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	return d.listTrackings(filter, func(*storage.Tracking) bool {
+		return true
+	})
+}
+
+func (d *database) ListTrackingsForUser(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	return d.listTrackings(filter, func(tracking *storage.Tracking) bool {
+		return tracking.UserID == filter.UserID
+	})
+}
+
+func (d *database) ListTrackingsForUsers(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	users := make(map[uuid.UUID]bool, len(filter.UserIDs))
+	for _, id := range filter.UserIDs {
+		users[id] = true
+	}
+
+	return d.listTrackings(filter, func(tracking *storage.Tracking) bool {
+		return users[tracking.UserID]
+	})
+}
+
+func (d *database) listTrackings(
+	filter *storage.TrackingFilter,
+	accept func(tracking *storage.Tracking) bool,
+) (*storage.ListTrackingsResponse, error) {
+	var query bson.D
+	var err error
+
+	if filter.Query != "" {
+		query, err = filterparser.ParseTracking(filter.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filter.Cursor != "" && !bson.IsObjectIdHex(filter.Cursor) {
+		return nil, storage.ErrInvalidCursor
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	matched := make([]*storage.Tracking, 0)
+	for _, tracking := range d.trackings {
+		if !accept(tracking) || filter.MissingWeather && !tracking.Weather.IsEmpty() {
+			continue
+		}
+		ok, err := match(tracking, query)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, tracking)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Cursor < matched[j].Cursor
+	})
+
+	limit := defaultTrackingPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+	trackings := make([]*storage.Tracking, 0, limit)
+	for _, tracking := range matched {
+		if len(trackings) == limit {
+			break
+		}
+		if filter.Cursor != "" && tracking.Cursor <= bson.ObjectIdHex(filter.Cursor) {
+			continue
+		}
+		trackings = append(trackings, copyTracking(tracking))
+	}
+
+	return &storage.ListTrackingsResponse{
+		Total:     int64(len(matched)),
+		Trackings: trackings,
+	}, nil
+}
+
+func (d *database) GetReport(filter *storage.ReportFilter) (*storage.Report, error) {
+	start := filter.FromDate
+	duration := defaultDuration
+	if filter.Duration != time.Duration(0) {
+		duration = filter.Duration
+	}
+	end := start.Add(duration)
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	count := 0
+	resTime := time.Duration(0)
+	resDistance := float64(0)
+	buckets := make(map[time.Time]*storage.ReportBucket)
+	for _, tracking := range d.trackings {
+		if tracking.UserID != filter.UserID {
+			continue
+		}
+		if tracking.Date.Before(start) || !tracking.Date.Before(end) {
+			continue
+		}
+		count++
+		resTime += tracking.Time
+		resDistance += float64(tracking.Distance)
+
+		if filter.Granularity == storage.NoGranularity {
+			continue
+		}
+		bucketStart, err := bucketStart(filter.Granularity, start, tracking.Date)
+		if err != nil {
+			return nil, err
+		}
+		bucket, ok := buckets[bucketStart]
+		if !ok {
+			bucket = &storage.ReportBucket{StartDate: bucketStart}
+			buckets[bucketStart] = bucket
+		}
+		bucket.Count++
+		bucket.Time += tracking.Time
+		bucket.Distance += tracking.Distance
+		if tracking.Distance > bucket.LongestRun {
+			bucket.LongestRun = tracking.Distance
+		}
+	}
+
+	if count == 0 {
+		return &storage.Report{
+			AverageSpeed: 0,
+			Distance:     0,
+			Buckets:      []*storage.ReportBucket{},
+		}, nil
+	}
+	averageSpeed := resDistance / resTime.Seconds()
+
+	report := &storage.Report{
+		AverageSpeed: float32(averageSpeed),
+		Distance:     float32(resDistance),
+		Buckets:      make([]*storage.ReportBucket, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		if bucket.Time != 0 {
+			bucket.AverageSpeed = float32(float64(bucket.Distance) / bucket.Time.Seconds())
+		}
+		report.Buckets = append(report.Buckets, bucket)
+	}
+	sort.Slice(report.Buckets, func(i, j int) bool {
+		return report.Buckets[i].StartDate.Before(report.Buckets[j].StartDate)
+	})
+
+	return report, nil
+}
+
+// bucketStart returns the first moment of the report bucket the date falls into.
+func bucketStart(granularity storage.Granularity, start, date time.Time) (time.Time, error) {
+	date = date.UTC()
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch granularity {
+	case storage.DayGranularity:
+		return day, nil
+	case storage.WeekGranularity:
+		week := 7 * 24 * time.Hour
+		return date.Add(-(date.Sub(start) % week)), nil
+	case storage.ISOWeekGranularity:
+		// Sunday is the last day of ISO week
+		weekday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -weekday), nil
+	case storage.MonthGranularity:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, storage.ErrUnknownGranularity
+	}
+}
+
+func copyTracking(tracking *storage.Tracking) *storage.Tracking {
+	res := *tracking
+	if tracking.Weather != nil {
+		weather := *tracking.Weather
+		res.Weather = &weather
+	}
+	if tracking.Route != nil {
+		res.Route = append(storage.Route(nil), tracking.Route...)
+	}
+
+	return &res
+}
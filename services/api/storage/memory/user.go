@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/mongo/filterparser"
+)
+
+const defaultUsersPerRequest = 10
+
+func (d *database) SaveUser(user *storage.User) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.users[user.ID]; ok {
+		return storage.ErrAlreadyExists
+	}
+	if d.hasEmail(user.Email, user.ID) {
+		return storage.ErrAlreadyExists
+	}
+	d.users[user.ID] = copyUser(user)
+
+	return nil
+}
+
+func (d *database) UpdateUser(user *storage.User) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.users[user.ID]; !ok {
+		return storage.ErrNotFound
+	}
+	if d.hasEmail(user.Email, user.ID) {
+		return storage.ErrAlreadyExists
+	}
+	d.users[user.ID] = copyUser(user)
+
+	return nil
+}
+
+func (d *database) DeleteUser(id uuid.UUID) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.users, id)
+
+	return nil
+}
+
+func (d *database) GetUser(id uuid.UUID) (*storage.User, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	user, ok := d.users[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+
+	return copyUser(user), nil
+}
+
+func (d *database) GetUserByEmail(email string) (*storage.User, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, user := range d.users {
+		if user.Email == email {
+			return copyUser(user), nil
+		}
+	}
+
+	return nil, storage.ErrNotFound
+}
+
+func (d *database) ListUsers(filter *storage.UserFilter) (*storage.ListUsersResponse, error) {
+	var query bson.D
+	var err error
+
+	if filter.Query != "" {
+		query, err = filterparser.ParseUsers(filter.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if filter.Cursor != "" && !bson.IsObjectIdHex(filter.Cursor) {
+		return nil, storage.ErrInvalidCursor
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	matched := make([]*storage.User, 0)
+	for _, user := range d.users {
+		ok, err := match(user, query)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, user)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Cursor < matched[j].Cursor
+	})
+
+	limit := defaultUsersPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+	users := make([]*storage.User, 0, limit)
+	for _, user := range matched {
+		if len(users) == limit {
+			break
+		}
+		if filter.Cursor != "" && user.Cursor <= bson.ObjectIdHex(filter.Cursor) {
+			continue
+		}
+		users = append(users, copyUser(user))
+	}
+
+	return &storage.ListUsersResponse{
+		Total: int64(len(matched)),
+		Users: users,
+	}, nil
+}
+
+// hasEmail emulates unique email index. Must be called under lock.
+func (d *database) hasEmail(email string, except uuid.UUID) bool {
+	for _, user := range d.users {
+		if user.Email == email && user.ID != except {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyUser(user *storage.User) *storage.User {
+	res := *user
+	res.Roles = append([]uuid.UUID(nil), user.Roles...)
+	res.ACL = append([]storage.Permission(nil), user.ACL...)
+
+	return &res
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) GetWeatherCacheEntry(key string) (*storage.WeatherCacheEntry, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	entry, ok := d.weatherCache[key]
+	if !ok || !entry.ExpiresAt.After(time.Now()) {
+		return nil, storage.ErrNotFound
+	}
+
+	return copyWeatherCacheEntry(entry), nil
+}
+
+func (d *database) SaveWeatherCacheEntry(entry *storage.WeatherCacheEntry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.weatherCache[entry.Key] = copyWeatherCacheEntry(entry)
+
+	return nil
+}
+
+func copyWeatherCacheEntry(entry *storage.WeatherCacheEntry) *storage.WeatherCacheEntry {
+	res := *entry
+	if entry.Weather != nil {
+		weather := *entry.Weather
+		res.Weather = &weather
+	}
+
+	return &res
+}
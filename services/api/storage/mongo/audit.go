@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	auditCollection = "audit_events"
+
+	defaultAuditEventsPerRequest = 10
+)
+
+func (d *database) SaveAuditEvent(event *storage.AuditEvent) error {
+	return d.session.DB(d.name).C(auditCollection).Insert(event)
+}
+
+func (d *database) ListAuditEvents(filter *storage.AuditEventFilter) (*storage.ListAuditEventsResponse, error) {
+	var events []*storage.AuditEvent
+	query := bson.D{}
+	if filter.ActorID != uuid.Nil {
+		query = append(query, bson.DocElem{Name: "actor_id", Value: filter.ActorID})
+	}
+	if filter.TargetItem != "" {
+		query = append(query, bson.DocElem{Name: "target.item", Value: filter.TargetItem})
+	}
+
+	total, err := d.session.DB(d.name).C(auditCollection).Find(query).Count()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Cursor != "" {
+		if !bson.IsObjectIdHex(filter.Cursor) {
+			return nil, storage.ErrInvalidCursor
+		}
+		query = append(query, bson.DocElem{Name: "cursor", Value: bson.D{{Name: "$gt", Value: bson.ObjectIdHex(filter.Cursor)}}})
+	}
+	mongoQuery := d.session.DB(d.name).C(auditCollection).Find(query)
+	mongoQuery.Sort("cursor")
+
+	limit := defaultAuditEventsPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+	mongoQuery.Limit(limit)
+
+	if err := mongoQuery.All(&events); err != nil {
+		return nil, err
+	}
+
+	return &storage.ListAuditEventsResponse{
+		Total:  int64(total),
+		Events: events,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const weatherJobCollection = "weather_jobs"
+
+func (d *database) SaveWeatherJob(job *storage.WeatherJob) error {
+	if _, err := d.session.DB(d.name).C(weatherJobCollection).UpsertId(job.ID, job); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) LeaseWeatherJob(now, leaseUntil time.Time) (*storage.WeatherJob, error) {
+	// find and modify is atomic, so the job is leased by one worker only
+	var job storage.WeatherJob
+	_, err := d.session.DB(d.name).C(weatherJobCollection).
+		Find(bson.M{"status": storage.PendingJobStatus, "run_at": bson.M{"$lte": now}}).
+		Sort("run_at").
+		Apply(mgo.Change{
+			Update: bson.M{
+				"$inc": bson.M{"attempts": 1},
+				"$set": bson.M{"run_at": leaseUntil, "lease_id": uuid.New()},
+			},
+			ReturnNew: true,
+		}, &job)
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (d *database) AckWeatherJob(job *storage.WeatherJob) error {
+	if err := d.session.DB(d.name).C(weatherJobCollection).
+		Remove(bson.M{"_id": job.ID, "lease_id": job.LeaseID}); err != nil {
+		if err == mgo.ErrNotFound {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) UpdateWeatherJob(job *storage.WeatherJob) error {
+	if err := d.session.DB(d.name).C(weatherJobCollection).
+		Update(bson.M{"_id": job.ID, "lease_id": job.LeaseID}, job); err != nil {
+		if err == mgo.ErrNotFound {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) GetWeatherJob(id uuid.UUID) (*storage.WeatherJob, error) {
+	var job storage.WeatherJob
+	if err := d.session.DB(d.name).C(weatherJobCollection).FindId(id).One(&job); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,67 @@
+package mongo
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const loginAttemptsCollection = "login_attempts"
+
+func (d *database) RecordLoginFailure(key string, at, expiresAt time.Time) (*storage.LoginAttempts, error) {
+	collection := d.session.DB(d.name).C(loginAttemptsCollection)
+	// TTL index removes expired attempts only eventually, so the counter
+	// starts over here
+	if err := collection.Remove(bson.M{"_id": key, "expires_at": bson.M{"$lte": at}}); err != nil &&
+		err != mgo.ErrNotFound {
+		return nil, err
+	}
+
+	change := mgo.Change{
+		Update: bson.M{
+			"$inc": bson.M{"failures": 1},
+			"$set": bson.M{"last_failure_at": at, "expires_at": expiresAt},
+		},
+		Upsert:    true,
+		ReturnNew: true,
+	}
+	var attempts storage.LoginAttempts
+	_, err := collection.FindId(key).Apply(change, &attempts)
+	// concurrent first failures both insert the key, the one losing the race
+	// updates the inserted document
+	if mgo.IsDup(err) {
+		_, err = collection.FindId(key).Apply(change, &attempts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &attempts, nil
+}
+
+func (d *database) GetLoginAttempts(key string) (*storage.LoginAttempts, error) {
+	var attempts storage.LoginAttempts
+	if err := d.session.DB(d.name).C(loginAttemptsCollection).
+		Find(bson.M{"_id": key, "expires_at": bson.M{"$gt": time.Now()}}).One(&attempts); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &attempts, nil
+}
+
+func (d *database) DeleteLoginAttempts(key string) error {
+	if err := d.session.DB(d.name).C(loginAttemptsCollection).RemoveId(key); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
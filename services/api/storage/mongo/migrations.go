@@ -0,0 +1,112 @@
+package mongo
+
+import (
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// migrateACL removes ACL entries, which are granted by ownership policies
+// now: entries for the user itself and for its trackings. Entries for removed
+// trackings are removed too. It's idempotent, so it runs on every start.
+func migrateACL(db *mgo.Database) error {
+	users := db.C(userCollection)
+	iter := users.Find(bson.M{
+		"acl.resource.scope": bson.M{"$in": []storage.Scope{storage.UserScope, storage.TrackingScope}},
+	}).Iter()
+
+	var user storage.User
+	for iter.Next(&user) {
+		acl := make([]storage.Permission, 0, len(user.ACL))
+		for _, permission := range user.ACL {
+			owned, err := isOwnedOrRemoved(db, user.ID, permission.Resource)
+			if err != nil {
+				iter.Close()
+
+				return err
+			}
+			if !owned {
+				acl = append(acl, permission)
+			}
+		}
+		if len(acl) == len(user.ACL) {
+			continue
+		}
+		if err := users.UpdateId(user.ID, bson.M{"$set": bson.M{"acl": acl}}); err != nil {
+			iter.Close()
+
+			return err
+		}
+	}
+
+	return iter.Close()
+}
+
+func isOwnedOrRemoved(db *mgo.Database, userID uuid.UUID, resource storage.Resource) (bool, error) {
+	switch resource.Scope {
+	case storage.UserScope:
+		return resource.Item == userID.String(), nil
+	case storage.TrackingScope:
+		id, err := uuid.Parse(resource.Item)
+		if err != nil {
+			return false, nil
+		}
+		var tracking storage.Tracking
+		err = db.C(trackingCollection).FindId(id).Select(bson.M{"user_id": 1}).One(&tracking)
+		if err == mgo.ErrNotFound {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		return tracking.UserID == userID, nil
+	default:
+		return false, nil
+	}
+}
+
+// migrateRoles replaces names of built-in roles in users by their ids. It's
+// idempotent, so it runs on every start.
+func migrateRoles(db *mgo.Database) error {
+	ids := make(map[string]uuid.UUID, len(storage.BuiltInRoles))
+	for _, role := range storage.BuiltInRoles {
+		ids[role.Name] = role.ID
+	}
+
+	users := db.C(userCollection)
+	iter := users.Find(bson.M{"roles": bson.M{"$type": "string"}}).Select(bson.M{"roles": 1}).Iter()
+	var user struct {
+		ID    uuid.UUID `bson:"_id"`
+		Roles []string  `bson:"roles"`
+	}
+	for iter.Next(&user) {
+		roles := make([]uuid.UUID, 0, len(user.Roles))
+		for _, name := range user.Roles {
+			if id, ok := ids[name]; ok {
+				roles = append(roles, id)
+			}
+		}
+		if err := users.UpdateId(user.ID, bson.M{"$set": bson.M{"roles": roles}}); err != nil {
+			iter.Close()
+
+			return err
+		}
+	}
+
+	return iter.Close()
+}
+
+// migrateEmailVerified marks users created before email verification as
+// verified, so they aren't restricted. It's idempotent, so it runs on every
+// start.
+func migrateEmailVerified(db *mgo.Database) error {
+	_, err := db.C(userCollection).UpdateAll(
+		bson.M{"email_verified": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"email_verified": true}},
+	)
+
+	return err
+}
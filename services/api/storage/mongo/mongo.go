@@ -1,7 +1,10 @@
 package mongo
 
 import (
+	"time"
+
 	"github.com/boodyvo/jogging-api/services/api/storage"
+	log "github.com/sirupsen/logrus"
 
 	"gopkg.in/mgo.v2"
 )
@@ -9,6 +12,7 @@ import (
 type database struct {
 	session *mgo.Session
 	name    string
+	logger  *log.Logger
 }
 
 type Index struct {
@@ -27,6 +31,54 @@ var (
 				},
 			},
 		},
+		{
+			CollectionName: "roles",
+			Index: []mgo.Index{
+				{
+					Key:    []string{"name"},
+					Unique: true,
+				},
+			},
+		},
+		{
+			CollectionName: "audit_events",
+			Index: []mgo.Index{
+				// for pagination
+				{
+					Key:    []string{"cursor"},
+					Unique: true,
+				},
+				{
+					Key:    []string{"actor_id", "cursor"},
+					Unique: false,
+				},
+				{
+					Key:    []string{"target.item", "cursor"},
+					Unique: false,
+				},
+			},
+		},
+		{
+			CollectionName: "teams",
+			Index: []mgo.Index{
+				{
+					Key:    []string{"owner_id"},
+					Unique: false,
+				},
+				{
+					Key:    []string{"coaches"},
+					Unique: false,
+				},
+				{
+					Key:    []string{"members"},
+					Unique: false,
+				},
+				{
+					Key:    []string{"invitations.user_id"},
+					Unique: false,
+				},
+			},
+		},
 		{
 			CollectionName: "trackings",
 			Index: []mgo.Index{
@@ -58,12 +110,83 @@ var (
 					Key:    []string{"refresh_token"},
 					Unique: true,
 				},
+				// for revocation
+				{
+					Key:    []string{"family"},
+					Unique: false,
+				},
+				{
+					Key:    []string{"user_id"},
+					Unique: false,
+				},
+			},
+		},
+		{
+			CollectionName: "one_time_tokens",
+			Index: []mgo.Index{
+				{
+					Key:    []string{"user_id", "purpose"},
+					Unique: false,
+				},
+				// TTL, expired tokens are useless
+				{
+					Key:         []string{"expires_at"},
+					ExpireAfter: time.Second,
+				},
+			},
+		},
+		{
+			CollectionName: "weather_jobs",
+			Index: []mgo.Index{
+				// for lease
+				{
+					Key:    []string{"status", "run_at"},
+					Unique: false,
+				},
+			},
+		},
+		{
+			CollectionName: "weather_cache",
+			Index: []mgo.Index{
+				// TTL
+				{
+					Key:         []string{"expires_at"},
+					ExpireAfter: time.Second,
+				},
+			},
+		},
+		{
+			CollectionName: "login_attempts",
+			Index: []mgo.Index{
+				// TTL, counter starts over after the window
+				{
+					Key:         []string{"expires_at"},
+					ExpireAfter: time.Second,
+				},
+			},
+		},
+		{
+			CollectionName: "revocations",
+			Index: []mgo.Index{
+				{
+					Key:    []string{"token_id"},
+					Unique: false,
+				},
+				{
+					Key:    []string{"user_id"},
+					Unique: false,
+				},
+				// TTL, revocation is removed when all revoked tokens are expired
+				{
+					Key:         []string{"expires_at"},
+					ExpireAfter: time.Second,
+				},
 			},
 		},
 	}
 )
 
-func New(url, name string) (storage.Storage, error) {
+func New(url, name string, logger *log.Logger) (storage.Storage, error) {
 	session, err := mgo.Dial(url)
 	if err != nil {
 		return nil, err
@@ -78,8 +201,19 @@ func New(url, name string) (storage.Storage, error) {
 		}
 	}
 
+	if err := migrateRoles(session.DB(name)); err != nil {
+		return nil, err
+	}
+	if err := migrateACL(session.DB(name)); err != nil {
+		return nil, err
+	}
+	if err := migrateEmailVerified(session.DB(name)); err != nil {
+		return nil, err
+	}
+
 	return &database{
 		session: session,
 		name:    name,
+		logger:  logger,
 	}, nil
 }
@@ -0,0 +1,45 @@
+package mongo
+
+import (
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const oneTimeTokenCollection = "one_time_tokens"
+
+func (d *database) SaveOneTimeToken(token *storage.OneTimeToken) error {
+	if err := d.session.DB(d.name).C(oneTimeTokenCollection).Insert(token); err != nil {
+		if mgo.IsDup(err) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) ConsumeOneTimeToken(hash string) (*storage.OneTimeToken, error) {
+	// find and remove is atomic, so the token is consumed only once
+	var token storage.OneTimeToken
+	if _, err := d.session.DB(d.name).C(oneTimeTokenCollection).
+		FindId(hash).Apply(mgo.Change{Remove: true}, &token); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (d *database) DeleteOneTimeTokens(userID uuid.UUID, purpose storage.TokenPurpose) error {
+	if _, err := d.session.DB(d.name).C(oneTimeTokenCollection).
+		RemoveAll(bson.M{"user_id": userID, "purpose": purpose}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const revocationCollection = "revocations"
+
+func (d *database) SaveRevocation(revocation *storage.Revocation) error {
+	if err := d.session.DB(d.name).C(revocationCollection).Insert(revocation); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) IsTokenRevoked(userID uuid.UUID, tokenID string, issuedAt time.Time) (bool, error) {
+	conditions := []bson.M{{
+		"user_id":       userID,
+		"token_id":      bson.M{"$exists": false},
+		"issued_before": bson.M{"$gt": issuedAt},
+	}}
+	if tokenID != "" {
+		conditions = append(conditions, bson.M{"token_id": tokenID})
+	}
+
+	// TTL index removes expired revocations only once a minute
+	count, err := d.session.DB(d.name).C(revocationCollection).Find(bson.M{
+		"expires_at": bson.M{"$gt": time.Now()},
+		"$or":        conditions,
+	}).Count()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
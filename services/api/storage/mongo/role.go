@@ -0,0 +1,68 @@
+package mongo
+
+import (
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const roleCollection = "roles"
+
+func (d *database) SaveRole(role *storage.Role) error {
+	if err := d.session.DB(d.name).C(roleCollection).Insert(role); err != nil {
+		if mgo.IsDup(err) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) UpdateRole(role *storage.Role) error {
+	if err := d.session.DB(d.name).C(roleCollection).UpdateId(role.ID, role); err != nil {
+		if err == mgo.ErrNotFound {
+			return storage.ErrNotFound
+		}
+		if mgo.IsDup(err) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) DeleteRole(id uuid.UUID) error {
+	if err := d.session.DB(d.name).C(roleCollection).RemoveId(id); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) GetRole(id uuid.UUID) (*storage.Role, error) {
+	var role storage.Role
+	if err := d.session.DB(d.name).C(roleCollection).FindId(id).One(&role); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (d *database) ListRoles() ([]*storage.Role, error) {
+	roles := make([]*storage.Role, 0)
+	if err := d.session.DB(d.name).C(roleCollection).Find(bson.M{}).Sort("name").All(&roles); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,71 @@
+package mongo
+
+import (
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const teamCollection = "teams"
+
+func (d *database) SaveTeam(team *storage.Team) error {
+	if err := d.session.DB(d.name).C(teamCollection).Insert(team); err != nil {
+		if mgo.IsDup(err) {
+			return storage.ErrAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) UpdateTeam(team *storage.Team) error {
+	if err := d.session.DB(d.name).C(teamCollection).UpdateId(team.ID, team); err != nil {
+		if err == mgo.ErrNotFound {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) DeleteTeam(id uuid.UUID) error {
+	if err := d.session.DB(d.name).C(teamCollection).RemoveId(id); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) GetTeam(id uuid.UUID) (*storage.Team, error) {
+	var team storage.Team
+	if err := d.session.DB(d.name).C(teamCollection).FindId(id).One(&team); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+func (d *database) ListTeamsForUser(userID uuid.UUID) ([]*storage.Team, error) {
+	teams := make([]*storage.Team, 0)
+	query := bson.M{"$or": []bson.M{
+		{"owner_id": userID},
+		{"coaches": userID},
+		{"members": userID},
+		{"invitations.user_id": userID},
+	}}
+	if err := d.session.DB(d.name).C(teamCollection).Find(query).All(&teams); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
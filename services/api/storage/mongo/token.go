@@ -1,6 +1,8 @@
 package mongo
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/boodyvo/jogging-api/services/api/storage"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
@@ -40,3 +42,41 @@ func (d *database) GetToken(refreshToken string) (*storage.Token, error) {
 
 	return &token, nil
 }
+
+func (d *database) RotateToken(old, new *storage.Token) error {
+	// Remove is atomic, so only one of concurrent rotations succeeds.
+	if err := d.session.DB(d.name).C(tokenCollection).
+		Remove(bson.M{"refresh_token": old.Refresh}); err != nil {
+		if err == mgo.ErrNotFound {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+
+	return d.SaveToken(new)
+}
+
+func (d *database) DeleteTokensByFamily(family uuid.UUID) error {
+	if _, err := d.session.DB(d.name).C(tokenCollection).RemoveAll(bson.M{"family": family}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) ListTokensByUser(userID uuid.UUID) ([]*storage.Token, error) {
+	tokens := make([]*storage.Token, 0)
+	if err := d.session.DB(d.name).C(tokenCollection).Find(bson.M{"user_id": userID}).All(&tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (d *database) DeleteTokensByUser(userID uuid.UUID) error {
+	if _, err := d.session.DB(d.name).C(tokenCollection).RemoveAll(bson.M{"user_id": userID}); err != nil {
+		return err
+	}
+
+	return nil
+}
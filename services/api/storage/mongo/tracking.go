@@ -13,6 +13,9 @@ import (
 const (
 	defaultTrackingPerRequest = 10
 	defaultDuration           = 7 * 24 * time.Hour
+	// heldJobDelay hides the weather job saved before its tracking from
+	// workers, until the tracking is saved
+	heldJobDelay = time.Minute
 
 	trackingCollection = "trackings"
 )
@@ -36,6 +39,95 @@ func (d *database) UpdateTracking(tracking *storage.Tracking) error {
 	return nil
 }
 
+// SaveTrackingWithWeatherJob saves the job before the tracking, as there are
+// no transactions. The job is held until the tracking is saved, so workers
+// don't lease it before. If saving stops in between, the held job runs after
+// heldJobDelay anyway.
+func (d *database) SaveTrackingWithWeatherJob(tracking *storage.Tracking, job *storage.WeatherJob) error {
+	return d.SaveTrackingsWithWeatherJobs([]*storage.Tracking{tracking}, []*storage.WeatherJob{job})
+}
+
+// SaveTrackingsWithWeatherJobs removes trackings saved before the error, so
+// none of them are left unless removing fails too.
+func (d *database) SaveTrackingsWithWeatherJobs(trackings []*storage.Tracking, jobs []*storage.WeatherJob) error {
+	return d.saveWithHeldWeatherJobs(jobs, func() error {
+		for i, tracking := range trackings {
+			if err := d.SaveTracking(tracking); err != nil {
+				ids := make([]uuid.UUID, 0, len(jobs))
+				for _, saved := range trackings[:i] {
+					_ = d.DeleteTracking(saved.ID)
+				}
+				for _, job := range jobs {
+					ids = append(ids, job.ID)
+				}
+				_, _ = d.session.DB(d.name).C(weatherJobCollection).RemoveAll(bson.M{"_id": bson.M{"$in": ids}})
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *database) UpdateTrackingWithWeatherJob(tracking *storage.Tracking, job *storage.WeatherJob) error {
+	return d.saveWithHeldWeatherJobs([]*storage.WeatherJob{job}, func() error {
+		return d.UpdateTracking(tracking)
+	})
+}
+
+func (d *database) saveWithHeldWeatherJobs(jobs []*storage.WeatherJob, save func() error) error {
+	heldRunAt := make([]time.Time, 0, len(jobs))
+	for _, job := range jobs {
+		held := *job
+		held.RunAt = job.RunAt.Add(heldJobDelay)
+		if err := d.SaveWeatherJob(&held); err != nil {
+			return err
+		}
+		heldRunAt = append(heldRunAt, held.RunAt)
+	}
+	if err := save(); err != nil {
+		return err
+	}
+	// the held job runs later if it's not released, so it's not an error
+	for i, job := range jobs {
+		if err := d.releaseWeatherJob(job, heldRunAt[i]); err != nil {
+			d.logger.
+				WithField("job", job.ID).
+				WithField("err", err).
+				Warn("cannot release held weather job, it runs later")
+		}
+	}
+
+	return nil
+}
+
+// releaseWeatherJob makes the held job due at its run time. The job leased
+// or saved again meanwhile is left as it is, so the lease holder could ack
+// it.
+func (d *database) releaseWeatherJob(job *storage.WeatherJob, heldRunAt time.Time) error {
+	err := d.session.DB(d.name).C(weatherJobCollection).Update(
+		bson.M{"_id": job.ID, "status": storage.PendingJobStatus, "run_at": heldRunAt},
+		bson.M{"$set": bson.M{"run_at": job.RunAt}},
+	)
+	if err != nil && err != mgo.ErrNotFound {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) SetTrackingWeather(id uuid.UUID, weather *storage.Weather) error {
+	if err := d.session.DB(d.name).C(trackingCollection).
+		UpdateId(id, bson.M{"$set": bson.M{"weather": weather}}); err != nil {
+		if err == mgo.ErrNotFound {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (d *database) DeleteTracking(id uuid.UUID) error {
 	if err := d.session.DB(d.name).C(trackingCollection).RemoveId(id); err != nil {
 		if err == mgo.ErrNotFound {
@@ -59,6 +151,20 @@ func (d *database) GetTracking(id uuid.UUID) (*storage.Tracking, error) {
 	return &tracking, nil
 }
 
+// missingWeatherQuery matches trackings with empty weather.
+var missingWeatherQuery = bson.D{{"$or", []bson.D{
+	{{"weather", nil}},
+	{
+		{"weather.temperature", 0},
+		{"weather.temperature_min", 0},
+		{"weather.temperature_max", 0},
+		{"weather.snowdepth", 0},
+		{"weather.winddirection", 0},
+		{"weather.windspeed", 0},
+		{"weather.pressure", 0},
+	},
+}}}
+
 func (d *database) ListTrackings(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
 	var trackings []*storage.Tracking
 	var err error
@@ -70,6 +176,9 @@ func (d *database) ListTrackings(filter *storage.TrackingFilter) (*storage.ListT
 			return nil, err
 		}
 	}
+	if filter.MissingWeather {
+		query = bson.D{{"$and", []bson.D{query, missingWeatherQuery}}}
+	}
 
 	mongoQuery := d.session.DB(d.name).C(trackingCollection).Find(query)
 	total, err := mongoQuery.Count()
@@ -91,7 +200,7 @@ func (d *database) ListTrackings(filter *storage.TrackingFilter) (*storage.ListT
 	mongoQuery.Sort("cursor")
 
 	limit := defaultTrackingPerRequest
-	if filter.PerRequest != 0 {
+	if filter.PerRequest > 0 {
 		limit = int(filter.PerRequest)
 	}
 	mongoQuery.Limit(limit)
@@ -107,6 +216,19 @@ func (d *database) ListTrackings(filter *storage.TrackingFilter) (*storage.ListT
 }
 
 func (d *database) ListTrackingsForUser(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	return d.listTrackingsMatching(filter, bson.D{{"user_id", bson.D{{"$eq", filter.UserID}}}})
+}
+
+func (d *database) ListTrackingsForUsers(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	return d.listTrackingsMatching(filter, bson.D{{"user_id", bson.D{{"$in", filter.UserIDs}}}})
+}
+
+// listTrackingsMatching lists trackings matching both the filter query and
+// the match condition.
+func (d *database) listTrackingsMatching(
+	filter *storage.TrackingFilter,
+	match bson.D,
+) (*storage.ListTrackingsResponse, error) {
 	var trackings []*storage.Tracking
 	var err error
 	query := bson.D{}
@@ -117,10 +239,13 @@ func (d *database) ListTrackingsForUser(filter *storage.TrackingFilter) (*storag
 			return nil, err
 		}
 	}
+	if filter.MissingWeather {
+		query = bson.D{{"$and", []bson.D{query, missingWeatherQuery}}}
+	}
 
 	query = bson.D{{"$and", []bson.D{
 		query,
-		{{"user_id", bson.D{{"$eq", filter.UserID}}}},
+		match,
 	}}}
 
 	mongoQuery := d.session.DB(d.name).C(trackingCollection).Find(query)
@@ -143,7 +268,7 @@ func (d *database) ListTrackingsForUser(filter *storage.TrackingFilter) (*storag
 	mongoQuery.Sort("cursor")
 
 	limit := defaultTrackingPerRequest
-	if filter.PerRequest != 0 {
+	if filter.PerRequest > 0 {
 		limit = int(filter.PerRequest)
 	}
 	mongoQuery.Limit(limit)
@@ -166,7 +291,7 @@ func (d *database) GetReport(filter *storage.ReportFilter) (*storage.Report, err
 	}
 	end := start.Add(duration)
 
-	pipeline := []bson.M{
+	match := []bson.M{
 		{
 			"$match": bson.M{"user_id": filter.UserID},
 		},
@@ -176,15 +301,15 @@ func (d *database) GetReport(filter *storage.ReportFilter) (*storage.Report, err
 		{
 			"$match": bson.D{{"date", bson.D{{"$lt", end}}}},
 		},
-		{
-			"$group": bson.M{
-				"_id":        "time",
-				"time":       bson.M{"$sum": "$time"},
-				"distance":   bson.M{"$sum": "$distance"},
-				"time_count": bson.M{"$sum": 1},
-			},
-		},
 	}
+	pipeline := append(match, bson.M{
+		"$group": bson.M{
+			"_id":        "time",
+			"time":       bson.M{"$sum": "$time"},
+			"distance":   bson.M{"$sum": "$distance"},
+			"time_count": bson.M{"$sum": 1},
+		},
+	})
 
 	result := make([]bson.M, 0)
 	col := d.session.DB(d.name).C(trackingCollection)
@@ -196,6 +321,7 @@ func (d *database) GetReport(filter *storage.ReportFilter) (*storage.Report, err
 		return &storage.Report{
 			AverageSpeed: 0,
 			Distance:     0,
+			Buckets:      []*storage.ReportBucket{},
 		}, nil
 	}
 
@@ -210,8 +336,79 @@ func (d *database) GetReport(filter *storage.ReportFilter) (*storage.Report, err
 	}
 	averageSpeed := resDistance / resTime.Seconds()
 
+	buckets := make([]*storage.ReportBucket, 0)
+	if filter.Granularity != storage.NoGranularity {
+		bucketStart, err := bucketStartExpression(filter.Granularity, start)
+		if err != nil {
+			return nil, err
+		}
+		pipeline = append(match,
+			bson.M{
+				"$group": bson.M{
+					"_id":         bucketStart,
+					"count":       bson.M{"$sum": 1},
+					"time":        bson.M{"$sum": "$time"},
+					"distance":    bson.M{"$sum": "$distance"},
+					"longest_run": bson.M{"$max": "$distance"},
+				},
+			},
+			bson.M{
+				"$addFields": bson.M{
+					"average_speed": bson.M{"$cond": []interface{}{
+						bson.M{"$eq": []interface{}{"$time", 0}},
+						0,
+						bson.M{"$divide": []interface{}{"$distance", bson.M{"$divide": []interface{}{"$time", int64(time.Second)}}}},
+					}},
+				},
+			},
+			bson.M{
+				"$sort": bson.M{"_id": 1},
+			},
+		)
+		if err := col.Pipe(pipeline).All(&buckets); err != nil {
+			return nil, err
+		}
+	}
+
 	return &storage.Report{
 		AverageSpeed: float32(averageSpeed),
 		Distance:     float32(resDistance),
+		Buckets:      buckets,
 	}, nil
 }
+
+// bucketStartExpression returns aggregation expression that evaluates to the
+// first moment of the bucket the tracking date falls into.
+func bucketStartExpression(granularity storage.Granularity, start time.Time) (interface{}, error) {
+	day := int64(24 * time.Hour / time.Millisecond)
+	// subtracting two dates gives milliseconds between them
+	dayStart := bson.M{"$subtract": []interface{}{
+		"$date",
+		bson.M{"$mod": []interface{}{bson.M{"$subtract": []interface{}{"$date", time.Unix(0, 0)}}, day}},
+	}}
+
+	switch granularity {
+	case storage.DayGranularity:
+		return dayStart, nil
+	case storage.WeekGranularity:
+		return bson.M{"$subtract": []interface{}{
+			"$date",
+			bson.M{"$mod": []interface{}{bson.M{"$subtract": []interface{}{"$date", start}}, 7 * day}},
+		}}, nil
+	case storage.ISOWeekGranularity:
+		return bson.M{"$subtract": []interface{}{
+			dayStart,
+			bson.M{"$multiply": []interface{}{
+				bson.M{"$subtract": []interface{}{bson.M{"$isoDayOfWeek": "$date"}, 1}},
+				day,
+			}},
+		}}, nil
+	case storage.MonthGranularity:
+		return bson.M{"$dateFromParts": bson.M{
+			"year":  bson.M{"$year": "$date"},
+			"month": bson.M{"$month": "$date"},
+		}}, nil
+	default:
+		return nil, storage.ErrUnknownGranularity
+	}
+}
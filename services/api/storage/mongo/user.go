@@ -15,6 +15,9 @@ const (
 
 func (d *database) SaveUser(user *storage.User) error {
 	if err := d.session.DB(d.name).C(userCollection).Insert(user); err != nil {
+		if mgo.IsDup(err) {
+			return storage.ErrAlreadyExists
+		}
 		return err
 	}
 
@@ -26,6 +29,10 @@ func (d *database) UpdateUser(user *storage.User) error {
 		if err == mgo.ErrNotFound {
 			return storage.ErrNotFound
 		}
+		// email is unique by index
+		if mgo.IsDup(err) {
+			return storage.ErrAlreadyExists
+		}
 		return err
 	}
 
@@ -99,7 +106,7 @@ func (d *database) ListUsers(filter *storage.UserFilter) (*storage.ListUsersResp
 	mongoQuery.Sort("cursor")
 
 	limit := defaultUsersPerRequest
-	if filter.PerRequest != 0 {
+	if filter.PerRequest > 0 {
 		limit = int(filter.PerRequest)
 	}
 	mongoQuery.Limit(limit)
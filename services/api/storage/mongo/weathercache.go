@@ -0,0 +1,34 @@
+package mongo
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const weatherCacheCollection = "weather_cache"
+
+func (d *database) GetWeatherCacheEntry(key string) (*storage.WeatherCacheEntry, error) {
+	var entry storage.WeatherCacheEntry
+	// TTL index removes expired entries only eventually
+	if err := d.session.DB(d.name).C(weatherCacheCollection).
+		Find(bson.M{"_id": key, "expires_at": bson.M{"$gt": time.Now()}}).One(&entry); err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func (d *database) SaveWeatherCacheEntry(entry *storage.WeatherCacheEntry) error {
+	if _, err := d.session.DB(d.name).C(weatherCacheCollection).UpsertId(entry.Key, entry); err != nil {
+		return err
+	}
+
+	return nil
+}
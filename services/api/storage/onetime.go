@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenPurpose string
+
+const (
+	PasswordResetPurpose     TokenPurpose = "password_reset"
+	EmailVerificationPurpose TokenPurpose = "email_verification"
+)
+
+// OneTimeToken is sent to the user by email. Only the hash of the token is
+// stored, and it's deleted when used.
+type OneTimeToken struct {
+	Hash      string       `json:"hash" bson:"_id"`
+	UserID    uuid.UUID    `json:"user_id" bson:"user_id"`
+	Purpose   TokenPurpose `json:"purpose" bson:"purpose"`
+	ExpiresAt time.Time    `json:"expires_at" bson:"expires_at"`
+}
+
+func HashOneTimeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	defaultAuditEventsPerRequest = 10
+
+	auditEventColumnsList = `id, actor_id, action, target_scope, target_item, before, after, request_id, client_ip,
+		peer_ip, created_at, cursor`
+)
+
+func (d *database) SaveAuditEvent(event *storage.AuditEvent) error {
+	_, err := d.db.Exec(
+		`INSERT INTO audit_events (`+auditEventColumnsList+`) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		event.ID, event.ActorID, event.Action, event.Target.Scope, event.Target.Item, event.Before, event.After,
+		event.RequestID, event.ClientIP, event.PeerIP, event.CreatedAt, []byte(event.Cursor),
+	)
+
+	return convertError(err)
+}
+
+func (d *database) ListAuditEvents(filter *storage.AuditEventFilter) (*storage.ListAuditEventsResponse, error) {
+	cond := newCondition(nil)
+	if filter.ActorID != uuid.Nil {
+		cond.add(`actor_id = ?`, filter.ActorID)
+	}
+	if filter.TargetItem != "" {
+		cond.add(`target_item = ?`, filter.TargetItem)
+	}
+
+	var total int64
+	if err := d.db.QueryRow(`SELECT COUNT(*) FROM audit_events WHERE `+cond.String(), cond.args...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	if filter.Cursor != "" {
+		if !bson.IsObjectIdHex(filter.Cursor) {
+			return nil, storage.ErrInvalidCursor
+		}
+		cond.add(`cursor > ?`, []byte(bson.ObjectIdHex(filter.Cursor)))
+	}
+	limit := defaultAuditEventsPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+
+	rows, err := d.db.Query(
+		fmt.Sprintf(
+			`SELECT %s FROM audit_events WHERE %s ORDER BY cursor LIMIT %d`,
+			auditEventColumnsList, cond.String(), limit,
+		),
+		cond.args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*storage.AuditEvent, 0, limit)
+	for rows.Next() {
+		var event storage.AuditEvent
+		var cursor []byte
+		err := rows.Scan(
+			&event.ID, &event.ActorID, &event.Action, &event.Target.Scope, &event.Target.Item, &event.Before,
+			&event.After, &event.RequestID, &event.ClientIP, &event.PeerIP, &event.CreatedAt, &cursor,
+		)
+		if err != nil {
+			return nil, err
+		}
+		event.Cursor = bson.ObjectId(cursor)
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &storage.ListAuditEventsResponse{
+		Total:  total,
+		Events: events,
+	}, nil
+}
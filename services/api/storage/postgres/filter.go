@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+var (
+	trackingColumns = map[string]string{
+		"date":                    "date",
+		"time":                    "duration",
+		"distance":                "distance",
+		"location.longitude":      "longitude",
+		"location.latitude":       "latitude",
+		"weather.temperature":     "weather_temperature",
+		"weather.temperature_min": "weather_temperature_min",
+		"weather.temperature_max": "weather_temperature_max",
+		"weather.snowdepth":       "weather_snowdepth",
+		"weather.winddirection":   "weather_winddirection",
+		"weather.windspeed":       "weather_windspeed",
+		"weather.pressure":        "weather_pressure",
+	}
+	userColumns = map[string]string{
+		"email": "email",
+	}
+
+	comparisons = map[string]string{
+		"$eq": "=",
+		"$ne": "<>",
+		"$gt": ">",
+		"$lt": "<",
+	}
+)
+
+// condition accumulates parameterized SQL condition. Arguments are numbered
+// in order they are added, so one condition could be extended by the caller.
+type condition struct {
+	columns map[string]string
+	where   []string
+	args    []interface{}
+}
+
+func newCondition(columns map[string]string) *condition {
+	return &condition{
+		columns: columns,
+	}
+}
+
+// add appends expression where every ? is replaced with next positional argument.
+func (c *condition) add(expression string, args ...interface{}) {
+	for _, arg := range args {
+		c.args = append(c.args, arg)
+		expression = strings.Replace(expression, "?", fmt.Sprintf("$%d", len(c.args)), 1)
+	}
+	c.where = append(c.where, expression)
+}
+
+// addQuery compiles query built by filterparser into SQL.
+func (c *condition) addQuery(query bson.D) error {
+	if len(query) == 0 {
+		return nil
+	}
+
+	expression, err := c.compile(query)
+	if err != nil {
+		return err
+	}
+	c.where = append(c.where, expression)
+
+	return nil
+}
+
+func (c *condition) String() string {
+	if len(c.where) == 0 {
+		return "TRUE"
+	}
+
+	return strings.Join(c.where, " AND ")
+}
+
+func (c *condition) compile(query bson.D) (string, error) {
+	expressions := make([]string, 0, len(query))
+	for _, elem := range query {
+		expression, err := c.compileElem(elem)
+		if err != nil {
+			return "", err
+		}
+		expressions = append(expressions, expression)
+	}
+
+	return "(" + strings.Join(expressions, " AND ") + ")", nil
+}
+
+func (c *condition) compileElem(elem bson.DocElem) (string, error) {
+	switch elem.Name {
+	case "$and", "$or":
+		subQueries, ok := elem.Value.([]bson.D)
+		if !ok || len(subQueries) == 0 {
+			return "", ErrUnsupportedQuery
+		}
+		expressions := make([]string, 0, len(subQueries))
+		for _, subQuery := range subQueries {
+			expression, err := c.compile(subQuery)
+			if err != nil {
+				return "", err
+			}
+			expressions = append(expressions, expression)
+		}
+		operation := " AND "
+		if elem.Name == "$or" {
+			operation = " OR "
+		}
+
+		return "(" + strings.Join(expressions, operation) + ")", nil
+	}
+
+	column, ok := c.columns[elem.Name]
+	if !ok {
+		return "", ErrUnsupportedQuery
+	}
+	conditions, ok := elem.Value.(bson.D)
+	if !ok || len(conditions) == 0 {
+		return "", ErrUnsupportedQuery
+	}
+	expressions := make([]string, 0, len(conditions))
+	for _, cond := range conditions {
+		comparison, ok := comparisons[cond.Name]
+		if !ok {
+			return "", ErrUnsupportedQuery
+		}
+		c.args = append(c.args, cond.Value)
+		expressions = append(expressions, fmt.Sprintf("%s %s $%d", column, comparison, len(c.args)))
+	}
+
+	return "(" + strings.Join(expressions, " AND ") + ")", nil
+}
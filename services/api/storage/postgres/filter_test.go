@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/boodyvo/jogging-api/services/api/storage/mongo/filterparser"
+)
+
+func TestCondition(t *testing.T) {
+	type TestCase struct {
+		Name   string
+		Query  string
+		Where  string
+		Args   []interface{}
+		Prefix bool
+	}
+
+	tm, _ := time.Parse("2006-01-02", "2020-03-20")
+	tests := []TestCase{
+		{
+			Name:  "empty",
+			Query: "",
+			Where: "TRUE",
+		},
+		{
+			Name:  "simple",
+			Query: "distance gt 10",
+			Where: "((distance > $1))",
+			Args:  []interface{}{float32(10)},
+		},
+		{
+			Name:  "renamed columns",
+			Query: "(time lt 1h) and (location.latitude ne 20.5)",
+			Where: "((((duration < $1)) AND ((latitude <> $2))))",
+			Args:  []interface{}{time.Hour, float64(20.5)},
+		},
+		{
+			Name:  "nested",
+			Query: "(date eq 2020-03-20) or ((distance gt 10) and (weather.temperature lt 5))",
+			Where: "((((date = $1)) OR ((((distance > $2)) AND ((weather_temperature < $3))))))",
+			Args:  []interface{}{tm, float32(10), float32(5)},
+		},
+		{
+			Name:   "with user",
+			Query:  "distance gt 10",
+			Where:  "user_id = $1 AND ((distance > $2))",
+			Args:   []interface{}{uuid.Nil, float32(10)},
+			Prefix: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.Name, func(tt *testing.T) {
+			cond := newCondition(trackingColumns)
+			if tc.Prefix {
+				cond.add("user_id = ?", uuid.Nil)
+			}
+			if tc.Query != "" {
+				query, err := filterparser.ParseTracking(tc.Query)
+				assert.NoError(tt, err, "cannot parse query")
+				assert.NoError(tt, cond.addQuery(query), "cannot compile query")
+			}
+
+			assert.Equal(tt, tc.Where, cond.String(), "condition is incorrect")
+			assert.Equal(tt, tc.Args, cond.args, "arguments are incorrect")
+		})
+	}
+}
+
+func TestConditionUsers(t *testing.T) {
+	query, err := filterparser.ParseUsers("email eq test@gmail.com")
+	assert.NoError(t, err, "cannot parse query")
+
+	cond := newCondition(trackingColumns)
+	assert.Equal(t, ErrUnsupportedQuery, cond.addQuery(query), "unknown column is compiled")
+
+	cond = newCondition(userColumns)
+	assert.NoError(t, cond.addQuery(query), "cannot compile query")
+	assert.Equal(t, "((email = $1))", cond.String())
+}
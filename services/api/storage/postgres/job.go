@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const weatherJobColumnsList = `id, status, attempts, run_at, lease_id, last_error, created_at`
+
+func (d *database) SaveWeatherJob(job *storage.WeatherJob) error {
+	return saveWeatherJob(d.db, job)
+}
+
+func saveWeatherJob(db executor, job *storage.WeatherJob) error {
+	_, err := db.Exec(
+		`INSERT INTO weather_jobs (`+weatherJobColumnsList+`) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET status = $2, attempts = $3, run_at = $4, lease_id = $5, last_error = $6,
+			created_at = $7`,
+		job.ID, job.Status, job.Attempts, job.RunAt, job.LeaseID, job.LastError, job.CreatedAt,
+	)
+
+	return convertError(err)
+}
+
+func (d *database) LeaseWeatherJob(now, leaseUntil time.Time) (*storage.WeatherJob, error) {
+	// locked rows are skipped, so concurrent workers lease different jobs
+	row := d.db.QueryRow(
+		`UPDATE weather_jobs SET attempts = attempts + 1, run_at = $3, lease_id = $4
+		WHERE id = (
+			SELECT id FROM weather_jobs WHERE status = $1 AND run_at <= $2
+			ORDER BY run_at LIMIT 1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+weatherJobColumnsList,
+		storage.PendingJobStatus, now, leaseUntil, uuid.New(),
+	)
+	job, err := scanWeatherJob(row)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return job, nil
+}
+
+func (d *database) AckWeatherJob(job *storage.WeatherJob) error {
+	res, err := d.db.Exec(`DELETE FROM weather_jobs WHERE id = $1 AND lease_id = $2`, job.ID, job.LeaseID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *database) UpdateWeatherJob(job *storage.WeatherJob) error {
+	res, err := d.db.Exec(
+		`UPDATE weather_jobs SET status = $3, attempts = $4, run_at = $5, last_error = $6
+		WHERE id = $1 AND lease_id = $2`,
+		job.ID, job.LeaseID, job.Status, job.Attempts, job.RunAt, job.LastError,
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *database) GetWeatherJob(id uuid.UUID) (*storage.WeatherJob, error) {
+	row := d.db.QueryRow(`SELECT `+weatherJobColumnsList+` FROM weather_jobs WHERE id = $1`, id)
+	job, err := scanWeatherJob(row)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return job, nil
+}
+
+func scanWeatherJob(row scanner) (*storage.WeatherJob, error) {
+	var job storage.WeatherJob
+	if err := row.Scan(
+		&job.ID, &job.Status, &job.Attempts, &job.RunAt, &job.LeaseID, &job.LastError, &job.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) RecordLoginFailure(key string, at, expiresAt time.Time) (*storage.LoginAttempts, error) {
+	// there is no TTL in postgres, so expired attempts are removed on write
+	if _, err := d.db.Exec(`DELETE FROM login_attempts WHERE expires_at <= $1`, at); err != nil {
+		return nil, err
+	}
+	var attempts storage.LoginAttempts
+	err := d.db.QueryRow(
+		`INSERT INTO login_attempts (key, failures, last_failure_at, expires_at) VALUES ($1, 1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.expires_at <= $2 THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failure_at = $2,
+			expires_at = $3
+		RETURNING key, failures, last_failure_at, expires_at`,
+		key, at, expiresAt,
+	).Scan(&attempts.Key, &attempts.Failures, &attempts.LastFailureAt, &attempts.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attempts, nil
+}
+
+func (d *database) GetLoginAttempts(key string) (*storage.LoginAttempts, error) {
+	var attempts storage.LoginAttempts
+	err := d.db.QueryRow(
+		`SELECT key, failures, last_failure_at, expires_at FROM login_attempts WHERE key = $1 AND expires_at > now()`,
+		key,
+	).Scan(&attempts.Key, &attempts.Failures, &attempts.LastFailureAt, &attempts.ExpiresAt)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &attempts, nil
+}
+
+func (d *database) DeleteLoginAttempts(key string) error {
+	if _, err := d.db.Exec(`DELETE FROM login_attempts WHERE key = $1`, key); err != nil {
+		return err
+	}
+
+	return nil
+}
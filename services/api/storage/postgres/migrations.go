@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"database/sql"
+)
+
+// migrationsLock is a key for advisory lock, so only one replica migrates schema at a time.
+const migrationsLock = 7241536
+
+// migrations are applied in order and only once. Never edit applied migration,
+// append a new one instead.
+var migrations = []string{
+	`CREATE TABLE users (
+		id UUID PRIMARY KEY,
+		email TEXT NOT NULL UNIQUE,
+		password TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL,
+		roles TEXT[] NOT NULL DEFAULT '{}',
+		cursor BYTEA NOT NULL UNIQUE
+	)`,
+	`CREATE TABLE acl (
+		user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		action TEXT NOT NULL,
+		scope TEXT NOT NULL,
+		item TEXT NOT NULL,
+		PRIMARY KEY (user_id, position)
+	)`,
+	`CREATE TABLE trackings (
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL,
+		cursor BYTEA NOT NULL UNIQUE,
+		date TIMESTAMPTZ NOT NULL,
+		duration BIGINT NOT NULL,
+		distance REAL NOT NULL,
+		longitude DOUBLE PRECISION NOT NULL,
+		latitude DOUBLE PRECISION NOT NULL,
+		weather_temperature REAL NOT NULL DEFAULT 0,
+		weather_temperature_min REAL NOT NULL DEFAULT 0,
+		weather_temperature_max REAL NOT NULL DEFAULT 0,
+		weather_snowdepth REAL NOT NULL DEFAULT 0,
+		weather_winddirection REAL NOT NULL DEFAULT 0,
+		weather_windspeed REAL NOT NULL DEFAULT 0,
+		weather_pressure REAL NOT NULL DEFAULT 0
+	)`,
+	// for pagination
+	`CREATE INDEX trackings_user_id_cursor_idx ON trackings (user_id, cursor)`,
+	// for reports
+	`CREATE INDEX trackings_user_id_date_idx ON trackings (user_id, date)`,
+	`CREATE TABLE tokens (
+		refresh_token TEXT PRIMARY KEY,
+		user_id UUID NOT NULL,
+		access_token TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+	// encoded polyline, see storage.Route
+	`ALTER TABLE trackings ADD COLUMN route TEXT NOT NULL DEFAULT ''`,
+	// every existing token becomes a family of its own
+	`ALTER TABLE tokens ADD COLUMN family UUID`,
+	`UPDATE tokens SET family = md5(refresh_token)::UUID`,
+	`ALTER TABLE tokens ALTER COLUMN family SET NOT NULL`,
+	`CREATE INDEX tokens_family_idx ON tokens (family)`,
+	`CREATE INDEX tokens_user_id_idx ON tokens (user_id)`,
+	`CREATE TABLE revocations (
+		token_id TEXT NOT NULL DEFAULT '',
+		user_id UUID NOT NULL,
+		issued_before TIMESTAMPTZ NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX revocations_token_id_idx ON revocations (token_id)`,
+	`CREATE INDEX revocations_user_id_idx ON revocations (user_id)`,
+	// ownership policies grant access to the user itself and its trackings,
+	// entries of removed trackings are useless
+	`DELETE FROM acl WHERE scope = 'user' AND item = user_id::TEXT`,
+	`DELETE FROM acl USING trackings
+		WHERE acl.scope = 'tracking' AND acl.item = trackings.id::TEXT AND acl.user_id = trackings.user_id`,
+	`DELETE FROM acl WHERE scope = 'tracking' AND item <> '*'
+		AND NOT EXISTS (SELECT 1 FROM trackings WHERE trackings.id::TEXT = acl.item)`,
+	// built-in roles are seeded by storage.SeedRoles
+	`CREATE TABLE roles (
+		id UUID PRIMARY KEY,
+		name TEXT NOT NULL UNIQUE,
+		permissions JSONB NOT NULL DEFAULT '[]',
+		built_in BOOLEAN NOT NULL DEFAULT FALSE
+	)`,
+	// users reference roles by id instead of name
+	`UPDATE users SET roles = array_replace(array_replace(array_replace(roles,
+		'UserRole', '0cabfb1e-d4ac-40f5-a588-2bd4462932a3'),
+		'ManagerRole', 'a97b62ed-61d1-46a1-81e4-d63dfe4d554b'),
+		'AdminRole', '1085f9e5-fa62-45e6-bf63-7be4e9197b76')`,
+	`CREATE TABLE teams (
+		id UUID PRIMARY KEY,
+		name TEXT NOT NULL,
+		owner_id UUID NOT NULL,
+		coaches UUID[] NOT NULL DEFAULT '{}',
+		members UUID[] NOT NULL DEFAULT '{}',
+		invitations JSONB NOT NULL DEFAULT '[]',
+		created_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX teams_owner_id_idx ON teams (owner_id)`,
+	`CREATE INDEX teams_coaches_idx ON teams USING GIN (coaches)`,
+	`CREATE INDEX teams_members_idx ON teams USING GIN (members)`,
+	`CREATE TABLE audit_events (
+		id UUID PRIMARY KEY,
+		actor_id UUID NOT NULL,
+		action TEXT NOT NULL,
+		target_scope TEXT NOT NULL,
+		target_item TEXT NOT NULL,
+		before TEXT NOT NULL DEFAULT '',
+		after TEXT NOT NULL DEFAULT '',
+		request_id TEXT NOT NULL DEFAULT '',
+		client_ip TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL,
+		cursor BYTEA NOT NULL UNIQUE
+	)`,
+	`CREATE INDEX audit_events_actor_id_cursor_idx ON audit_events (actor_id, cursor)`,
+	`CREATE INDEX audit_events_target_item_cursor_idx ON audit_events (target_item, cursor)`,
+	// users created before email verification aren't restricted
+	`ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT FALSE`,
+	`UPDATE users SET email_verified = TRUE`,
+	`CREATE TABLE one_time_tokens (
+		hash TEXT PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		purpose TEXT NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX one_time_tokens_user_id_purpose_idx ON one_time_tokens (user_id, purpose)`,
+	`CREATE TABLE login_attempts (
+		key TEXT PRIMARY KEY,
+		failures INTEGER NOT NULL,
+		last_failure_at TIMESTAMPTZ NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX login_attempts_expires_at_idx ON login_attempts (expires_at)`,
+	// job id is the tracking id
+	`CREATE TABLE weather_jobs (
+		id UUID PRIMARY KEY REFERENCES trackings (id) ON DELETE CASCADE,
+		status TEXT NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		run_at TIMESTAMPTZ NOT NULL,
+		lease_id UUID NOT NULL,
+		last_error TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX weather_jobs_status_run_at_idx ON weather_jobs (status, run_at)`,
+	`CREATE TABLE weather_cache (
+		key TEXT PRIMARY KEY,
+		station_id TEXT NOT NULL DEFAULT '',
+		weather JSONB,
+		expires_at TIMESTAMPTZ NOT NULL
+	)`,
+	`CREATE INDEX weather_cache_expires_at_idx ON weather_cache (expires_at)`,
+	`ALTER TABLE audit_events ADD COLUMN peer_ip TEXT NOT NULL DEFAULT ''`,
+}
+
+func migrate(db *sql.DB) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock($1)`, migrationsLock); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return err
+	}
+
+	var version int
+	if err := tx.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version); err != nil {
+		return err
+	}
+	for i := version; i < len(migrations); i++ {
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES ($1)`, i+1); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
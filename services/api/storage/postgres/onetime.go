@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveOneTimeToken(token *storage.OneTimeToken) error {
+	// there is no TTL in postgres, so expired tokens are removed on write
+	if _, err := d.db.Exec(`DELETE FROM one_time_tokens WHERE expires_at <= now()`); err != nil {
+		return err
+	}
+	_, err := d.db.Exec(
+		`INSERT INTO one_time_tokens (hash, user_id, purpose, expires_at) VALUES ($1, $2, $3, $4)`,
+		token.Hash, token.UserID, token.Purpose, token.ExpiresAt,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+
+	return nil
+}
+
+func (d *database) ConsumeOneTimeToken(hash string) (*storage.OneTimeToken, error) {
+	var token storage.OneTimeToken
+	err := d.db.QueryRow(
+		`DELETE FROM one_time_tokens WHERE hash = $1 RETURNING hash, user_id, purpose, expires_at`,
+		hash,
+	).Scan(&token.Hash, &token.UserID, &token.Purpose, &token.ExpiresAt)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &token, nil
+}
+
+func (d *database) DeleteOneTimeTokens(userID uuid.UUID, purpose storage.TokenPurpose) error {
+	if _, err := d.db.Exec(
+		`DELETE FROM one_time_tokens WHERE user_id = $1 AND purpose = $2`, userID, purpose,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// uniqueViolation is postgres error code for duplicate key value.
+const uniqueViolation = "23505"
+
+type database struct {
+	db *sql.DB
+}
+
+func New(dsn string) (storage.Storage, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+	if err := migrate(db); err != nil {
+		return nil, err
+	}
+
+	return &database{
+		db: db,
+	}, nil
+}
+
+func convertError(err error) error {
+	if err == sql.ErrNoRows {
+		return storage.ErrNotFound
+	}
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == uniqueViolation {
+		return storage.ErrAlreadyExists
+	}
+
+	return err
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveRevocation(revocation *storage.Revocation) error {
+	// there is no TTL in postgres, so expired revocations are removed on write
+	if _, err := d.db.Exec(`DELETE FROM revocations WHERE expires_at <= now()`); err != nil {
+		return err
+	}
+	_, err := d.db.Exec(
+		`INSERT INTO revocations (token_id, user_id, issued_before, expires_at) VALUES ($1, $2, $3, $4)`,
+		revocation.TokenID, revocation.UserID, revocation.IssuedBefore, revocation.ExpiresAt,
+	)
+
+	return err
+}
+
+func (d *database) IsTokenRevoked(userID uuid.UUID, tokenID string, issuedAt time.Time) (bool, error) {
+	var revoked bool
+	err := d.db.QueryRow(
+		`SELECT EXISTS (SELECT 1 FROM revocations WHERE expires_at > now() AND (
+			(token_id <> '' AND token_id = $1) OR
+			(token_id = '' AND user_id = $2 AND issued_before > $3)
+		))`,
+		tokenID, userID, issuedAt,
+	).Scan(&revoked)
+
+	return revoked, err
+}
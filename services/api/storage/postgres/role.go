@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const roleColumnsList = `id, name, permissions, built_in`
+
+func (d *database) SaveRole(role *storage.Role) error {
+	permissions, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.Exec(
+		`INSERT INTO roles (`+roleColumnsList+`) VALUES ($1, $2, $3, $4)`,
+		role.ID, role.Name, permissions, role.BuiltIn,
+	)
+
+	return convertError(err)
+}
+
+func (d *database) UpdateRole(role *storage.Role) error {
+	permissions, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return err
+	}
+	res, err := d.db.Exec(
+		`UPDATE roles SET name = $2, permissions = $3, built_in = $4 WHERE id = $1`,
+		role.ID, role.Name, permissions, role.BuiltIn,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *database) DeleteRole(id uuid.UUID) error {
+	_, err := d.db.Exec(`DELETE FROM roles WHERE id = $1`, id)
+
+	return err
+}
+
+func (d *database) GetRole(id uuid.UUID) (*storage.Role, error) {
+	role, err := scanRole(d.db.QueryRow(`SELECT `+roleColumnsList+` FROM roles WHERE id = $1`, id))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return role, nil
+}
+
+func (d *database) ListRoles() ([]*storage.Role, error) {
+	rows, err := d.db.Query(`SELECT ` + roleColumnsList + ` FROM roles ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]*storage.Role, 0)
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+func scanRole(row scanner) (*storage.Role, error) {
+	var role storage.Role
+	var permissions []byte
+	if err := row.Scan(&role.ID, &role.Name, &permissions, &role.BuiltIn); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(permissions, &role.Permissions); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
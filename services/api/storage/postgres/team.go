@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const teamColumnsList = `id, name, owner_id, coaches, members, invitations, created_at`
+
+func (d *database) SaveTeam(team *storage.Team) error {
+	invitations, err := json.Marshal(team.Invitations)
+	if err != nil {
+		return err
+	}
+	_, err = d.db.Exec(
+		`INSERT INTO teams (`+teamColumnsList+`) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		team.ID, team.Name, team.OwnerID, pq.Array(team.Coaches), pq.Array(team.Members), invitations, team.CreatedAt,
+	)
+
+	return convertError(err)
+}
+
+func (d *database) UpdateTeam(team *storage.Team) error {
+	invitations, err := json.Marshal(team.Invitations)
+	if err != nil {
+		return err
+	}
+	res, err := d.db.Exec(
+		`UPDATE teams SET name = $2, owner_id = $3, coaches = $4, members = $5, invitations = $6, created_at = $7
+		WHERE id = $1`,
+		team.ID, team.Name, team.OwnerID, pq.Array(team.Coaches), pq.Array(team.Members), invitations, team.CreatedAt,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *database) DeleteTeam(id uuid.UUID) error {
+	_, err := d.db.Exec(`DELETE FROM teams WHERE id = $1`, id)
+
+	return err
+}
+
+func (d *database) GetTeam(id uuid.UUID) (*storage.Team, error) {
+	team, err := scanTeam(d.db.QueryRow(`SELECT `+teamColumnsList+` FROM teams WHERE id = $1`, id))
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return team, nil
+}
+
+func (d *database) ListTeamsForUser(userID uuid.UUID) ([]*storage.Team, error) {
+	rows, err := d.db.Query(
+		`SELECT `+teamColumnsList+` FROM teams
+		WHERE owner_id = $1 OR coaches @> ARRAY[$1::UUID] OR members @> ARRAY[$1::UUID]
+			OR invitations @> jsonb_build_array(jsonb_build_object('user_id', $1::TEXT))`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]*storage.Team, 0)
+	for rows.Next() {
+		team, err := scanTeam(rows)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
+
+func scanTeam(row scanner) (*storage.Team, error) {
+	var team storage.Team
+	var invitations []byte
+	err := row.Scan(
+		&team.ID, &team.Name, &team.OwnerID, pq.Array(&team.Coaches), pq.Array(&team.Members), &invitations,
+		&team.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(invitations, &team.Invitations); err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) SaveToken(token *storage.Token) error {
+	return saveToken(d.db, token)
+}
+
+func (d *database) DeleteToken(token *storage.Token) error {
+	if _, err := d.db.Exec(`DELETE FROM tokens WHERE refresh_token = $1`, token.Refresh); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) GetToken(refreshToken string) (*storage.Token, error) {
+	var token storage.Token
+	err := d.db.QueryRow(
+		`SELECT refresh_token, user_id, family, access_token, expires_at FROM tokens WHERE refresh_token = $1`,
+		refreshToken,
+	).Scan(&token.Refresh, &token.UserID, &token.Family, &token.Access, &token.ExpiresAt)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &token, nil
+}
+
+func (d *database) RotateToken(old, new *storage.Token) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`DELETE FROM tokens WHERE refresh_token = $1`, old.Refresh)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	if err := saveToken(tx, new); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *database) DeleteTokensByFamily(family uuid.UUID) error {
+	if _, err := d.db.Exec(`DELETE FROM tokens WHERE family = $1`, family); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) DeleteTokensByUser(userID uuid.UUID) error {
+	if _, err := d.db.Exec(`DELETE FROM tokens WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) ListTokensByUser(userID uuid.UUID) ([]*storage.Token, error) {
+	rows, err := d.db.Query(
+		`SELECT refresh_token, user_id, family, access_token, expires_at FROM tokens WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*storage.Token, 0)
+	for rows.Next() {
+		var token storage.Token
+		if err := rows.Scan(&token.Refresh, &token.UserID, &token.Family, &token.Access, &token.ExpiresAt); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// executor is implemented by both sql.DB and sql.Tx.
+type executor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+}
+
+func saveToken(db executor, token *storage.Token) error {
+	_, err := db.Exec(
+		`INSERT INTO tokens (refresh_token, user_id, family, access_token, expires_at) VALUES ($1, $2, $3, $4, $5)`,
+		token.Refresh, token.UserID, token.Family, token.Access, token.ExpiresAt,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+
+	return nil
+}
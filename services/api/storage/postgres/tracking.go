@@ -0,0 +1,357 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/mongo/filterparser"
+)
+
+const (
+	defaultTrackingPerRequest = 10
+	defaultDuration           = 7 * 24 * time.Hour
+
+	trackingColumnsList = `id, user_id, cursor, date, duration, distance, longitude, latitude,
+		weather_temperature, weather_temperature_min, weather_temperature_max, weather_snowdepth,
+		weather_winddirection, weather_windspeed, weather_pressure, route`
+)
+
+func (d *database) SaveTracking(tracking *storage.Tracking) error {
+	return saveTracking(d.db, tracking)
+}
+
+func (d *database) UpdateTracking(tracking *storage.Tracking) error {
+	return updateTracking(d.db, tracking)
+}
+
+func (d *database) SaveTrackingWithWeatherJob(tracking *storage.Tracking, job *storage.WeatherJob) error {
+	return d.SaveTrackingsWithWeatherJobs([]*storage.Tracking{tracking}, []*storage.WeatherJob{job})
+}
+
+func (d *database) SaveTrackingsWithWeatherJobs(trackings []*storage.Tracking, jobs []*storage.WeatherJob) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i, tracking := range trackings {
+		if err := saveTracking(tx, tracking); err != nil {
+			return err
+		}
+		if err := saveWeatherJob(tx, jobs[i]); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *database) UpdateTrackingWithWeatherJob(tracking *storage.Tracking, job *storage.WeatherJob) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateTracking(tx, tracking); err != nil {
+		return err
+	}
+	if err := saveWeatherJob(tx, job); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func saveTracking(db executor, tracking *storage.Tracking) error {
+	_, err := db.Exec(
+		`INSERT INTO trackings (`+trackingColumnsList+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		trackingValues(tracking)...,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+
+	return nil
+}
+
+func updateTracking(db executor, tracking *storage.Tracking) error {
+	res, err := db.Exec(
+		`UPDATE trackings SET user_id = $2, cursor = $3, date = $4, duration = $5, distance = $6,
+			longitude = $7, latitude = $8, weather_temperature = $9, weather_temperature_min = $10,
+			weather_temperature_max = $11, weather_snowdepth = $12, weather_winddirection = $13,
+			weather_windspeed = $14, weather_pressure = $15, route = $16
+		WHERE id = $1`,
+		trackingValues(tracking)...,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *database) SetTrackingWeather(id uuid.UUID, weather *storage.Weather) error {
+	res, err := d.db.Exec(
+		`UPDATE trackings SET weather_temperature = $2, weather_temperature_min = $3,
+			weather_temperature_max = $4, weather_snowdepth = $5, weather_winddirection = $6,
+			weather_windspeed = $7, weather_pressure = $8
+		WHERE id = $1`,
+		id, weather.Temperature, weather.TemperatureMin, weather.TemperatureMax, weather.Snowdepth,
+		weather.Winddirection, weather.Windspeed, weather.Pressure,
+	)
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (d *database) DeleteTracking(id uuid.UUID) error {
+	if _, err := d.db.Exec(`DELETE FROM trackings WHERE id = $1`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) GetTracking(id uuid.UUID) (*storage.Tracking, error) {
+	row := d.db.QueryRow(`SELECT `+trackingColumnsList+` FROM trackings WHERE id = $1`, id)
+	tracking, err := scanTracking(row)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return tracking, nil
+}
+
+func (d *database) ListTrackings(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	return d.listTrackings(filter, newCondition(trackingColumns))
+}
+
+func (d *database) ListTrackingsForUser(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	cond := newCondition(trackingColumns)
+	cond.add(`user_id = ?`, filter.UserID)
+
+	return d.listTrackings(filter, cond)
+}
+
+func (d *database) ListTrackingsForUsers(filter *storage.TrackingFilter) (*storage.ListTrackingsResponse, error) {
+	cond := newCondition(trackingColumns)
+	cond.add(`user_id = ANY(?)`, pq.Array(filter.UserIDs))
+
+	return d.listTrackings(filter, cond)
+}
+
+func (d *database) listTrackings(filter *storage.TrackingFilter, cond *condition) (*storage.ListTrackingsResponse, error) {
+	if filter.MissingWeather {
+		cond.add(`weather_temperature = 0 AND weather_temperature_min = 0 AND weather_temperature_max = 0 AND
+			weather_snowdepth = 0 AND weather_winddirection = 0 AND weather_windspeed = 0 AND weather_pressure = 0`)
+	}
+	if filter.Query != "" {
+		query, err := filterparser.ParseTracking(filter.Query)
+		if err != nil {
+			return nil, err
+		}
+		if err := cond.addQuery(query); err != nil {
+			return nil, err
+		}
+	}
+
+	var total int64
+	if err := d.db.QueryRow(`SELECT COUNT(*) FROM trackings WHERE `+cond.String(), cond.args...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	if filter.Cursor != "" {
+		if !bson.IsObjectIdHex(filter.Cursor) {
+			return nil, storage.ErrInvalidCursor
+		}
+		cond.add(`cursor > ?`, []byte(bson.ObjectIdHex(filter.Cursor)))
+	}
+	limit := defaultTrackingPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+
+	rows, err := d.db.Query(
+		fmt.Sprintf(`SELECT %s FROM trackings WHERE %s ORDER BY cursor LIMIT %d`, trackingColumnsList, cond.String(), limit),
+		cond.args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	trackings := make([]*storage.Tracking, 0, limit)
+	for rows.Next() {
+		tracking, err := scanTracking(rows)
+		if err != nil {
+			return nil, err
+		}
+		trackings = append(trackings, tracking)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &storage.ListTrackingsResponse{
+		Total:     total,
+		Trackings: trackings,
+	}, nil
+}
+
+func (d *database) GetReport(filter *storage.ReportFilter) (*storage.Report, error) {
+	start := filter.FromDate
+	duration := defaultDuration
+	if filter.Duration != time.Duration(0) {
+		duration = filter.Duration
+	}
+	end := start.Add(duration)
+
+	var count int64
+	var resTimeInt64 int64
+	var resDistance float64
+	err := d.db.QueryRow(
+		`SELECT COUNT(*), COALESCE(SUM(duration), 0), COALESCE(SUM(distance::DOUBLE PRECISION), 0)
+		FROM trackings WHERE user_id = $1 AND date >= $2 AND date < $3`,
+		filter.UserID, start, end,
+	).Scan(&count, &resTimeInt64, &resDistance)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return &storage.Report{
+			AverageSpeed: 0,
+			Distance:     0,
+			Buckets:      []*storage.ReportBucket{},
+		}, nil
+	}
+	resTime := time.Duration(resTimeInt64)
+	averageSpeed := resDistance / resTime.Seconds()
+
+	buckets := make([]*storage.ReportBucket, 0)
+	if filter.Granularity != storage.NoGranularity {
+		buckets, err = d.getReportBuckets(filter.Granularity, filter.UserID, start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &storage.Report{
+		AverageSpeed: float32(averageSpeed),
+		Distance:     float32(resDistance),
+		Buckets:      buckets,
+	}, nil
+}
+
+func (d *database) getReportBuckets(
+	granularity storage.Granularity,
+	userID uuid.UUID,
+	start, end time.Time,
+) ([]*storage.ReportBucket, error) {
+	var bucketStart string
+	switch granularity {
+	case storage.DayGranularity:
+		bucketStart = `date_trunc('day', date AT TIME ZONE 'UTC')`
+	case storage.WeekGranularity:
+		bucketStart = `($2 AT TIME ZONE 'UTC') + floor(extract(epoch FROM date - $2) / 604800)::INTEGER * INTERVAL '7 days'`
+	case storage.ISOWeekGranularity:
+		// postgres weeks are ISO weeks starting on Monday
+		bucketStart = `date_trunc('week', date AT TIME ZONE 'UTC')`
+	case storage.MonthGranularity:
+		bucketStart = `date_trunc('month', date AT TIME ZONE 'UTC')`
+	default:
+		return nil, storage.ErrUnknownGranularity
+	}
+
+	rows, err := d.db.Query(
+		`SELECT `+bucketStart+` AS bucket, COUNT(*), SUM(duration), SUM(distance::DOUBLE PRECISION), MAX(distance),
+			CASE WHEN SUM(duration) = 0 THEN 0
+				ELSE SUM(distance::DOUBLE PRECISION) / (SUM(duration) / 1e9) END
+		FROM trackings WHERE user_id = $1 AND date >= $2 AND date < $3
+		GROUP BY bucket ORDER BY bucket`,
+		userID, start, end,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]*storage.ReportBucket, 0)
+	for rows.Next() {
+		var bucket storage.ReportBucket
+		var bucketTime int64
+		if err := rows.Scan(
+			&bucket.StartDate, &bucket.Count, &bucketTime, &bucket.Distance, &bucket.LongestRun, &bucket.AverageSpeed,
+		); err != nil {
+			return nil, err
+		}
+		bucket.Time = time.Duration(bucketTime)
+		buckets = append(buckets, &bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+func trackingValues(tracking *storage.Tracking) []interface{} {
+	weather := tracking.Weather
+	if weather == nil {
+		weather = &storage.Weather{}
+	}
+
+	return []interface{}{
+		tracking.ID, tracking.UserID, []byte(tracking.Cursor), tracking.Date, int64(tracking.Time),
+		tracking.Distance, tracking.Location.Longitude, tracking.Location.Latitude,
+		weather.Temperature, weather.TemperatureMin, weather.TemperatureMax, weather.Snowdepth,
+		weather.Winddirection, weather.Windspeed, weather.Pressure, tracking.Route.Encode(),
+	}
+}
+
+func scanTracking(row scanner) (*storage.Tracking, error) {
+	var tracking storage.Tracking
+	var cursor []byte
+	var duration int64
+	var route string
+	weather := &storage.Weather{}
+	if err := row.Scan(
+		&tracking.ID, &tracking.UserID, &cursor, &tracking.Date, &duration,
+		&tracking.Distance, &tracking.Location.Longitude, &tracking.Location.Latitude,
+		&weather.Temperature, &weather.TemperatureMin, &weather.TemperatureMax, &weather.Snowdepth,
+		&weather.Winddirection, &weather.Windspeed, &weather.Pressure, &route,
+	); err != nil {
+		return nil, err
+	}
+	decoded, err := storage.DecodeRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	tracking.Route = decoded
+	tracking.Cursor = bson.ObjectId(cursor)
+	tracking.Time = time.Duration(duration)
+	tracking.Weather = weather
+
+	return &tracking, nil
+}
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/mongo/filterparser"
+)
+
+const (
+	defaultUsersPerRequest = 10
+
+	userColumnsList = `id, email, password, created_at, roles, cursor, email_verified`
+)
+
+func (d *database) SaveUser(user *storage.User) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(
+		`INSERT INTO users (`+userColumnsList+`) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		user.ID, user.Email, user.Password, user.CreatedAt, pq.Array(user.Roles), []byte(user.Cursor),
+		user.EmailVerified,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+	if err := saveACL(tx, user); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *database) UpdateUser(user *storage.User) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		`UPDATE users SET email = $2, password = $3, created_at = $4, roles = $5, cursor = $6, email_verified = $7
+			WHERE id = $1`,
+		user.ID, user.Email, user.Password, user.CreatedAt, pq.Array(user.Roles), []byte(user.Cursor),
+		user.EmailVerified,
+	)
+	if err != nil {
+		return convertError(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNotFound
+	}
+	if _, err := tx.Exec(`DELETE FROM acl WHERE user_id = $1`, user.ID); err != nil {
+		return err
+	}
+	if err := saveACL(tx, user); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (d *database) DeleteUser(id uuid.UUID) error {
+	if _, err := d.db.Exec(`DELETE FROM users WHERE id = $1`, id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *database) GetUser(id uuid.UUID) (*storage.User, error) {
+	row := d.db.QueryRow(`SELECT `+userColumnsList+` FROM users WHERE id = $1`, id)
+	user, err := scanUser(row)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if err := d.loadACL(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (d *database) GetUserByEmail(email string) (*storage.User, error) {
+	row := d.db.QueryRow(`SELECT `+userColumnsList+` FROM users WHERE email = $1`, email)
+	user, err := scanUser(row)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if err := d.loadACL(user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (d *database) ListUsers(filter *storage.UserFilter) (*storage.ListUsersResponse, error) {
+	cond := newCondition(userColumns)
+	if filter.Query != "" {
+		query, err := filterparser.ParseUsers(filter.Query)
+		if err != nil {
+			return nil, err
+		}
+		if err := cond.addQuery(query); err != nil {
+			return nil, err
+		}
+	}
+
+	var total int64
+	if err := d.db.QueryRow(`SELECT COUNT(*) FROM users WHERE `+cond.String(), cond.args...).Scan(&total); err != nil {
+		return nil, err
+	}
+
+	if filter.Cursor != "" {
+		if !bson.IsObjectIdHex(filter.Cursor) {
+			return nil, storage.ErrInvalidCursor
+		}
+		cond.add(`cursor > ?`, []byte(bson.ObjectIdHex(filter.Cursor)))
+	}
+	limit := defaultUsersPerRequest
+	if filter.PerRequest > 0 {
+		limit = int(filter.PerRequest)
+	}
+
+	rows, err := d.db.Query(
+		fmt.Sprintf(`SELECT %s FROM users WHERE %s ORDER BY cursor LIMIT %d`, userColumnsList, cond.String(), limit),
+		cond.args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*storage.User, 0, limit)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if err := d.loadACL(user); err != nil {
+			return nil, err
+		}
+	}
+
+	return &storage.ListUsersResponse{
+		Total: total,
+		Users: users,
+	}, nil
+}
+
+func (d *database) loadACL(user *storage.User) error {
+	rows, err := d.db.Query(`SELECT action, scope, item FROM acl WHERE user_id = $1 ORDER BY position`, user.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	user.ACL = []storage.Permission{}
+	for rows.Next() {
+		var permission storage.Permission
+		if err := rows.Scan(&permission.Action, &permission.Resource.Scope, &permission.Resource.Item); err != nil {
+			return err
+		}
+		user.ACL = append(user.ACL, permission)
+	}
+
+	return rows.Err()
+}
+
+func saveACL(tx *sql.Tx, user *storage.User) error {
+	for i, permission := range user.ACL {
+		_, err := tx.Exec(
+			`INSERT INTO acl (user_id, position, action, scope, item) VALUES ($1, $2, $3, $4, $5)`,
+			user.ID, i, permission.Action, permission.Resource.Scope, permission.Resource.Item,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanUser(row scanner) (*storage.User, error) {
+	var user storage.User
+	var cursor []byte
+	if err := row.Scan(
+		&user.ID, &user.Email, &user.Password, &user.CreatedAt, pq.Array(&user.Roles), &cursor,
+		&user.EmailVerified,
+	); err != nil {
+		return nil, err
+	}
+	user.Cursor = bson.ObjectId(cursor)
+
+	return &user, nil
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func (d *database) GetWeatherCacheEntry(key string) (*storage.WeatherCacheEntry, error) {
+	var entry storage.WeatherCacheEntry
+	var weather []byte
+	err := d.db.QueryRow(
+		`SELECT key, station_id, weather, expires_at FROM weather_cache WHERE key = $1 AND expires_at > now()`,
+		key,
+	).Scan(&entry.Key, &entry.StationID, &weather, &entry.ExpiresAt)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if weather != nil {
+		if err := json.Unmarshal(weather, &entry.Weather); err != nil {
+			return nil, err
+		}
+	}
+
+	return &entry, nil
+}
+
+func (d *database) SaveWeatherCacheEntry(entry *storage.WeatherCacheEntry) error {
+	// there is no TTL in postgres, so expired entries are removed on write
+	if _, err := d.db.Exec(`DELETE FROM weather_cache WHERE expires_at <= $1`, time.Now()); err != nil {
+		return err
+	}
+	var weather []byte
+	if entry.Weather != nil {
+		var err error
+		if weather, err = json.Marshal(entry.Weather); err != nil {
+			return err
+		}
+	}
+	_, err := d.db.Exec(
+		`INSERT INTO weather_cache (key, station_id, weather, expires_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (key) DO UPDATE SET station_id = $2, weather = $3, expires_at = $4`,
+		entry.Key, entry.StationID, weather, entry.ExpiresAt,
+	)
+
+	return err
+}
@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/lib"
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+)
+
+const oneDay = 24 * time.Hour
+
+// PersonalRecords collects records over all trackings of a user. Trackings are
+// added one by one and only totals of days, weeks and months are kept, so
+// memory depends on the number of days with runs, not on the number of
+// trackings. Trackings of the biggest periods are listed afterwards by
+// TrackingsQuery.
+type PersonalRecords struct {
+	FastestPace     *TrackingRecord
+	LongestDistance *TrackingRecord
+	LongestDuration *TrackingRecord
+	BiggestWeek     *PeriodRecord
+	BiggestMonth    *PeriodRecord
+	CurrentStreak   *Streak
+	LongestStreak   *Streak
+
+	weeks  map[time.Time]*PeriodRecord
+	months map[time.Time]*PeriodRecord
+	days   map[time.Time]struct{}
+}
+
+type TrackingRecord struct {
+	TrackingID uuid.UUID
+	Date       time.Time
+	Distance   float32
+	Time       time.Duration
+}
+
+type PeriodRecord struct {
+	StartDate time.Time
+	// EndDate is the start of the next period
+	EndDate     time.Time
+	Count       int64
+	Distance    float32
+	TrackingIDs []uuid.UUID
+}
+
+type Streak struct {
+	Days      int64
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+func NewPersonalRecords() *PersonalRecords {
+	return &PersonalRecords{
+		weeks:  make(map[time.Time]*PeriodRecord),
+		months: make(map[time.Time]*PeriodRecord),
+		days:   make(map[time.Time]struct{}),
+	}
+}
+
+func (r *PersonalRecords) Add(tracking *Tracking) {
+	record := &TrackingRecord{
+		TrackingID: tracking.ID,
+		Date:       tracking.Date,
+		Distance:   tracking.Distance,
+		Time:       tracking.Time,
+	}
+	if record.Distance > 0 && record.Time > 0 &&
+		(r.FastestPace == nil || record.Pace() < r.FastestPace.Pace()) {
+		r.FastestPace = record
+	}
+	if r.LongestDistance == nil || record.Distance > r.LongestDistance.Distance {
+		r.LongestDistance = record
+	}
+	if r.LongestDuration == nil || record.Time > r.LongestDuration.Time {
+		r.LongestDuration = record
+	}
+
+	date := tracking.Date.UTC()
+	trackingDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	r.days[trackingDay] = struct{}{}
+	// ISO week starts on Monday
+	week := trackingDay.AddDate(0, 0, -((int(trackingDay.Weekday()) + 6) % 7))
+	addToPeriod(r.weeks, week, week.AddDate(0, 0, 7), tracking)
+	month := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	addToPeriod(r.months, month, month.AddDate(0, 1, 0), tracking)
+}
+
+// Finish calculates records that depend on all trackings. Current streak is
+// kept if the last run was today or yesterday relatively to now.
+func (r *PersonalRecords) Finish(now time.Time) {
+	r.BiggestWeek = biggestPeriod(r.weeks)
+	r.BiggestMonth = biggestPeriod(r.months)
+
+	days := make([]time.Time, 0, len(r.days))
+	for d := range r.days {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Before(days[j])
+	})
+
+	var streak *Streak
+	r.LongestStreak = nil
+	r.CurrentStreak = nil
+	for _, d := range days {
+		if streak != nil && d.Sub(streak.EndDate) == oneDay {
+			streak.Days++
+			streak.EndDate = d
+		} else {
+			streak = &Streak{Days: 1, StartDate: d, EndDate: d}
+		}
+		if r.LongestStreak == nil || streak.Days > r.LongestStreak.Days {
+			longest := *streak
+			r.LongestStreak = &longest
+		}
+	}
+
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if streak != nil && !streak.EndDate.Before(today.Add(-oneDay)) {
+		r.CurrentStreak = streak
+	}
+}
+
+func (r *PersonalRecords) ToProto() *pb.GetPersonalRecordsResponse {
+	return &pb.GetPersonalRecordsResponse{
+		FastestPace:     r.FastestPace.ToProto(),
+		LongestDistance: r.LongestDistance.ToProto(),
+		LongestDuration: r.LongestDuration.ToProto(),
+		BiggestWeek:     r.BiggestWeek.ToProto(),
+		BiggestMonth:    r.BiggestMonth.ToProto(),
+		CurrentStreak:   r.CurrentStreak.ToProto(),
+		LongestStreak:   r.LongestStreak.ToProto(),
+	}
+}
+
+// Pace returns time per kilometer, distance is in meters.
+func (r *TrackingRecord) Pace() time.Duration {
+	if r.Distance <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(r.Time) * 1000 / float64(r.Distance))
+}
+
+func (r *TrackingRecord) ToProto() *pb.TrackingRecord {
+	if r == nil {
+		return nil
+	}
+
+	return &pb.TrackingRecord{
+		TrackingId: r.TrackingID.String(),
+		Date:       r.Date.Format(lib.DateFormat),
+		Distance:   r.Distance,
+		Time:       &duration.Duration{Seconds: int64(r.Time.Seconds())},
+		Pace:       &duration.Duration{Seconds: int64(r.Pace().Seconds())},
+	}
+}
+
+// TrackingsQuery returns the tracking filter query matching trackings of the
+// period. Dates are compared with the day precision, so the start day is
+// matched by both eq and gt.
+func (r *PeriodRecord) TrackingsQuery() string {
+	start := r.StartDate.Format(lib.DateFormat)
+
+	return fmt.Sprintf("(date eq %s or date gt %s) and date lt %s",
+		start, start, r.EndDate.Format(lib.DateFormat))
+}
+
+func (r *PeriodRecord) ToProto() *pb.PeriodRecord {
+	if r == nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(r.TrackingIDs))
+	for _, id := range r.TrackingIDs {
+		ids = append(ids, id.String())
+	}
+
+	return &pb.PeriodRecord{
+		StartDate:   r.StartDate.Format(lib.DateFormat),
+		Count:       r.Count,
+		Distance:    r.Distance,
+		TrackingIds: ids,
+	}
+}
+
+func (s *Streak) ToProto() *pb.Streak {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.Streak{
+		Days:      s.Days,
+		StartDate: s.StartDate.Format(lib.DateFormat),
+		EndDate:   s.EndDate.Format(lib.DateFormat),
+	}
+}
+
+func addToPeriod(periods map[time.Time]*PeriodRecord, start, end time.Time, tracking *Tracking) {
+	period, ok := periods[start]
+	if !ok {
+		period = &PeriodRecord{StartDate: start, EndDate: end}
+		periods[start] = period
+	}
+	period.Count++
+	period.Distance += tracking.Distance
+}
+
+// biggestPeriod returns period with the biggest distance, the earliest one on tie.
+func biggestPeriod(periods map[time.Time]*PeriodRecord) *PeriodRecord {
+	var biggest *PeriodRecord
+	for _, period := range periods {
+		if biggest == nil || period.Distance > biggest.Distance ||
+			(period.Distance == biggest.Distance && period.StartDate.Before(biggest.StartDate)) {
+			biggest = period
+		}
+	}
+
+	return biggest
+}
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newRecordsTracking(date time.Time, distance float32, duration time.Duration) *Tracking {
+	return &Tracking{
+		ID:       uuid.New(),
+		Date:     date,
+		Distance: distance,
+		Time:     duration,
+	}
+}
+
+func TestPersonalRecords(t *testing.T) {
+	r := require.New(t)
+
+	// Monday
+	date := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+	fastest := newRecordsTracking(date, 5000, 20*time.Minute)
+	longest := newRecordsTracking(date.AddDate(0, 0, 1), 21100, 2*time.Hour)
+	slowest := newRecordsTracking(date.AddDate(0, 0, 2), 10000, 3*time.Hour)
+	trackings := []*Tracking{
+		fastest, longest, slowest,
+		newRecordsTracking(date.AddDate(0, 0, 2), 0, 10*time.Minute),
+		newRecordsTracking(date.AddDate(0, 0, 7), 10000, time.Hour),
+		newRecordsTracking(date.AddDate(0, 0, 8), 10000, time.Hour),
+		newRecordsTracking(date.AddDate(0, 0, 30), 1000, 5*time.Minute),
+		newRecordsTracking(date.AddDate(0, 0, 31), 1000, 5*time.Minute),
+	}
+
+	records := NewPersonalRecords()
+	for _, tracking := range trackings {
+		records.Add(tracking)
+	}
+	records.Finish(date.AddDate(0, 0, 32).Add(10 * time.Hour))
+
+	r.Equal(fastest.ID, records.FastestPace.TrackingID)
+	r.Equal(4*time.Minute, records.FastestPace.Pace())
+	r.Equal(longest.ID, records.LongestDistance.TrackingID)
+	r.Equal(slowest.ID, records.LongestDuration.TrackingID)
+
+	r.Equal(date, records.BiggestWeek.StartDate)
+	r.Equal(int64(4), records.BiggestWeek.Count)
+	r.Equal(float32(36100), records.BiggestWeek.Distance)
+	r.Equal(time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), records.BiggestMonth.StartDate)
+	r.Equal(int64(6), records.BiggestMonth.Count)
+
+	r.Equal(int64(3), records.LongestStreak.Days)
+	r.Equal(date, records.LongestStreak.StartDate)
+	r.Equal(date.AddDate(0, 0, 2), records.LongestStreak.EndDate)
+	r.Equal(int64(2), records.CurrentStreak.Days)
+	r.Equal(date.AddDate(0, 0, 31), records.CurrentStreak.EndDate)
+
+	records.Finish(date.AddDate(0, 0, 33))
+	r.Nil(records.CurrentStreak, "streak is current after a day without runs")
+}
+
+func TestPersonalRecordsEmpty(t *testing.T) {
+	records := NewPersonalRecords()
+	records.Finish(time.Now())
+
+	response := records.ToProto()
+	require.Nil(t, response.FastestPace)
+	require.Nil(t, response.BiggestWeek)
+	require.Nil(t, response.CurrentStreak)
+}
@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revocation denies tokens before they expire. It revokes the token with
+// TokenID, or all tokens of the user issued before IssuedBefore when TokenID
+// is empty.
+type Revocation struct {
+	TokenID      string    `json:"token_id" bson:"token_id,omitempty"`
+	UserID       uuid.UUID `json:"user_id" bson:"user_id"`
+	IssuedBefore time.Time `json:"issued_before" bson:"issued_before"`
+	// ExpiresAt is the time all revoked tokens are expired, so revocation
+	// isn't needed anymore.
+	ExpiresAt time.Time `json:"expires_at" bson:"expires_at"`
+}
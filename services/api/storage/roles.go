@@ -3,11 +3,11 @@ package storage
 import (
 	"fmt"
 
+	"github.com/google/uuid"
+
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 )
 
-// TODO(boodyvo): Move roles and permissions to DB and make migration so could be dynamic
-
 type Action string
 
 const (
@@ -134,6 +134,29 @@ func (p Permission) Include(another Permission) bool {
 	return p.Resource.Item == another.Resource.Item
 }
 
+// PermissionGrant is the permission with its source. Role is nil if the
+// permission is granted by ACL.
+type PermissionGrant struct {
+	Permission Permission
+	Role       *Role
+}
+
+func (g PermissionGrant) ToProto() *pb.EffectivePermission {
+	if g.Role == nil {
+		return &pb.EffectivePermission{
+			Permission: g.Permission.ToProto(),
+			Source:     pb.PermissionSource_PERMISSION_SOURCE_ACL,
+		}
+	}
+
+	return &pb.EffectivePermission{
+		Permission: g.Permission.ToProto(),
+		Source:     pb.PermissionSource_PERMISSION_SOURCE_ROLE,
+		RoleId:     g.Role.ID.String(),
+		RoleName:   g.Role.Name,
+	}
+}
+
 func PermissionFromProto(action pb.Action, scope pb.Scope, item string) (Permission, error) {
 	var a Action
 	var s Scope
@@ -163,34 +186,137 @@ func PermissionFromProto(action pb.Action, scope pb.Scope, item string) (Permiss
 	return NewPermission(a, s, item), nil
 }
 
+func (p Permission) ToProto() *pb.Permission {
+	permission := &pb.Permission{Item: p.Resource.Item}
+	switch p.Action {
+	case ReadAction:
+		permission.Action = pb.Action_ACTION_READ
+	case UpdateAction:
+		permission.Action = pb.Action_ACTION_UPDATE
+	case DeleteAction:
+		permission.Action = pb.Action_ACTION_DELETE
+	}
+	switch p.Resource.Scope {
+	case UserScope:
+		permission.Scope = pb.Scope_SCOPE_USERS
+	case TrackingScope:
+		permission.Scope = pb.Scope_SCOPE_TRACKINGS
+	case PermissionsScope:
+		permission.Scope = pb.Scope_SCOPE_PERMISSIONS
+	}
+
+	return permission
+}
+
+// Policies
+
+// Policy grants permissions by relation of the user to the resource, so
+// nothing has to be stored per resource.
+type Policy interface {
+	// Allows checks the permission on the resource owned by owner.
+	Allows(user *User, permission Permission, owner uuid.UUID) bool
+}
+
+// OwnerPolicy grants actions on resources of the scope to their owner.
+type OwnerPolicy struct {
+	Scope   Scope
+	Actions []Action
+}
+
+var (
+	// OwnUserPolicy allows the user to manage itself, the user owns itself.
+	OwnUserPolicy = OwnerPolicy{
+		Scope:   UserScope,
+		Actions: []Action{ReadAction, UpdateAction, DeleteAction},
+	}
+	OwnTrackingsPolicy = OwnerPolicy{
+		Scope:   TrackingScope,
+		Actions: []Action{ReadAction, UpdateAction, DeleteAction},
+	}
+)
+
+// CoachPolicy grants coaches read access to trackings and reports of their
+// team members. Teams of the user must be loaded by LoadTeams before.
+type CoachPolicy struct{}
+
+func (p *CoachPolicy) Allows(user *User, permission Permission, owner uuid.UUID) bool {
+	if permission.Resource.Scope != TrackingScope || permission.Action != ReadAction || owner == uuid.Nil {
+		return false
+	}
+
+	return user.Coaches(owner)
+}
+
+func (p *OwnerPolicy) Allows(user *User, permission Permission, owner uuid.UUID) bool {
+	if permission.Resource.Scope != p.Scope || owner == uuid.Nil || owner != user.ID {
+		return false
+	}
+	for _, action := range p.Actions {
+		if action == permission.Action {
+			return true
+		}
+	}
+
+	return false
+}
+
+var Policies = []Policy{
+	&OwnUserPolicy,
+	&OwnTrackingsPolicy,
+	&CoachPolicy{},
+}
+
 // Roles
 
+// Role is a named set of permissions. Users reference roles by ID, so a role
+// change applies to all its holders.
 type Role struct {
+	ID          uuid.UUID    `json:"id" bson:"_id"`
 	Name        string       `json:"name" bson:"name"`
 	Permissions []Permission `json:"permissions" bson:"permissions"`
+	// BuiltIn roles are seeded at startup and couldn't be changed by users.
+	BuiltIn bool `json:"built_in" bson:"built_in"`
 }
 
 var (
 	UserRole = Role{
+		ID:          uuid.MustParse("0cabfb1e-d4ac-40f5-a588-2bd4462932a3"),
 		Name:        "UserRole",
 		Permissions: []Permission{},
+		BuiltIn:     true,
 	}
 	ManagerRole = Role{
+		ID:   uuid.MustParse("a97b62ed-61d1-46a1-81e4-d63dfe4d554b"),
 		Name: "ManagerRole",
 		Permissions: []Permission{
 			ReadUsersPermission, UpdateUsersPermission, DeleteUsersPermission,
 		},
+		BuiltIn: true,
 	}
 	AdminRole = Role{
+		ID:   uuid.MustParse("1085f9e5-fa62-45e6-bf63-7be4e9197b76"),
 		Name: "AdminRole",
 		Permissions: []Permission{
 			ReadUsersPermission, UpdateUsersPermission, DeleteUsersPermission,
 			ReadTrackingsPermission, UpdateTrackingsPermission, DeleteTrackingsPermission,
 			ReadPermissionsPermission, UpdatePermissionsPermission, DeletePermissionsPermission,
 		},
+		BuiltIn: true,
 	}
 )
 
+// BuiltInRoles are seeded by SeedRoles. Their IDs are fixed, so they could
+// be referenced from code and migrations.
+var BuiltInRoles = []*Role{&UserRole, &ManagerRole, &AdminRole}
+
+func NewRole(name string, permissions []Permission) *Role {
+	return &Role{
+		ID:          uuid.New(),
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
 func (r *Role) String() string {
 	return r.Name
 }
@@ -207,9 +333,37 @@ func (r *Role) HasPermission(permission Permission) bool {
 }
 
 func (r *Role) Equal(another Role) bool {
-	return r.Name == another.Name
+	return r.ID == another.ID
 }
 
+func (r *Role) ToProto() *pb.RoleDefinition {
+	permissions := make([]*pb.Permission, 0, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		permissions = append(permissions, permission.ToProto())
+	}
+
+	return &pb.RoleDefinition{
+		Id:          r.ID.String(),
+		Name:        r.Name,
+		Permissions: permissions,
+		BuiltIn:     r.BuiltIn,
+	}
+}
+
+func PermissionsFromProto(permissions []*pb.Permission) ([]Permission, error) {
+	res := make([]Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		p, err := PermissionFromProto(permission.Action, permission.Scope, permission.Item)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+// RoleFromProto returns built-in role for the enum.
 func RoleFromProto(role pb.Role) (Role, error) {
 	switch role {
 	case pb.Role_ROLE_ADMIN:
@@ -223,8 +377,37 @@ func RoleFromProto(role pb.Role) (Role, error) {
 	}
 }
 
-var Roles = map[string]*Role{
-	UserRole.Name:    &UserRole,
-	ManagerRole.Name: &ManagerRole,
-	AdminRole.Name:   &AdminRole,
+// SeedRoles saves built-in roles to the storage, so they are always in sync
+// with the code.
+func SeedRoles(store Storage) error {
+	for _, role := range BuiltInRoles {
+		err := store.UpdateRole(role)
+		if err == ErrNotFound {
+			err = store.SaveRole(role)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadRoles loads roles of the user from the storage, so its permissions
+// could be checked. Deleted roles are skipped.
+func LoadRoles(store Storage, user *User) error {
+	roles := make([]*Role, 0, len(user.Roles))
+	for _, id := range user.Roles {
+		role, err := store.GetRole(id)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		roles = append(roles, role)
+	}
+	user.roles = roles
+
+	return nil
 }
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasPermissionFor(t *testing.T) {
+	r := require.New(t)
+	user := NewUser("user@gmail.com", "password")
+	admin := NewAdmin("admin@gmail.com", "password")
+	admin.roles = []*Role{&AdminRole}
+	tracking := uuid.New()
+
+	cases := []struct {
+		name       string
+		user       *User
+		permission Permission
+		owner      uuid.UUID
+		allowed    bool
+	}{
+		{"owner reads tracking", user, NewPermission(ReadAction, TrackingScope, tracking.String()), user.ID, true},
+		{"owner deletes tracking", user, NewPermission(DeleteAction, TrackingScope, tracking.String()), user.ID, true},
+		{"owner lists all trackings", user, ReadTrackingsPermission, uuid.Nil, false},
+		{"user reads tracking of another user", user, NewPermission(ReadAction, TrackingScope, tracking.String()), admin.ID, false},
+		{"user reads unknown tracking", user, NewPermission(ReadAction, TrackingScope, tracking.String()), uuid.Nil, false},
+		{"user reads itself", user, NewPermission(ReadAction, UserScope, user.ID.String()), user.ID, true},
+		{"user updates permissions", user, UpdatePermissionsPermission, user.ID, false},
+		{"admin reads tracking of another user", admin, NewPermission(ReadAction, TrackingScope, tracking.String()), user.ID, true},
+	}
+	for _, c := range cases {
+		r.Equal(c.allowed, c.user.HasPermissionFor(c.permission, c.owner), c.name)
+	}
+	r.Empty(user.ACL, "new user has ACL entries")
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	r := require.New(t)
+	user := NewManager("manager@gmail.com", "password")
+	user.roles = []*Role{&ManagerRole}
+	user.AddPermission(ReadTrackingsPermission)
+
+	grants := user.EffectivePermissions()
+	r.Len(grants, len(ManagerRole.Permissions)+1)
+	for _, grant := range grants[:len(ManagerRole.Permissions)] {
+		r.Equal(&ManagerRole, grant.Role, "role permission has wrong source")
+	}
+	r.Nil(grants[len(grants)-1].Role, "ACL permission has role source")
+	r.Equal(ReadTrackingsPermission, grants[len(grants)-1].Permission)
+
+	r.True(user.HasACLPermission(ReadTrackingsPermission))
+	r.False(user.HasACLPermission(ReadUsersPermission), "role permission is in ACL")
+	user.RemovePermission(ReadTrackingsPermission)
+	r.False(user.HasACLPermission(ReadTrackingsPermission), "removed permission is in ACL")
+	r.True(user.HasPermission(ReadUsersPermission), "role permission is removed")
+}
@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"gopkg.in/mgo.v2/bson"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+)
+
+const (
+	// earthRadius is mean Earth radius in meters.
+	earthRadius = 6371008.8
+
+	// Route is stored with encoded polyline algorithm extended to four
+	// dimensions: latitude and longitude in 1e-5 degrees, elevation in
+	// decimeters and unix time in seconds.
+	routeDimensions    = 4
+	routeCoordinatePow = 1e5
+	routeElevationPow  = 10
+)
+
+type RoutePoint struct {
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Elevation float64   `json:"elevation"`
+	Time      time.Time `json:"time"`
+}
+
+// Route is an ordered list of GPS samples of a tracking. It's stored as single
+// encoded string, see Encode.
+type Route []RoutePoint
+
+func RouteFromProto(points []*pb.RoutePoint) Route {
+	if len(points) == 0 {
+		return nil
+	}
+
+	route := make(Route, 0, len(points))
+	for _, point := range points {
+		route = append(route, RoutePoint{
+			Latitude:  point.Latitude,
+			Longitude: point.Longitude,
+			Elevation: point.Elevation,
+			Time:      time.Unix(point.Time.GetSeconds(), int64(point.Time.GetNanos())).UTC(),
+		})
+	}
+
+	return route
+}
+
+func (r Route) ToProto() []*pb.RoutePoint {
+	points := make([]*pb.RoutePoint, 0, len(r))
+	for _, point := range r {
+		points = append(points, &pb.RoutePoint{
+			Latitude:  point.Latitude,
+			Longitude: point.Longitude,
+			Elevation: point.Elevation,
+			Time:      &timestamp.Timestamp{Seconds: point.Time.Unix()},
+		})
+	}
+
+	return points
+}
+
+func (r Route) Duration() time.Duration {
+	if len(r) < 2 {
+		return 0
+	}
+
+	return r[len(r)-1].Time.Sub(r[0].Time)
+}
+
+// Distance returns length of the route in meters.
+func (r Route) Distance() float64 {
+	distance := float64(0)
+	for i := 1; i < len(r); i++ {
+		distance += r[i-1].DistanceTo(r[i])
+	}
+
+	return distance
+}
+
+// DistanceTo returns great-circle distance between points in meters.
+func (p RoutePoint) DistanceTo(to RoutePoint) float64 {
+	lat1 := p.Latitude * math.Pi / 180
+	lat2 := to.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (to.Longitude - p.Longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Simplify returns route reduced with Douglas-Peucker algorithm, so no removed
+// point is further than tolerance meters from the simplified route.
+func (r Route) Simplify(tolerance float64) Route {
+	if tolerance <= 0 || len(r) < 3 {
+		return r
+	}
+
+	// Route is short enough to project it on the plane around its first point.
+	scale := math.Cos(r[0].Latitude*math.Pi/180) * earthRadius * math.Pi / 180
+	xs := make([]float64, len(r))
+	ys := make([]float64, len(r))
+	for i, point := range r {
+		xs[i] = (point.Longitude - r[0].Longitude) * scale
+		ys[i] = (point.Latitude - r[0].Latitude) * earthRadius * math.Pi / 180
+	}
+
+	keep := make([]bool, len(r))
+	keep[0], keep[len(r)-1] = true, true
+	stack := [][2]int{{0, len(r) - 1}}
+	for len(stack) > 0 {
+		first, last := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+
+		maxDistance, index := float64(0), 0
+		for i := first + 1; i < last; i++ {
+			distance := segmentDistance(xs[i], ys[i], xs[first], ys[first], xs[last], ys[last])
+			if distance > maxDistance {
+				maxDistance, index = distance, i
+			}
+		}
+		if maxDistance > tolerance {
+			keep[index] = true
+			stack = append(stack, [2]int{first, index}, [2]int{index, last})
+		}
+	}
+
+	simplified := make(Route, 0, len(r))
+	for i, point := range r {
+		if keep[i] {
+			simplified = append(simplified, point)
+		}
+	}
+
+	return simplified
+}
+
+// segmentDistance returns distance from point p to segment ab on the plane.
+func segmentDistance(px, py, ax, ay, bx, by float64) float64 {
+	dx, dy := bx-ax, by-ay
+	if dx == 0 && dy == 0 {
+		return math.Hypot(px-ax, py-ay)
+	}
+	t := math.Max(0, math.Min(1, ((px-ax)*dx+(py-ay)*dy)/(dx*dx+dy*dy)))
+
+	return math.Hypot(px-ax-t*dx, py-ay-t*dy)
+}
+
+// Encode returns route as polyline-encoded string of deltas between points.
+func (r Route) Encode() string {
+	var builder strings.Builder
+	previous := make([]int64, routeDimensions)
+	for _, point := range r {
+		values := []int64{
+			int64(math.Round(point.Latitude * routeCoordinatePow)),
+			int64(math.Round(point.Longitude * routeCoordinatePow)),
+			int64(math.Round(point.Elevation * routeElevationPow)),
+			point.Time.Unix(),
+		}
+		for i, value := range values {
+			encodeRouteValue(&builder, value-previous[i])
+			previous[i] = value
+		}
+	}
+
+	return builder.String()
+}
+
+func DecodeRoute(encoded string) (Route, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	var route Route
+	values := make([]int64, routeDimensions)
+	for offset := 0; offset < len(encoded); {
+		for i := range values {
+			delta, size, err := decodeRouteValue(encoded[offset:])
+			if err != nil {
+				return nil, err
+			}
+			offset += size
+			values[i] += delta
+		}
+		route = append(route, RoutePoint{
+			Latitude:  float64(values[0]) / routeCoordinatePow,
+			Longitude: float64(values[1]) / routeCoordinatePow,
+			Elevation: float64(values[2]) / routeElevationPow,
+			Time:      time.Unix(values[3], 0).UTC(),
+		})
+	}
+
+	return route, nil
+}
+
+func encodeRouteValue(builder *strings.Builder, value int64) {
+	shifted := uint64(value) << 1
+	if value < 0 {
+		shifted = ^shifted
+	}
+	for shifted >= 0x20 {
+		builder.WriteByte(byte(0x20|shifted&0x1f) + 63)
+		shifted >>= 5
+	}
+	builder.WriteByte(byte(shifted) + 63)
+}
+
+func decodeRouteValue(encoded string) (int64, int, error) {
+	var result uint64
+	for i := 0; i < len(encoded) && i < 13; i++ {
+		chunk := uint64(encoded[i]) - 63
+		if chunk > 0x3f {
+			return 0, 0, ErrInvalidRoute
+		}
+		result |= (chunk & 0x1f) << (5 * uint(i))
+		if chunk < 0x20 {
+			if result&1 != 0 {
+				return int64(^(result >> 1)), i + 1, nil
+			}
+			return int64(result >> 1), i + 1, nil
+		}
+	}
+
+	return 0, 0, ErrInvalidRoute
+}
+
+func (r Route) GetBSON() (interface{}, error) {
+	return r.Encode(), nil
+}
+
+func (r *Route) SetBSON(raw bson.Raw) error {
+	var encoded string
+	if err := raw.Unmarshal(&encoded); err != nil {
+		return err
+	}
+	route, err := DecodeRoute(encoded)
+	if err != nil {
+		return err
+	}
+	*r = route
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/mgo.v2/bson"
+)
+
+func newTestRoute(coordinates ...[2]float64) Route {
+	start := time.Date(2020, 3, 2, 7, 30, 0, 0, time.UTC)
+	route := make(Route, 0, len(coordinates))
+	for i, coordinate := range coordinates {
+		route = append(route, RoutePoint{
+			Latitude:  coordinate[0],
+			Longitude: coordinate[1],
+			Elevation: float64(i) * 1.5,
+			Time:      start.Add(time.Duration(i) * time.Minute),
+		})
+	}
+
+	return route
+}
+
+func TestRouteEncode(t *testing.T) {
+	r := require.New(t)
+
+	route := newTestRoute([2]float64{38.5, -120.2}, [2]float64{40.7, -120.95}, [2]float64{43.252, -126.453})
+	route[2].Elevation = -20.3
+	decoded, err := DecodeRoute(route.Encode())
+	r.NoError(err)
+	r.Equal(route, decoded)
+
+	decoded, err = DecodeRoute("")
+	r.NoError(err)
+	r.Nil(decoded)
+
+	encoded := route.Encode()
+	_, err = DecodeRoute(encoded[:len(encoded)-1])
+	r.Equal(ErrInvalidRoute, err)
+	_, err = DecodeRoute(" ")
+	r.Equal(ErrInvalidRoute, err)
+}
+
+func TestRouteBSON(t *testing.T) {
+	r := require.New(t)
+
+	tracking := Tracking{Cursor: bson.NewObjectId(), Route: newTestRoute([2]float64{50, 30}, [2]float64{50.001, 30.001})}
+	data, err := bson.Marshal(&tracking)
+	r.NoError(err)
+
+	var raw bson.M
+	r.NoError(bson.Unmarshal(data, &raw))
+	r.IsType("", raw["route"], "route should be stored as encoded string")
+
+	var decoded Tracking
+	r.NoError(bson.Unmarshal(data, &decoded))
+	r.Equal(tracking.Route, decoded.Route)
+
+	data, err = bson.Marshal(&Tracking{Cursor: bson.NewObjectId()})
+	r.NoError(err)
+	raw = bson.M{}
+	r.NoError(bson.Unmarshal(data, &raw))
+	r.NotContains(raw, "route")
+}
+
+func TestRouteSimplify(t *testing.T) {
+	r := require.New(t)
+
+	// Points along the equator, the middle one is ~11 meters to the north.
+	route := newTestRoute(
+		[2]float64{0, 0},
+		[2]float64{0, 0.001},
+		[2]float64{0.0001, 0.002},
+		[2]float64{0, 0.003},
+		[2]float64{0, 0.004},
+	)
+	r.InDelta(445, route.Distance(), 1)
+	r.Equal(4*time.Minute, route.Duration())
+
+	r.Equal(route, route.Simplify(0))
+	r.Equal(Route{route[0], route[2], route[4]}, route.Simplify(6))
+	r.Equal(Route{route[0], route[4]}, route.Simplify(20))
+}
+
+func TestTrackingSetRoute(t *testing.T) {
+	r := require.New(t)
+
+	tracking := &Tracking{Time: time.Hour, Distance: 10}
+	tracking.SetRoute(newTestRoute([2]float64{0, 0}))
+	r.Equal(time.Hour, tracking.Time, "single point is not enough for duration")
+
+	tracking.SetRoute(newTestRoute([2]float64{0, 0}, [2]float64{0, 0.01}))
+	r.Equal(time.Minute, tracking.Time)
+	r.InDelta(1111.95, tracking.Distance, 0.1)
+}
@@ -1,6 +1,10 @@
 package storage
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Storage interface {
 	// User CRUD
@@ -11,6 +15,13 @@ type Storage interface {
 	GetUserByEmail(email string) (*User, error)
 	ListUsers(filter *UserFilter) (*ListUsersResponse, error)
 
+	// Role CRUD, role names are unique
+	SaveRole(role *Role) error
+	UpdateRole(role *Role) error
+	DeleteRole(id uuid.UUID) error
+	GetRole(id uuid.UUID) (*Role, error)
+	ListRoles() ([]*Role, error)
+
 	// Tracking CRUD
 	SaveTracking(tracking *Tracking) error
 	UpdateTracking(tracking *Tracking) error
@@ -18,10 +29,81 @@ type Storage interface {
 	GetTracking(id uuid.UUID) (*Tracking, error)
 	ListTrackings(filter *TrackingFilter) (*ListTrackingsResponse, error)
 	ListTrackingsForUser(filter *TrackingFilter) (*ListTrackingsResponse, error)
+	ListTrackingsForUsers(filter *TrackingFilter) (*ListTrackingsResponse, error)
+	// SaveTrackingWithWeatherJob and UpdateTrackingWithWeatherJob save the
+	// tracking together with its weather job, so the tracking isn't left
+	// without weather.
+	SaveTrackingWithWeatherJob(tracking *Tracking, job *WeatherJob) error
+	UpdateTrackingWithWeatherJob(tracking *Tracking, job *WeatherJob) error
+	// SaveTrackingsWithWeatherJobs saves all trackings or none of them, jobs[i]
+	// is the weather job of trackings[i].
+	SaveTrackingsWithWeatherJobs(trackings []*Tracking, jobs []*WeatherJob) error
+	// SetTrackingWeather changes only weather, so concurrent updates of other
+	// fields aren't lost.
+	SetTrackingWeather(id uuid.UUID, weather *Weather) error
 	GetReport(filter *ReportFilter) (*Report, error)
 
+	// Team CRUD
+	SaveTeam(team *Team) error
+	UpdateTeam(team *Team) error
+	DeleteTeam(id uuid.UUID) error
+	GetTeam(id uuid.UUID) (*Team, error)
+	// ListTeamsForUser returns teams, where the user is owner, coach, member
+	// or invited.
+	ListTeamsForUser(userID uuid.UUID) ([]*Team, error)
+
+	// Audit log is append only
+	SaveAuditEvent(event *AuditEvent) error
+	ListAuditEvents(filter *AuditEventFilter) (*ListAuditEventsResponse, error)
+
 	// Token CRUD
 	SaveToken(token *Token) error
 	DeleteToken(token *Token) error
 	GetToken(refreshToken string) (*Token, error)
+	// RotateToken deletes old token and saves new one. It returns ErrNotFound
+	// when old token doesn't exist, e.g. it was already rotated.
+	RotateToken(old, new *Token) error
+	DeleteTokensByFamily(family uuid.UUID) error
+	DeleteTokensByUser(userID uuid.UUID) error
+	ListTokensByUser(userID uuid.UUID) ([]*Token, error)
+
+	// One-time tokens for emailed links
+	SaveOneTimeToken(token *OneTimeToken) error
+	// ConsumeOneTimeToken deletes the token by hash and returns it, so it's
+	// used only once. It returns ErrNotFound when token doesn't exist.
+	ConsumeOneTimeToken(hash string) (*OneTimeToken, error)
+	DeleteOneTimeTokens(userID uuid.UUID, purpose TokenPurpose) error
+
+	// Weather jobs queue
+	// SaveWeatherJob adds the job or replaces the job of the same tracking,
+	// so it runs again from the start.
+	SaveWeatherJob(job *WeatherJob) error
+	// LeaseWeatherJob takes the pending job due at now and hides it from
+	// others till leaseUntil. It returns ErrNotFound if no job is due.
+	LeaseWeatherJob(now, leaseUntil time.Time) (*WeatherJob, error)
+	// AckWeatherJob deletes the done job. AckWeatherJob and UpdateWeatherJob
+	// return ErrNotFound if the lease of the job is lost.
+	AckWeatherJob(job *WeatherJob) error
+	UpdateWeatherJob(job *WeatherJob) error
+	GetWeatherJob(id uuid.UUID) (*WeatherJob, error)
+
+	// Weather cache
+	// GetWeatherCacheEntry returns ErrNotFound if there is no entry or it's
+	// expired.
+	GetWeatherCacheEntry(key string) (*WeatherCacheEntry, error)
+	// SaveWeatherCacheEntry adds the entry or replaces the entry of the key.
+	SaveWeatherCacheEntry(entry *WeatherCacheEntry) error
+
+	// Failed sign ins
+	// RecordLoginFailure increments the counter of the key and returns it. The
+	// counter of expired attempts starts over.
+	RecordLoginFailure(key string, at, expiresAt time.Time) (*LoginAttempts, error)
+	// GetLoginAttempts returns ErrNotFound if there are no attempts or they
+	// are expired.
+	GetLoginAttempts(key string) (*LoginAttempts, error)
+	DeleteLoginAttempts(key string) error
+
+	// Revocation list
+	SaveRevocation(revocation *Revocation) error
+	IsTokenRevoked(userID uuid.UUID, tokenID string, issuedAt time.Time) (bool, error)
 }
@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+)
+
+type TeamRole string
+
+const (
+	CoachTeamRole  TeamRole = "coach"
+	MemberTeamRole TeamRole = "member"
+)
+
+// Team shares trackings of members with coaches. Owner manages the team and
+// is its first coach.
+type Team struct {
+	ID          uuid.UUID    `json:"id" bson:"_id"`
+	Name        string       `json:"name" bson:"name"`
+	OwnerID     uuid.UUID    `json:"owner_id" bson:"owner_id"`
+	Coaches     []uuid.UUID  `json:"coaches" bson:"coaches"`
+	Members     []uuid.UUID  `json:"members" bson:"members"`
+	Invitations []Invitation `json:"invitations" bson:"invitations"`
+	CreatedAt   time.Time    `json:"created_at" bson:"created_at"`
+}
+
+// Invitation is pending until the user accepts it.
+type Invitation struct {
+	UserID uuid.UUID `json:"user_id" bson:"user_id"`
+	Role   TeamRole  `json:"role" bson:"role"`
+}
+
+func NewTeam(name string, owner uuid.UUID) *Team {
+	return &Team{
+		ID:          uuid.New(),
+		Name:        name,
+		OwnerID:     owner,
+		Coaches:     []uuid.UUID{owner},
+		Members:     []uuid.UUID{},
+		Invitations: []Invitation{},
+		CreatedAt:   time.Now(),
+	}
+}
+
+// Includes checks if the user is owner, coach, member or invited.
+func (t *Team) Includes(id uuid.UUID) bool {
+	if t.OwnerID == id || t.IsCoach(id) || t.IsMember(id) {
+		return true
+	}
+	for _, invitation := range t.Invitations {
+		if invitation.UserID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *Team) IsCoach(id uuid.UUID) bool {
+	return containsID(t.Coaches, id)
+}
+
+func (t *Team) IsMember(id uuid.UUID) bool {
+	return containsID(t.Members, id)
+}
+
+// Invite replaces the previous invitation of the user.
+func (t *Team) Invite(id uuid.UUID, role TeamRole) {
+	t.Invitations = append(t.removeInvitation(id), Invitation{UserID: id, Role: role})
+}
+
+// Accept moves the user from invitations to coaches or members. It returns
+// false if the user isn't invited.
+func (t *Team) Accept(id uuid.UUID) bool {
+	for _, invitation := range t.Invitations {
+		if invitation.UserID != id {
+			continue
+		}
+		t.Invitations = t.removeInvitation(id)
+		switch invitation.Role {
+		case CoachTeamRole:
+			if !t.IsCoach(id) {
+				t.Coaches = append(t.Coaches, id)
+			}
+		case MemberTeamRole:
+			if !t.IsMember(id) {
+				t.Members = append(t.Members, id)
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// Leave removes the user from coaches, members and invitations. It returns
+// false if the user isn't in the team.
+func (t *Team) Leave(id uuid.UUID) bool {
+	invitations := t.removeInvitation(id)
+	left := t.IsCoach(id) || t.IsMember(id) || len(invitations) != len(t.Invitations)
+	t.Coaches = removeID(t.Coaches, id)
+	t.Members = removeID(t.Members, id)
+	t.Invitations = invitations
+
+	return left
+}
+
+func (t *Team) removeInvitation(id uuid.UUID) []Invitation {
+	invitations := make([]Invitation, 0, len(t.Invitations))
+	for _, invitation := range t.Invitations {
+		if invitation.UserID != id {
+			invitations = append(invitations, invitation)
+		}
+	}
+
+	return invitations
+}
+
+func (t *Team) ToProto() *pb.Team {
+	invitations := make([]*pb.TeamInvitation, 0, len(t.Invitations))
+	for _, invitation := range t.Invitations {
+		invitations = append(invitations, &pb.TeamInvitation{
+			UserId: invitation.UserID.String(),
+			Role:   invitation.Role.ToProto(),
+		})
+	}
+
+	return &pb.Team{
+		Id:          t.ID.String(),
+		Name:        t.Name,
+		OwnerId:     t.OwnerID.String(),
+		Coaches:     idsToStrings(t.Coaches),
+		Members:     idsToStrings(t.Members),
+		Invitations: invitations,
+	}
+}
+
+func (r TeamRole) ToProto() pb.TeamRole {
+	switch r {
+	case CoachTeamRole:
+		return pb.TeamRole_TEAM_ROLE_COACH
+	case MemberTeamRole:
+		return pb.TeamRole_TEAM_ROLE_MEMBER
+	default:
+		return pb.TeamRole_TEAM_ROLE_UNSPECIFIED
+	}
+}
+
+func TeamRoleFromProto(role pb.TeamRole) (TeamRole, error) {
+	switch role {
+	case pb.TeamRole_TEAM_ROLE_COACH:
+		return CoachTeamRole, nil
+	case pb.TeamRole_TEAM_ROLE_MEMBER:
+		return MemberTeamRole, nil
+	default:
+		return "", ErrUnknownTeamRole
+	}
+}
+
+// LoadTeams loads teams of the user from the storage, so policies could
+// check its relation to other users.
+func LoadTeams(store Storage, user *User) error {
+	teams, err := store.ListTeamsForUser(user.ID)
+	if err != nil {
+		return err
+	}
+	user.teams = teams
+
+	return nil
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func removeID(ids []uuid.UUID, id uuid.UUID) []uuid.UUID {
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, i := range ids {
+		if i != id {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
+
+func idsToStrings(ids []uuid.UUID) []string {
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, id.String())
+	}
+
+	return res
+}
@@ -9,7 +9,9 @@ import (
 )
 
 type Token struct {
-	UserID    uuid.UUID `json:"user_id" bson:"user_id"`
+	UserID uuid.UUID `json:"user_id" bson:"user_id"`
+	// Family is shared by all refresh tokens rotated from the same sign in.
+	Family    uuid.UUID `json:"family" bson:"family"`
 	Access    string    `json:"access_token" bson:"access_token"`
 	Refresh   string    `json:"refresh_token" bson:"refresh_token"`
 	ExpiresAt time.Time `json:"expires_at" bson:"expires_at"`
@@ -25,5 +27,6 @@ const (
 type Claims struct {
 	UserID string    `json:"user_id"`
 	Type   TokenType `json:"type"`
+	Family string    `json:"family,omitempty"`
 	jwt.StandardClaims
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/boodyvo/jogging-api/lib"
 
 	"github.com/golang/protobuf/ptypes/duration"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 	"github.com/google/uuid"
@@ -26,6 +27,7 @@ type Tracking struct {
 	// Distance represents in meters
 	Distance float32       `json:"distance" bson:"distance"`
 	Weather  *Weather      `json:"weather" bson:"weather"`
+	Route    Route         `json:"route,omitempty" bson:"route,omitempty"`
 	Cursor   bson.ObjectId `json:"-" bson:"cursor"`
 }
 
@@ -34,7 +36,7 @@ func NewTrackingFromProto(tracking *pb.CreateTrackingRequest) (*Tracking, error)
 	if err != nil {
 		return nil, err
 	}
-	return &Tracking{
+	res := &Tracking{
 		Cursor: bson.NewObjectId(),
 		ID:     uuid.New(),
 		Location: Location{
@@ -45,7 +47,21 @@ func NewTrackingFromProto(tracking *pb.CreateTrackingRequest) (*Tracking, error)
 		Time:     time.Duration(tracking.Time.Seconds * int64(time.Second)),
 		Distance: tracking.Distance,
 		Weather:  &Weather{},
-	}, nil
+	}
+	res.SetRoute(RouteFromProto(tracking.Route))
+
+	return res, nil
+}
+
+// SetRoute sets route and derives duration and distance from it, when route
+// has enough points for that.
+func (t *Tracking) SetRoute(route Route) {
+	t.Route = route
+	if len(route) < 2 {
+		return
+	}
+	t.Time = route.Duration().Truncate(time.Second)
+	t.Distance = float32(route.Distance())
 }
 
 func (t *Tracking) ToProto() *pb.Tracking {
@@ -63,11 +79,60 @@ func (t *Tracking) ToProto() *pb.Tracking {
 	}
 }
 
+// ApplyUpdateFromProto sets fields listed in mask from update. It returns true
+// when date or location was changed, so weather has to be obtained again.
+func (t *Tracking) ApplyUpdateFromProto(update *pb.TrackingUpdate, mask *field_mask.FieldMask) (bool, error) {
+	weatherChanged := false
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "date":
+			trackingDate, err := time.Parse(lib.DateFormat, update.Date)
+			if err != nil {
+				return false, err
+			}
+			weatherChanged = weatherChanged || !trackingDate.Equal(t.Date)
+			t.Date = trackingDate
+		case "time":
+			if update.Time == nil {
+				return false, ErrUnknownField
+			}
+			t.Time = time.Duration(update.Time.Seconds * int64(time.Second))
+		case "distance":
+			t.Distance = update.Distance
+		case "location", "location.longitude", "location.latitude":
+			if update.Location == nil {
+				return false, ErrUnknownField
+			}
+			location := t.Location
+			if path != "location.latitude" {
+				location.Longitude = update.Location.Longitude
+			}
+			if path != "location.longitude" {
+				location.Latitude = update.Location.Latitude
+			}
+			weatherChanged = weatherChanged || location != t.Location
+			t.Location = location
+		default:
+			return false, ErrUnknownField
+		}
+	}
+	if weatherChanged {
+		t.Weather = &Weather{}
+	}
+
+	return weatherChanged, nil
+}
+
 type TrackingFilter struct {
-	UserID     uuid.UUID
+	UserID uuid.UUID
+	// UserIDs are used by ListTrackingsForUsers
+	UserIDs    []uuid.UUID
 	PerRequest int64
 	Cursor     string
 	Query      string
+	// MissingWeather lists only trackings with empty weather, which lookup
+	// has failed.
+	MissingWeather bool
 }
 
 func TrackingFilterFromProtoForUser(tracking *pb.ListTrackingsRequest, user *User) (*TrackingFilter, error) {
@@ -109,10 +174,38 @@ func ProtoFromListTrackingsResponse(response *ListTrackingsResponse) *pb.ListTra
 	}
 }
 
+type Granularity string
+
+const (
+	NoGranularity      Granularity = ""
+	DayGranularity     Granularity = "day"
+	WeekGranularity    Granularity = "week"
+	MonthGranularity   Granularity = "month"
+	ISOWeekGranularity Granularity = "iso_week"
+)
+
+func GranularityFromProto(granularity pb.Granularity) (Granularity, error) {
+	switch granularity {
+	case pb.Granularity_GRANULARITY_UNSPECIFIED:
+		return NoGranularity, nil
+	case pb.Granularity_GRANULARITY_DAY:
+		return DayGranularity, nil
+	case pb.Granularity_GRANULARITY_WEEK:
+		return WeekGranularity, nil
+	case pb.Granularity_GRANULARITY_MONTH:
+		return MonthGranularity, nil
+	case pb.Granularity_GRANULARITY_ISO_WEEK:
+		return ISOWeekGranularity, nil
+	default:
+		return NoGranularity, ErrUnknownGranularity
+	}
+}
+
 type ReportFilter struct {
-	UserID   uuid.UUID
-	FromDate time.Time
-	Duration time.Duration
+	UserID      uuid.UUID
+	FromDate    time.Time
+	Duration    time.Duration
+	Granularity Granularity
 }
 
 func NewReportFilterFromProtoForUser(request *pb.ReportRequest, user *User) (*ReportFilter, error) {
@@ -125,21 +218,55 @@ func NewReportFilterFromProtoForUser(request *pb.ReportRequest, user *User) (*Re
 		dur = time.Duration(request.Duration.Seconds * int64(time.Second))
 	}
 
+	granularity, err := GranularityFromProto(request.Granularity)
+	if err != nil {
+		return nil, err
+	}
+
 	return &ReportFilter{
-		UserID:   user.ID,
-		FromDate: fromDate.UTC(),
-		Duration: dur,
+		UserID:      user.ID,
+		FromDate:    fromDate.UTC(),
+		Duration:    dur,
+		Granularity: granularity,
 	}, nil
 }
 
 type Report struct {
-	AverageSpeed float32 `json:"average_speed" bson:"average_speed"`
-	Distance     float32 `json:"distance" bson:"distance"`
+	AverageSpeed float32         `json:"average_speed" bson:"average_speed"`
+	Distance     float32         `json:"distance" bson:"distance"`
+	Buckets      []*ReportBucket `json:"buckets" bson:"buckets"`
 }
 
 func (r *Report) ToProto() *pb.ReportResponse {
+	buckets := make([]*pb.ReportBucket, 0, len(r.Buckets))
+	for _, bucket := range r.Buckets {
+		buckets = append(buckets, bucket.ToProto())
+	}
+
 	return &pb.ReportResponse{
 		AverageSpeed: r.AverageSpeed,
 		Distance:     r.Distance,
+		Buckets:      buckets,
+	}
+}
+
+// ReportBucket aggregates trackings of one day, week or month of the report.
+type ReportBucket struct {
+	StartDate    time.Time     `json:"start_date" bson:"_id"`
+	Count        int64         `json:"count" bson:"count"`
+	Distance     float32       `json:"distance" bson:"distance"`
+	Time         time.Duration `json:"time" bson:"time"`
+	AverageSpeed float32       `json:"average_speed" bson:"average_speed"`
+	LongestRun   float32       `json:"longest_run" bson:"longest_run"`
+}
+
+func (b *ReportBucket) ToProto() *pb.ReportBucket {
+	return &pb.ReportBucket{
+		StartDate:    b.StartDate.UTC().Format(lib.DateFormat),
+		Count:        b.Count,
+		Distance:     b.Distance,
+		Time:         &duration.Duration{Seconds: int64(b.Time.Seconds())},
+		AverageSpeed: b.AverageSpeed,
+		LongestRun:   b.LongestRun,
 	}
 }
@@ -15,32 +15,35 @@ type User struct {
 	Email     string        `json:"email" bson:"email"`
 	Password  string        `json:"-" bson:"password"`
 	CreatedAt time.Time     `json:"created_at" bson:"created_at"`
-	Roles     []string      `json:"-" bson:"roles"`
+	Roles     []uuid.UUID   `json:"-" bson:"roles"`
 	ACL       []Permission  `json:"-" bson:"acl"`
 	Cursor    bson.ObjectId `json:"-" bson:"cursor"`
+	// EmailVerified is set when the user follows the link sent to the email.
+	EmailVerified bool `json:"email_verified" bson:"email_verified"`
+
+	// roles are set by LoadRoles
+	roles []*Role
+	// teams are set by LoadTeams
+	teams []*Team
 }
 
 func NewUser(email, password string) *User {
 	user := newUser(email, password)
-	user.Roles = []string{UserRole.Name}
-	// Adding basic permissions for particular user
-	user.AddPermission(NewPermission(ReadAction, UserScope, user.ID.String()))
-	user.AddPermission(NewPermission(UpdateAction, UserScope, user.ID.String()))
-	user.AddPermission(NewPermission(DeleteAction, UserScope, user.ID.String()))
+	user.Roles = []uuid.UUID{UserRole.ID}
 
 	return user
 }
 
 func NewManager(email, password string) *User {
 	user := newUser(email, password)
-	user.Roles = []string{ManagerRole.Name}
+	user.Roles = []uuid.UUID{ManagerRole.ID}
 
 	return user
 }
 
 func NewAdmin(email, password string) *User {
 	user := newUser(email, password)
-	user.Roles = []string{AdminRole.Name}
+	user.Roles = []uuid.UUID{AdminRole.ID}
 
 	return user
 }
@@ -56,11 +59,12 @@ func newUser(email, password string) *User {
 	}
 }
 
-// TODO(boodyvo): for now just full search trough role but need to make roles by IDs
+// HasPermission checks the permission by roles and ACL. Roles must be loaded
+// by LoadRoles before.
 func (u *User) HasPermission(permission Permission) bool {
 	// check in roles
-	for _, role := range u.Roles {
-		if Roles[role].HasPermission(permission) {
+	for _, role := range u.roles {
+		if role.HasPermission(permission) {
 			return true
 		}
 	}
@@ -74,6 +78,30 @@ func (u *User) HasPermission(permission Permission) bool {
 	return false
 }
 
+// HasPermissionFor checks the permission on the resource owned by owner by
+// policies, roles and ACL. Owner is uuid.Nil if it's unknown.
+func (u *User) HasPermissionFor(permission Permission, owner uuid.UUID) bool {
+	for _, policy := range Policies {
+		if policy.Allows(u, permission, owner) {
+			return true
+		}
+	}
+
+	return u.HasPermission(permission)
+}
+
+// Coaches checks if the user coaches another one in any of its teams. Teams
+// must be loaded by LoadTeams before.
+func (u *User) Coaches(id uuid.UUID) bool {
+	for _, team := range u.teams {
+		if team.IsCoach(u.ID) && team.IsMember(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (u *User) AddPermission(permission Permission) {
 	u.ACL = append(u.ACL, permission)
 }
@@ -89,20 +117,47 @@ func (u *User) RemovePermission(permission Permission) {
 	u.ACL = newACL
 }
 
-func (u *User) AddRole(role Role) {
+// HasACLPermission checks if exactly the permission is in the user ACL.
+func (u *User) HasACLPermission(permission Permission) bool {
+	for _, perm := range u.ACL {
+		if permission.Equal(perm) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EffectivePermissions returns permissions granted by roles and ACL with
+// their sources. Roles must be loaded by LoadRoles before.
+func (u *User) EffectivePermissions() []PermissionGrant {
+	grants := make([]PermissionGrant, 0, len(u.ACL))
+	for _, role := range u.roles {
+		for _, perm := range role.Permissions {
+			grants = append(grants, PermissionGrant{Permission: perm, Role: role})
+		}
+	}
+	for _, perm := range u.ACL {
+		grants = append(grants, PermissionGrant{Permission: perm})
+	}
+
+	return grants
+}
+
+func (u *User) AddRole(id uuid.UUID) {
 	for _, r := range u.Roles {
-		if role.Name == r {
+		if id == r {
 			return
 		}
 	}
 
-	u.Roles = append(u.Roles, role.Name)
+	u.Roles = append(u.Roles, id)
 }
 
-func (u *User) RemoveRole(role Role) {
-	roles := make([]string, 0, len(u.Roles))
+func (u *User) RemoveRole(id uuid.UUID) {
+	roles := make([]uuid.UUID, 0, len(u.Roles))
 	for _, r := range u.Roles {
-		if role.Name != r {
+		if id != r {
 			roles = append(roles, r)
 		}
 	}
@@ -110,26 +165,11 @@ func (u *User) RemoveRole(role Role) {
 	u.Roles = roles
 }
 
-// TODO(boodyvo): Could check permissions by owning but for now in such way
-// TODO(boodyvo): Implement permissions saving as separate table
-func (u *User) AddTrackingPermission(id uuid.UUID) {
-	// Adding basic permissions for particular user
-	u.AddPermission(NewPermission(ReadAction, TrackingScope, id.String()))
-	u.AddPermission(NewPermission(UpdateAction, TrackingScope, id.String()))
-	u.AddPermission(NewPermission(DeleteAction, TrackingScope, id.String()))
-}
-
-func (u *User) RemoveTrackingPermission(id uuid.UUID) {
-	// Adding basic permissions for particular user
-	u.RemovePermission(NewPermission(ReadAction, TrackingScope, id.String()))
-	u.RemovePermission(NewPermission(UpdateAction, TrackingScope, id.String()))
-	u.RemovePermission(NewPermission(DeleteAction, TrackingScope, id.String()))
-}
-
 func (u *User) ToProto() *pb.User {
 	return &pb.User{
-		Id:    u.ID.String(),
-		Email: u.Email,
+		Id:            u.ID.String(),
+		Email:         u.Email,
+		EmailVerified: u.EmailVerified,
 	}
 }
 
@@ -138,11 +178,17 @@ func (u *User) ToDetailedProto() *pb.DetailedUser {
 	for _, acl := range u.ACL {
 		permissions = append(permissions, acl.String())
 	}
+	roles := make([]string, 0, len(u.Roles))
+	for _, role := range u.Roles {
+		roles = append(roles, role.String())
+	}
+
 	return &pb.DetailedUser{
-		Id:          u.ID.String(),
-		Email:       u.Email,
-		Roles:       u.Roles,
-		Permissions: permissions,
+		Id:            u.ID.String(),
+		Email:         u.Email,
+		Roles:         roles,
+		Permissions:   permissions,
+		EmailVerified: u.EmailVerified,
 	}
 }
 
@@ -4,6 +4,9 @@ import (
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 )
 
+// Weather is the daily weather. Temperature is in degrees Celsius, snow depth
+// in centimeters, wind direction in degrees, wind speed in km/h and pressure
+// in hPa, providers convert their values to these units.
 type Weather struct {
 	Temperature    float32 `json:"temperature" bson:"temperature"`
 	TemperatureMin float32 `json:"temperature_min" bson:"temperature_min"`
@@ -14,6 +17,12 @@ type Weather struct {
 	Pressure       float32 `json:"pressure" bson:"pressure"`
 }
 
+// IsEmpty reports whether the weather isn't set. Lookup never returns all
+// zero values, so empty weather is the one of failed lookup.
+func (w *Weather) IsEmpty() bool {
+	return w == nil || *w == Weather{}
+}
+
 func (w *Weather) ToProto() *pb.Weather {
 	return &pb.Weather{
 		Temperature:    w.Temperature,
@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"time"
+)
+
+// WeatherCacheEntry is the looked up nearest station or daily weather of the
+// station, only one of them is set.
+type WeatherCacheEntry struct {
+	Key       string    `json:"key" bson:"_id"`
+	StationID string    `json:"station_id" bson:"station_id"`
+	Weather   *Weather  `json:"weather" bson:"weather"`
+	ExpiresAt time.Time `json:"expires_at" bson:"expires_at"`
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"strings"
+	"time"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// Backoff locks sign in after FreeFailures failed attempts. The lockout
+// starts from Delay and doubles with every next failure up to MaxDelay.
+type Backoff struct {
+	FreeFailures int
+	Delay        time.Duration
+	MaxDelay     time.Duration
+	// Window is the time after the last failure, when failures are forgotten.
+	Window time.Duration
+}
+
+// SignInLimits are backoffs of failed sign ins of the account and of the
+// client address. The address limit stops guessing passwords of many
+// accounts.
+type SignInLimits struct {
+	Account Backoff
+	Address Backoff
+}
+
+var DefaultSignInLimits = SignInLimits{
+	Account: Backoff{
+		FreeFailures: 5,
+		Delay:        30 * time.Second,
+		MaxDelay:     15 * time.Minute,
+		Window:       time.Hour,
+	},
+	Address: Backoff{
+		FreeFailures: 50,
+		Delay:        30 * time.Second,
+		MaxDelay:     15 * time.Minute,
+		Window:       time.Hour,
+	},
+}
+
+// LockedUntil returns the end of the lockout, it's zero if there is none.
+func (b Backoff) LockedUntil(attempts *storage.LoginAttempts) time.Time {
+	if attempts.Failures < b.FreeFailures {
+		return time.Time{}
+	}
+	delay := b.Delay
+	for i := b.FreeFailures; i < attempts.Failures && delay < b.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > b.MaxDelay {
+		delay = b.MaxDelay
+	}
+
+	return attempts.LastFailureAt.Add(delay)
+}
+
+// signInKey is the key of login attempts with the backoff applied to them.
+type signInKey struct {
+	key     string
+	backoff Backoff
+}
+
+// signInKeys returns keys of the account and of the client address. Address
+// is unknown for calls without peer, e.g. in tests.
+func (s *APIServer) signInKeys(email, ip string) []signInKey {
+	keys := []signInKey{{
+		key:     storage.AccountLoginKey(strings.ToLower(strings.TrimSpace(email))),
+		backoff: s.signInLimits.Account,
+	}}
+	if ip != "" {
+		keys = append(keys, signInKey{key: storage.AddressLoginKey(ip), backoff: s.signInLimits.Address})
+	}
+
+	return keys
+}
+
+// checkSignInLock returns ErrSignInLocked if any of the keys is locked out.
+func (s *APIServer) checkSignInLock(keys []signInKey) error {
+	now := time.Now()
+	for _, key := range keys {
+		attempts, err := s.store.GetLoginAttempts(key.key)
+		if err == storage.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if key.backoff.LockedUntil(attempts).After(now) {
+			return ErrSignInLocked
+		}
+	}
+
+	return nil
+}
+
+func (s *APIServer) recordSignInFailure(keys []signInKey) error {
+	now := time.Now()
+	for _, key := range keys {
+		attempts, err := s.store.RecordLoginFailure(key.key, now, now.Add(key.backoff.Window))
+		if err != nil {
+			return err
+		}
+		if lockedUntil := key.backoff.LockedUntil(attempts); lockedUntil.After(now) {
+			s.logger.
+				WithField("key", key.key).
+				WithField("failures", attempts.Failures).
+				WithField("locked_until", lockedUntil).
+				Warn("sign in is locked out")
+		}
+	}
+
+	return nil
+}
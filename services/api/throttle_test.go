@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/services/api/mailer"
+	"github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func TestBackoff(t *testing.T) {
+	r := require.New(t)
+	backoff := Backoff{FreeFailures: 3, Delay: time.Second, MaxDelay: 5 * time.Second}
+	now := time.Now()
+
+	lockedUntil := func(failures int) time.Duration {
+		until := backoff.LockedUntil(&storage.LoginAttempts{Failures: failures, LastFailureAt: now})
+		if until.IsZero() {
+			return 0
+		}
+
+		return until.Sub(now)
+	}
+	r.Zero(lockedUntil(2))
+	r.Equal(time.Second, lockedUntil(3))
+	r.Equal(2*time.Second, lockedUntil(4))
+	r.Equal(4*time.Second, lockedUntil(5))
+	r.Equal(5*time.Second, lockedUntil(6))
+	r.Equal(5*time.Second, lockedUntil(100))
+}
+
+func TestSignInLockout(t *testing.T) {
+	r := require.New(t)
+	_, store, authService := newTestInterceptor(t)
+	limits := SignInLimits{
+		Account: Backoff{FreeFailures: 2, Delay: time.Minute, MaxDelay: time.Hour, Window: time.Hour},
+		Address: Backoff{FreeFailures: 10, Delay: time.Minute, MaxDelay: time.Hour, Window: time.Hour},
+	}
+	server := New(store, authService, nil, mailer.NewFile("", log.New()), nil, "", limits, nil, log.New()).(*APIServer)
+	ctx := context.Background()
+
+	password, err := hash("password")
+	r.NoError(err)
+	user := storage.NewUser("user@gmail.com", password)
+	r.NoError(store.SaveUser(user))
+
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: user.Email, Password: "wrong"})
+	r.Equal(ErrInvalidCredentials, err)
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: "unknown@gmail.com", Password: "wrong"})
+	r.Equal(ErrInvalidCredentials, err, "unknown email is found out")
+
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: user.Email, Password: "password"})
+	r.NoError(err)
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: user.Email, Password: "wrong"})
+	r.Equal(ErrInvalidCredentials, err)
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: user.Email, Password: "wrong"})
+	r.Equal(ErrInvalidCredentials, err)
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: user.Email, Password: "password"})
+	r.Equal(ErrSignInLocked, err, "account is not locked after failures")
+
+	for i := 0; i < 2; i++ {
+		_, err = server.SignIn(ctx, &pb.SignInRequest{Email: "unknown@gmail.com", Password: "wrong"})
+	}
+	r.Equal(ErrSignInLocked, err, "unknown email is not locked like existing one")
+
+	_, err = server.UnlockSignIn(context.WithValue(ctx, principalKey{}, user), &pb.UnlockSignInRequest{
+		UserId: user.ID.String(),
+	})
+	r.NoError(err)
+	_, err = server.SignIn(ctx, &pb.SignInRequest{Email: user.Email, Password: "password"})
+	r.NoError(err, "account is locked after unlock")
+}
+
+func TestSignInAddressLockout(t *testing.T) {
+	r := require.New(t)
+	_, store, authService := newTestInterceptor(t)
+	limits := SignInLimits{
+		Account: Backoff{FreeFailures: 10, Delay: time.Minute, MaxDelay: time.Hour, Window: time.Hour},
+		Address: Backoff{FreeFailures: 3, Delay: time.Minute, MaxDelay: time.Hour, Window: time.Hour},
+	}
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.1"})
+	r.NoError(err)
+	server := New(store, authService, nil, mailer.NewFile("", log.New()), nil, "", limits, proxies, log.New()).(*APIServer)
+
+	password, err := hash("password")
+	r.NoError(err)
+	user := storage.NewUser("user@gmail.com", password)
+	r.NoError(store.SaveUser(user))
+
+	// the gateway appends the address after entries sent by the client
+	for i := 0; i < 3; i++ {
+		forged := fmt.Sprintf("198.51.100.%d", i)
+		ctx := clientContext("10.0.0.1", forged+", 203.0.113.7")
+		_, err = server.SignIn(ctx, &pb.SignInRequest{Email: fmt.Sprintf("user%d@gmail.com", i), Password: "wrong"})
+		r.Equal(ErrInvalidCredentials, err)
+	}
+	_, err = server.SignIn(clientContext("10.0.0.1", "198.51.100.9, 203.0.113.7"), &pb.SignInRequest{
+		Email:    user.Email,
+		Password: "password",
+	})
+	r.Equal(ErrSignInLocked, err, "address is not locked after failures with forged entries")
+
+	_, err = server.SignIn(clientContext("10.0.0.1", "203.0.113.8"), &pb.SignInRequest{
+		Email:    user.Email,
+		Password: "password",
+	})
+	r.NoError(err, "other address is locked")
+
+	for i := 0; i < 3; i++ {
+		ctx := clientContext("203.0.113.9", fmt.Sprintf("198.51.100.%d", i))
+		_, err = server.SignIn(ctx, &pb.SignInRequest{Email: fmt.Sprintf("user%d@gmail.com", i), Password: "wrong"})
+		r.Equal(ErrInvalidCredentials, err)
+	}
+	_, err = server.SignIn(clientContext("203.0.113.9"), &pb.SignInRequest{Email: user.Email, Password: "password"})
+	r.Equal(ErrSignInLocked, err, "direct caller is not locked after failures with forged entries")
+}
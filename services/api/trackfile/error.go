@@ -0,0 +1,13 @@
+package trackfile
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrUnknownFormat   = status.Error(codes.InvalidArgument, "unknown file format")
+	ErrInvalidFile     = status.Error(codes.InvalidArgument, "invalid file")
+	ErrNoActivities    = status.Error(codes.InvalidArgument, "file has no activities with track points")
+	ErrInvalidActivity = status.Error(codes.InvalidArgument, "activity has no start time, duration or distance")
+)
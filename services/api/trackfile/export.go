@@ -0,0 +1,195 @@
+package trackfile
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/boodyvo/jogging-api/lib"
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+type Format string
+
+const (
+	CSVFormat    Format = "csv"
+	NDJSONFormat Format = "ndjson"
+	GPXFormat    Format = "gpx"
+)
+
+func FormatFromProto(format pb.ExportFormat) (Format, error) {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		return CSVFormat, nil
+	case pb.ExportFormat_EXPORT_FORMAT_NDJSON:
+		return NDJSONFormat, nil
+	case pb.ExportFormat_EXPORT_FORMAT_GPX:
+		return GPXFormat, nil
+	}
+
+	return "", ErrUnknownFormat
+}
+
+// Encoder writes trackings one by one, so export never holds all of them.
+// Close must be called after the last tracking to finish the file.
+type Encoder interface {
+	Encode(tracking *store.Tracking) error
+	Close() error
+}
+
+func NewEncoder(format Format, w io.Writer) (Encoder, error) {
+	switch format {
+	case CSVFormat:
+		return newCSVEncoder(w)
+	case NDJSONFormat:
+		return &ndjsonEncoder{
+			w:         w,
+			marshaler: &jsonpb.Marshaler{EmitDefaults: true, OrigName: true},
+		}, nil
+	case GPXFormat:
+		return newGPXEncoder(w)
+	}
+
+	return nil, ErrUnknownFormat
+}
+
+var csvHeader = []string{
+	"id", "user_id", "date", "time", "distance", "longitude", "latitude",
+	"temperature", "temperature_min", "temperature_max", "snowdepth",
+	"winddirection", "windspeed", "pressure",
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func newCSVEncoder(w io.Writer) (*csvEncoder, error) {
+	encoder := &csvEncoder{w: csv.NewWriter(w)}
+	if err := encoder.w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	return encoder, nil
+}
+
+func (e *csvEncoder) Encode(tracking *store.Tracking) error {
+	weather := tracking.Weather
+	if weather == nil {
+		weather = &store.Weather{}
+	}
+
+	return e.w.Write([]string{
+		tracking.ID.String(),
+		tracking.UserID.String(),
+		tracking.Date.Format(lib.DateFormat),
+		strconv.FormatInt(int64(tracking.Time.Seconds()), 10),
+		formatFloat32(tracking.Distance),
+		strconv.FormatFloat(tracking.Location.Longitude, 'f', -1, 64),
+		strconv.FormatFloat(tracking.Location.Latitude, 'f', -1, 64),
+		formatFloat32(weather.Temperature),
+		formatFloat32(weather.TemperatureMin),
+		formatFloat32(weather.TemperatureMax),
+		formatFloat32(weather.Snowdepth),
+		formatFloat32(weather.Winddirection),
+		formatFloat32(weather.Windspeed),
+		formatFloat32(weather.Pressure),
+	})
+}
+
+func (e *csvEncoder) Close() error {
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+func formatFloat32(value float32) string {
+	return strconv.FormatFloat(float64(value), 'f', -1, 32)
+}
+
+type ndjsonEncoder struct {
+	w         io.Writer
+	marshaler *jsonpb.Marshaler
+}
+
+func (e *ndjsonEncoder) Encode(tracking *store.Tracking) error {
+	message := tracking.ToProto()
+	message.Route = tracking.Route.ToProto()
+	if err := e.marshaler.Marshal(e.w, message); err != nil {
+		return err
+	}
+	_, err := e.w.Write([]byte("\n"))
+
+	return err
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
+
+type gpxTrack struct {
+	XMLName xml.Name        `xml:"trk"`
+	Name    string          `xml:"name"`
+	Points  []gpxTrackPoint `xml:"trkseg>trkpt"`
+}
+
+type gpxTrackPoint struct {
+	Latitude  float64  `xml:"lat,attr"`
+	Longitude float64  `xml:"lon,attr"`
+	Elevation *float64 `xml:"ele,omitempty"`
+	Time      string   `xml:"time"`
+}
+
+// gpxEncoder writes every tracking as a track. Tracking without route has
+// only its start location, dated by the start of the day.
+type gpxEncoder struct {
+	w       io.Writer
+	encoder *xml.Encoder
+}
+
+func newGPXEncoder(w io.Writer) (*gpxEncoder, error) {
+	_, err := io.WriteString(w, xml.Header+
+		`<gpx version="1.1" creator="jogging-api" xmlns="http://www.topografix.com/GPX/1/1">`+"\n")
+	if err != nil {
+		return nil, err
+	}
+
+	return &gpxEncoder{w: w, encoder: xml.NewEncoder(w)}, nil
+}
+
+func (e *gpxEncoder) Encode(tracking *store.Tracking) error {
+	track := gpxTrack{Name: tracking.ID.String()}
+	for _, point := range tracking.Route {
+		elevation := point.Elevation
+		track.Points = append(track.Points, gpxTrackPoint{
+			Latitude:  point.Latitude,
+			Longitude: point.Longitude,
+			Elevation: &elevation,
+			Time:      point.Time.UTC().Format(time.RFC3339),
+		})
+	}
+	if len(track.Points) == 0 {
+		track.Points = append(track.Points, gpxTrackPoint{
+			Latitude:  tracking.Location.Latitude,
+			Longitude: tracking.Location.Longitude,
+			Time:      tracking.Date.UTC().Format(time.RFC3339),
+		})
+	}
+
+	if err := e.encoder.Encode(track); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n")
+
+	return err
+}
+
+func (e *gpxEncoder) Close() error {
+	_, err := io.WriteString(e.w, "</gpx>\n")
+
+	return err
+}
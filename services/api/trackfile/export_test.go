@@ -0,0 +1,103 @@
+package trackfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+func exportTrackings() []*store.Tracking {
+	activities, _ := Parse([]byte(gpxFixture))
+	withRoute := activities[0].ToTracking()
+	withRoute.UserID = uuid.New()
+
+	return []*store.Tracking{
+		withRoute,
+		{
+			ID:       uuid.New(),
+			UserID:   withRoute.UserID,
+			Date:     time.Date(2020, 3, 3, 0, 0, 0, 0, time.UTC),
+			Time:     time.Hour,
+			Distance: 10000.5,
+			Location: store.Location{Longitude: 13.405, Latitude: 52.52},
+			Weather:  &store.Weather{Temperature: -1.5},
+		},
+	}
+}
+
+func encode(t *testing.T, format Format, trackings []*store.Tracking) []byte {
+	var buf bytes.Buffer
+	encoder, err := NewEncoder(format, &buf)
+	require.NoError(t, err)
+	for _, tracking := range trackings {
+		require.NoError(t, encoder.Encode(tracking))
+	}
+	require.NoError(t, encoder.Close())
+
+	return buf.Bytes()
+}
+
+func TestEncodeCSV(t *testing.T) {
+	r := require.New(t)
+	trackings := exportTrackings()
+
+	records, err := csv.NewReader(bytes.NewReader(encode(t, CSVFormat, trackings))).ReadAll()
+	r.NoError(err)
+	r.Len(records, 3)
+	r.Equal(csvHeader, records[0])
+	r.Equal([]string{
+		trackings[1].ID.String(), trackings[1].UserID.String(), "2020-03-03", "3600", "10000.5",
+		"13.405", "52.52", "-1.5", "0", "0", "0", "0", "0", "0",
+	}, records[2])
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	r := require.New(t)
+	trackings := exportTrackings()
+
+	lines := strings.Split(strings.TrimSpace(string(encode(t, NDJSONFormat, trackings))), "\n")
+	r.Len(lines, 2)
+	for i, line := range lines {
+		var tracking pb.Tracking
+		r.NoError(jsonpb.UnmarshalString(line, &tracking))
+		r.Equal(trackings[i].ID.String(), tracking.Id)
+		r.Len(tracking.Route, len(trackings[i].Route))
+	}
+}
+
+func TestEncodeGPX(t *testing.T) {
+	r := require.New(t)
+	trackings := exportTrackings()
+
+	activities, err := ParseGPX(encode(t, GPXFormat, trackings))
+	r.NoError(err)
+	r.Len(activities, 2)
+	r.Equal(trackings[0].Route, activities[0].Route)
+	r.Equal(store.Route{{
+		Latitude:  52.52,
+		Longitude: 13.405,
+		Time:      trackings[1].Date,
+	}}, activities[1].Route)
+}
+
+func TestEncodeEmpty(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(strings.Join(csvHeader, ",")+"\n", string(encode(t, CSVFormat, nil)))
+	r.Empty(encode(t, NDJSONFormat, nil))
+	activities, err := ParseGPX(encode(t, GPXFormat, nil))
+	r.NoError(err)
+	r.Empty(activities)
+
+	_, err = FormatFromProto(pb.ExportFormat(100))
+	r.Equal(ErrUnknownFormat, err)
+}
@@ -0,0 +1,183 @@
+package trackfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// Subset of FIT protocol needed to read track points. See FIT SDK for details:
+// https://developer.garmin.com/fit/protocol/
+const (
+	fitHeaderCompressed = 0x80
+	fitHeaderDefinition = 0x40
+	fitHeaderDeveloper  = 0x20
+
+	fitMessageRecord = 20
+
+	fitFieldTimestamp        = 253
+	fitFieldPositionLat      = 0
+	fitFieldPositionLong     = 1
+	fitFieldAltitude         = 2
+	fitFieldEnhancedAltitude = 78
+
+	fitInvalidSint32 = 0x7FFFFFFF
+	fitInvalidUint32 = 0xFFFFFFFF
+	fitInvalidUint16 = 0xFFFF
+)
+
+// fitEpoch is the start of FIT timestamps, 1989-12-31 00:00:00 UTC.
+var fitEpoch = time.Date(1989, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+type fitField struct {
+	num  byte
+	size int
+}
+
+type fitDefinition struct {
+	order   binary.ByteOrder
+	global  uint16
+	fields  []fitField
+	devSize int
+}
+
+func isFIT(data []byte) bool {
+	return len(data) >= 12 && bytes.Equal(data[8:12], []byte(".FIT"))
+}
+
+// ParseFIT returns activity built from record messages of the file.
+func ParseFIT(data []byte) ([]*Activity, error) {
+	if !isFIT(data) {
+		return nil, ErrInvalidFile
+	}
+	headerSize := int(data[0])
+	end := headerSize + int(binary.LittleEndian.Uint32(data[4:8]))
+	if headerSize < 12 || end > len(data) {
+		return nil, ErrInvalidFile
+	}
+
+	definitions := make(map[byte]*fitDefinition)
+	activity := &Activity{}
+	lastTimestamp := uint32(0)
+	for offset := headerSize; offset < end; {
+		header := data[offset]
+		offset++
+
+		if header&fitHeaderCompressed == 0 && header&fitHeaderDefinition != 0 {
+			definition, size, err := parseFITDefinition(data[offset:end], header&fitHeaderDeveloper != 0)
+			if err != nil {
+				return nil, err
+			}
+			definitions[header&0x0F] = definition
+			offset += size
+			continue
+		}
+
+		local := header & 0x0F
+		timestamp := uint32(0)
+		if header&fitHeaderCompressed != 0 {
+			local = (header >> 5) & 0x03
+			timeOffset := uint32(header & 0x1F)
+			timestamp = lastTimestamp&^0x1F + timeOffset
+			if timeOffset < lastTimestamp&0x1F {
+				timestamp += 0x20
+			}
+		}
+		definition, ok := definitions[local]
+		if !ok {
+			return nil, ErrInvalidFile
+		}
+
+		point := store.RoutePoint{Latitude: math.NaN(), Longitude: math.NaN()}
+		for _, field := range definition.fields {
+			if offset+field.size > end {
+				return nil, ErrInvalidFile
+			}
+			value := data[offset : offset+field.size]
+			offset += field.size
+
+			switch {
+			case field.num == fitFieldTimestamp && field.size == 4:
+				timestamp = definition.order.Uint32(value)
+			case definition.global != fitMessageRecord:
+			case (field.num == fitFieldPositionLat || field.num == fitFieldPositionLong) && field.size == 4:
+				raw := definition.order.Uint32(value)
+				if raw == fitInvalidSint32 {
+					continue
+				}
+				degrees := float64(int32(raw)) * 180 / (1 << 31)
+				if field.num == fitFieldPositionLat {
+					point.Latitude = degrees
+				} else {
+					point.Longitude = degrees
+				}
+			case field.num == fitFieldAltitude && field.size == 2:
+				if raw := definition.order.Uint16(value); raw != fitInvalidUint16 {
+					point.Elevation = float64(raw)/5 - 500
+				}
+			case field.num == fitFieldEnhancedAltitude && field.size == 4:
+				if raw := definition.order.Uint32(value); raw != fitInvalidUint32 {
+					point.Elevation = float64(raw)/5 - 500
+				}
+			}
+		}
+		offset += definition.devSize
+		if offset > end {
+			return nil, ErrInvalidFile
+		}
+		if timestamp != 0 {
+			lastTimestamp = timestamp
+		}
+
+		if definition.global != fitMessageRecord || timestamp == 0 ||
+			math.IsNaN(point.Latitude) || math.IsNaN(point.Longitude) {
+			continue
+		}
+		point.Time = fitEpoch.Add(time.Duration(timestamp) * time.Second)
+		activity.Route = append(activity.Route, point)
+	}
+
+	return []*Activity{activity}, nil
+}
+
+// parseFITDefinition returns definition message and its size without header.
+func parseFITDefinition(data []byte, developer bool) (*fitDefinition, int, error) {
+	if len(data) < 5 {
+		return nil, 0, ErrInvalidFile
+	}
+	definition := &fitDefinition{order: binary.LittleEndian}
+	if data[1] == 1 {
+		definition.order = binary.BigEndian
+	}
+	definition.global = definition.order.Uint16(data[2:4])
+
+	fieldsCount := int(data[4])
+	size := 5 + 3*fieldsCount
+	if len(data) < size {
+		return nil, 0, ErrInvalidFile
+	}
+	for i := 0; i < fieldsCount; i++ {
+		field := data[5+3*i:]
+		definition.fields = append(definition.fields, fitField{num: field[0], size: int(field[1])})
+	}
+
+	if developer {
+		if len(data) < size+1 {
+			return nil, 0, ErrInvalidFile
+		}
+		devFieldsCount := int(data[size])
+		size++
+		if len(data) < size+3*devFieldsCount {
+			return nil, 0, ErrInvalidFile
+		}
+		for i := 0; i < devFieldsCount; i++ {
+			definition.devSize += int(data[size+3*i+1])
+		}
+		size += 3 * devFieldsCount
+	}
+
+	return definition, size, nil
+}
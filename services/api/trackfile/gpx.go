@@ -0,0 +1,51 @@
+package trackfile
+
+import (
+	"encoding/xml"
+	"time"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+type gpxFile struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []struct {
+				Latitude  float64   `xml:"lat,attr"`
+				Longitude float64   `xml:"lon,attr"`
+				Elevation float64   `xml:"ele"`
+				Time      time.Time `xml:"time"`
+			} `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// ParseGPX returns activity for every track of the file. Points without time
+// are skipped, as duration couldn't be derived from them.
+func ParseGPX(data []byte) ([]*Activity, error) {
+	var file gpxFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	activities := make([]*Activity, 0, len(file.Tracks))
+	for _, track := range file.Tracks {
+		activity := &Activity{}
+		for _, segment := range track.Segments {
+			for _, point := range segment.Points {
+				if point.Time.IsZero() {
+					continue
+				}
+				activity.Route = append(activity.Route, store.RoutePoint{
+					Latitude:  point.Latitude,
+					Longitude: point.Longitude,
+					Elevation: point.Elevation,
+					Time:      point.Time,
+				})
+			}
+		}
+		activities = append(activities, activity)
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,53 @@
+package trackfile
+
+import (
+	"encoding/xml"
+	"time"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+type tcxFile struct {
+	Activities []struct {
+		Laps []struct {
+			Trackpoints []struct {
+				Time     time.Time `xml:"Time"`
+				Position *struct {
+					Latitude  float64 `xml:"LatitudeDegrees"`
+					Longitude float64 `xml:"LongitudeDegrees"`
+				} `xml:"Position"`
+				Altitude float64 `xml:"AltitudeMeters"`
+			} `xml:"Track>Trackpoint"`
+		} `xml:"Lap"`
+	} `xml:"Activities>Activity"`
+}
+
+// ParseTCX returns activity for every Activity of the file. Trackpoints without
+// position (e.g. treadmill or pauses) are skipped.
+func ParseTCX(data []byte) ([]*Activity, error) {
+	var file tcxFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, ErrInvalidFile
+	}
+
+	activities := make([]*Activity, 0, len(file.Activities))
+	for _, tcxActivity := range file.Activities {
+		activity := &Activity{}
+		for _, lap := range tcxActivity.Laps {
+			for _, point := range lap.Trackpoints {
+				if point.Position == nil || point.Time.IsZero() {
+					continue
+				}
+				activity.Route = append(activity.Route, store.RoutePoint{
+					Latitude:  point.Position.Latitude,
+					Longitude: point.Position.Longitude,
+					Elevation: point.Altitude,
+					Time:      point.Time,
+				})
+			}
+		}
+		activities = append(activities, activity)
+	}
+
+	return activities, nil
+}
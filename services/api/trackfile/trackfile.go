@@ -0,0 +1,80 @@
+package trackfile
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/mgo.v2/bson"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// Activity is a single run recorded by a watch or a phone.
+type Activity struct {
+	Route store.Route
+}
+
+// Parse detects format of the file and returns all activities from it.
+func Parse(data []byte) ([]*Activity, error) {
+	var activities []*Activity
+	var err error
+
+	switch {
+	case isFIT(data):
+		activities, err = ParseFIT(data)
+	case bytes.Contains(data, []byte("<gpx")):
+		activities, err = ParseGPX(data)
+	case bytes.Contains(data, []byte("<TrainingCenterDatabase")):
+		activities, err = ParseTCX(data)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*Activity, 0, len(activities))
+	for _, activity := range activities {
+		if len(activity.Route) < 2 {
+			continue
+		}
+		res = append(res, activity)
+	}
+	if len(res) == 0 {
+		return nil, ErrNoActivities
+	}
+
+	return res, nil
+}
+
+func (a *Activity) Location() store.Location {
+	return store.Location{
+		Longitude: a.Route[0].Longitude,
+		Latitude:  a.Route[0].Latitude,
+	}
+}
+
+// Validate checks that the activity could be saved as a tracking.
+func (a *Activity) Validate() error {
+	if len(a.Route) < 2 || a.Route[0].Time.IsZero() ||
+		a.Route.Duration() <= 0 || a.Route.Distance() <= 0 {
+		return ErrInvalidActivity
+	}
+
+	return nil
+}
+
+func (a *Activity) ToTracking() *store.Tracking {
+	start := a.Route[0].Time.UTC()
+	tracking := &store.Tracking{
+		Cursor:   bson.NewObjectId(),
+		ID:       uuid.New(),
+		Location: a.Location(),
+		Date:     time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC),
+		Weather:  &store.Weather{},
+	}
+	tracking.SetRoute(a.Route)
+
+	return tracking
+}
@@ -0,0 +1,193 @@
+package trackfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// stepDistance is a length of 0.01 degree of longitude on the equator.
+	stepDistance = 1111.95
+	delta        = 0.1
+)
+
+var start = time.Date(2020, 3, 2, 7, 30, 0, 0, time.UTC)
+
+const gpxFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <name>Morning run</name>
+    <trkseg>
+      <trkpt lat="0" lon="0"><ele>10</ele><time>2020-03-02T07:30:00Z</time></trkpt>
+      <trkpt lat="0" lon="0.01"><ele>11</ele><time>2020-03-02T07:35:00Z</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="0" lon="0.02"><ele>12</ele><time>2020-03-02T07:40:00Z</time></trkpt>
+      <trkpt lat="0" lon="0.03"><ele>12</ele></trkpt>
+    </trkseg>
+  </trk>
+  <trk>
+    <trkseg>
+      <trkpt lat="1" lon="1"><time>2020-03-03T07:30:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+const tcxFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2">
+  <Activities>
+    <Activity Sport="Running">
+      <Id>2020-03-02T07:30:00Z</Id>
+      <Lap StartTime="2020-03-02T07:30:00Z">
+        <Track>
+          <Trackpoint>
+            <Time>2020-03-02T07:30:00Z</Time>
+            <Position><LatitudeDegrees>0</LatitudeDegrees><LongitudeDegrees>0</LongitudeDegrees></Position>
+            <AltitudeMeters>10</AltitudeMeters>
+          </Trackpoint>
+          <Trackpoint>
+            <Time>2020-03-02T07:32:00Z</Time>
+          </Trackpoint>
+        </Track>
+      </Lap>
+      <Lap StartTime="2020-03-02T07:35:00Z">
+        <Track>
+          <Trackpoint>
+            <Time>2020-03-02T07:35:00Z</Time>
+            <Position><LatitudeDegrees>0</LatitudeDegrees><LongitudeDegrees>0.01</LongitudeDegrees></Position>
+          </Trackpoint>
+        </Track>
+      </Lap>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>`
+
+// fitFixture encodes record messages with given longitudes on the equator,
+// every 20 seconds. Every second point uses compressed timestamp header.
+func fitFixture(longitudes ...float64) []byte {
+	var records bytes.Buffer
+	// definition of local message 0: record with timestamp, position and altitude
+	records.Write([]byte{fitHeaderDefinition, 0, 0, fitMessageRecord, 0, 4,
+		fitFieldTimestamp, 4, 0x86,
+		fitFieldPositionLat, 4, 0x85,
+		fitFieldPositionLong, 4, 0x85,
+		fitFieldAltitude, 2, 0x84,
+	})
+	// definition of local message 1: record without timestamp
+	records.Write([]byte{fitHeaderDefinition | 1, 0, 0, fitMessageRecord, 0, 2,
+		fitFieldPositionLat, 4, 0x85,
+		fitFieldPositionLong, 4, 0x85,
+	})
+
+	timestamp := uint32(start.Sub(fitEpoch).Seconds())
+	for i, longitude := range longitudes {
+		timestamp += 20
+		semicircles := int32(longitude * (1 << 31) / 180)
+		if i%2 == 0 {
+			records.WriteByte(0)
+			_ = binary.Write(&records, binary.LittleEndian, timestamp)
+		} else {
+			records.WriteByte(fitHeaderCompressed | 1<<5 | byte(timestamp&0x1F))
+		}
+		_ = binary.Write(&records, binary.LittleEndian, int32(0))
+		_ = binary.Write(&records, binary.LittleEndian, semicircles)
+		if i%2 == 0 {
+			_ = binary.Write(&records, binary.LittleEndian, uint16(2550))
+		}
+	}
+
+	header := []byte{12, 0x10, 0, 0, 0, 0, 0, 0, '.', 'F', 'I', 'T'}
+	binary.LittleEndian.PutUint32(header[4:8], uint32(records.Len()))
+
+	// CRC is not checked, so it's left empty
+	return append(append(header, records.Bytes()...), 0, 0)
+}
+
+func TestParseGPX(t *testing.T) {
+	r := require.New(t)
+
+	activities, err := Parse([]byte(gpxFixture))
+	r.NoError(err)
+	r.Len(activities, 1, "track with single point should be skipped")
+
+	route := activities[0].Route
+	r.Len(route, 3)
+	r.Equal(start, route[0].Time)
+	r.Equal(10*time.Minute, route.Duration())
+	r.InDelta(2*stepDistance, route.Distance(), delta)
+	r.Equal(float64(12), route[2].Elevation)
+
+	tracking := activities[0].ToTracking()
+	r.Equal(time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC), tracking.Date)
+	r.Equal(10*time.Minute, tracking.Time)
+	r.InDelta(2*stepDistance, tracking.Distance, delta)
+	r.Equal(float64(0), tracking.Location.Longitude)
+	r.Len(tracking.Route, 3)
+}
+
+func TestParseTCX(t *testing.T) {
+	r := require.New(t)
+
+	activities, err := Parse([]byte(tcxFixture))
+	r.NoError(err)
+	r.Len(activities, 1)
+
+	route := activities[0].Route
+	r.Len(route, 2, "trackpoint without position should be skipped")
+	r.Equal(5*time.Minute, route.Duration())
+	r.InDelta(stepDistance, route.Distance(), delta)
+	r.Equal(float64(10), route[0].Elevation)
+}
+
+func TestParseFIT(t *testing.T) {
+	r := require.New(t)
+
+	activities, err := Parse(fitFixture(0, 0.01, 0.02, 0.03))
+	r.NoError(err)
+	r.Len(activities, 1)
+
+	route := activities[0].Route
+	r.Len(route, 4)
+	r.Equal(start.Add(20*time.Second), route[0].Time)
+	r.Equal(time.Minute, route.Duration())
+	r.InDelta(3*stepDistance, route.Distance(), delta)
+	r.InDelta(0.03, route[3].Longitude, 1e-6)
+	r.Equal(float64(10), route[0].Elevation)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "empty", data: nil, err: ErrUnknownFormat},
+		{name: "unknown", data: []byte("date,distance\n2020-03-02,5000"), err: ErrUnknownFormat},
+		{name: "broken gpx", data: []byte("<gpx><trk>"), err: ErrInvalidFile},
+		{name: "truncated fit", data: fitFixture(0, 0.01)[:30], err: ErrInvalidFile},
+		{name: "single point", data: fitFixture(0), err: ErrNoActivities},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.data)
+			require.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestActivityValidate(t *testing.T) {
+	r := require.New(t)
+
+	activities, err := Parse(fitFixture(0, 0.01))
+	r.NoError(err)
+	r.NoError(activities[0].Validate())
+
+	activities, err = Parse(fitFixture(0, 0))
+	r.NoError(err)
+	r.Equal(ErrInvalidActivity, activities[0].Validate(), "activity without distance")
+}
@@ -7,6 +7,10 @@ import (
 )
 
 var (
+	// dummyPassword is compared with passwords of unknown users, so sign in
+	// takes the same time for them.
+	dummyPassword = mustHash("dummy password")
+
 	emailRegexp = regexp.MustCompile(`^[A-z0-9!#$%&'*+\/=?^_` + "`" + `{|}~-]+(?:\.[A-z0-9!#$%&'*+\/=?^_` + "`" + `{|}~-]+)*@(?:[A-z0-9](?:[A-z0-9-]*[A-z0-9])?\.)+[A-z0-9](?:[A-z0-9-]*[A-z0-9])?$`)
 )
 
@@ -19,6 +23,15 @@ func hash(password string) (string, error) {
 	return string(hash), nil
 }
 
+func mustHash(password string) string {
+	hash, err := hash(password)
+	if err != nil {
+		panic(err)
+	}
+
+	return hash
+}
+
 func compare(hashedPassword, plainPassword string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(plainPassword))
 	if err != nil {
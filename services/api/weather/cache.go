@@ -0,0 +1,185 @@
+package weather
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/boodyvo/jogging-api/lib"
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	// stations are rarely added, so the nearest one is kept long
+	stationTTL = 30 * 24 * time.Hour
+	// history of the past day doesn't change
+	historyTTL = 365 * 24 * time.Hour
+
+	statsInterval = 10 * time.Minute
+)
+
+// CacheStats counts lookups answered by the cache and passed to the source.
+type CacheStats struct {
+	StationHits   int64 `json:"station_hits"`
+	StationMisses int64 `json:"station_misses"`
+	HistoryHits   int64 `json:"history_hits"`
+	HistoryMisses int64 `json:"history_misses"`
+}
+
+// CachedService caches lookups of the source provider: nearest stations by
+// coordinates rounded to 0.01 degree (about a kilometer) and daily history by
+// station and date. Values are kept in the LRU and, if the storage is set, in the
+// storage, so they outlive restarts and are shared by instances.
+type CachedService struct {
+	source StationService
+	lru    *lru
+	store  store.Storage
+	logger *log.Logger
+
+	stats CacheStats
+}
+
+// NewCachedService creates cache of up to size values in memory. Storage is
+// optional.
+func NewCachedService(source StationService, size int, storage store.Storage, logger *log.Logger) *CachedService {
+	return &CachedService{
+		source: source,
+		lru:    newLRU(size),
+		store:  storage,
+		logger: logger,
+	}
+}
+
+func (s *CachedService) Name() string {
+	return s.source.Name()
+}
+
+func (s *CachedService) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	station, err := s.GetNearestStationID(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetDailyWeather(station, time)
+}
+
+func (s *CachedService) GetNearestStationID(location store.Location) (string, error) {
+	key := fmt.Sprintf("station:%s:%.2f:%.2f", s.Name(), location.Latitude, location.Longitude)
+	if entry, ok := s.get(key); ok {
+		atomic.AddInt64(&s.stats.StationHits, 1)
+
+		return entry.StationID, nil
+	}
+	atomic.AddInt64(&s.stats.StationMisses, 1)
+
+	station, err := s.source.GetNearestStationID(location)
+	if err != nil {
+		return "", err
+	}
+	s.set(&store.WeatherCacheEntry{
+		Key:       key,
+		StationID: station,
+		ExpiresAt: time.Now().Add(stationTTL),
+	})
+
+	return station, nil
+}
+
+func (s *CachedService) GetDailyWeather(station string, date time.Time) (*store.Weather, error) {
+	day := date.UTC().Format(lib.DateFormat)
+	key := fmt.Sprintf("history:%s:%s:%s", s.Name(), station, day)
+	if entry, ok := s.get(key); ok && entry.Weather != nil {
+		atomic.AddInt64(&s.stats.HistoryHits, 1)
+		weather := *entry.Weather
+
+		return &weather, nil
+	}
+	atomic.AddInt64(&s.stats.HistoryMisses, 1)
+
+	weather, err := s.source.GetDailyWeather(station, date)
+	if err != nil {
+		return nil, err
+	}
+	// history of the current day isn't complete yet
+	if day < time.Now().UTC().Format(lib.DateFormat) {
+		cached := *weather
+		s.set(&store.WeatherCacheEntry{
+			Key:       key,
+			Weather:   &cached,
+			ExpiresAt: time.Now().Add(historyTTL),
+		})
+	}
+
+	return weather, nil
+}
+
+// Stats returns counters of lookups since the start.
+func (s *CachedService) Stats() CacheStats {
+	return CacheStats{
+		StationHits:   atomic.LoadInt64(&s.stats.StationHits),
+		StationMisses: atomic.LoadInt64(&s.stats.StationMisses),
+		HistoryHits:   atomic.LoadInt64(&s.stats.HistoryHits),
+		HistoryMisses: atomic.LoadInt64(&s.stats.HistoryMisses),
+	}
+}
+
+// LogStats logs stats periodically until quit is closed.
+func (s *CachedService) LogStats(quit <-chan struct{}) {
+	ticker := time.NewTicker(statsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.logger.
+				WithField("provider", s.Name()).
+				WithField("stats", s.Stats()).
+				WithField("size", s.lru.Len()).
+				Info("weather cache stats")
+		case <-quit:
+			return
+		}
+	}
+}
+
+func (s *CachedService) get(key string) (*store.WeatherCacheEntry, bool) {
+	now := time.Now()
+	if value, ok := s.lru.Get(key, now); ok {
+		return value.(*store.WeatherCacheEntry), true
+	}
+	if s.store == nil {
+		return nil, false
+	}
+
+	entry, err := s.store.GetWeatherCacheEntry(key)
+	if err != nil {
+		if err != store.ErrNotFound {
+			s.logger.
+				WithField("err", err).
+				WithField("key", key).
+				Error("cannot get weather cache entry")
+		}
+
+		return nil, false
+	}
+	s.lru.Add(key, entry, entry.ExpiresAt)
+
+	return entry, true
+}
+
+// set caches the entry. Failures are only logged, the value is looked up
+// again next time.
+func (s *CachedService) set(entry *store.WeatherCacheEntry) {
+	s.lru.Add(entry.Key, entry, entry.ExpiresAt)
+	if s.store == nil {
+		return
+	}
+	if err := s.store.SaveWeatherCacheEntry(entry); err != nil {
+		s.logger.
+			WithField("err", err).
+			WithField("key", entry.Key).
+			Error("cannot save weather cache entry")
+	}
+}
@@ -0,0 +1,138 @@
+package weather
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/memory"
+)
+
+type fakeStationService struct {
+	stationLookups int
+	historyLookups int
+}
+
+func (s *fakeStationService) GetWeather(date time.Time, location store.Location) (*store.Weather, error) {
+	station, err := s.GetNearestStationID(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetDailyWeather(station, date)
+}
+
+func (s *fakeStationService) Name() string {
+	return "fake"
+}
+
+func (s *fakeStationService) GetNearestStationID(location store.Location) (string, error) {
+	s.stationLookups++
+	if location.Latitude > 80 {
+		return "", ErrCannotGetWeather
+	}
+	if location.Longitude < 30 {
+		return "west", nil
+	}
+
+	return "east", nil
+}
+
+func (s *fakeStationService) GetDailyWeather(station string, date time.Time) (*store.Weather, error) {
+	s.historyLookups++
+	if date.Year() < 2000 {
+		return nil, errors.New("no history")
+	}
+
+	return &store.Weather{Temperature: float32(date.Day())}, nil
+}
+
+func TestLRU(t *testing.T) {
+	r := require.New(t)
+	cache := newLRU(2)
+	now := time.Now()
+
+	cache.Add("first", 1, now.Add(time.Hour))
+	cache.Add("second", 2, now.Add(time.Hour))
+	_, ok := cache.Get("first", now)
+	r.True(ok)
+	cache.Add("third", 3, now.Add(time.Hour))
+	_, ok = cache.Get("second", now)
+	r.False(ok, "least recently used value is kept")
+	value, ok := cache.Get("first", now)
+	r.True(ok, "recently used value is evicted")
+	r.Equal(1, value)
+	r.Equal(2, cache.Len())
+
+	_, ok = cache.Get("third", now.Add(2*time.Hour))
+	r.False(ok, "expired value is returned")
+	r.Equal(1, cache.Len())
+}
+
+func TestCachedService(t *testing.T) {
+	r := require.New(t)
+	source := &fakeStationService{}
+	service := NewCachedService(source, 100, nil, log.New())
+	date := time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC)
+
+	weather, err := service.GetWeather(date, store.Location{Latitude: 50.1234, Longitude: 20.1234})
+	r.NoError(err)
+	r.Equal(float32(20), weather.Temperature)
+	weather.Temperature = 100
+
+	// the same route a bit later the same day
+	weather, err = service.GetWeather(date.Add(time.Hour), store.Location{Latitude: 50.1201, Longitude: 20.1249})
+	r.NoError(err)
+	r.Equal(float32(20), weather.Temperature, "cached weather is changed by the caller")
+	r.Equal(1, source.stationLookups)
+	r.Equal(1, source.historyLookups)
+
+	_, err = service.GetWeather(date.AddDate(0, 0, 1), store.Location{Latitude: 50.1234, Longitude: 20.1234})
+	r.NoError(err)
+	_, err = service.GetWeather(date, store.Location{Latitude: 50.2, Longitude: 20.1234})
+	r.NoError(err)
+	r.Equal(2, source.stationLookups)
+	r.Equal(2, source.historyLookups, "history of the same station is looked up again")
+
+	_, err = service.GetWeather(time.Now(), store.Location{Latitude: 50.1234, Longitude: 20.1234})
+	r.NoError(err)
+	_, err = service.GetWeather(time.Now(), store.Location{Latitude: 50.1234, Longitude: 20.1234})
+	r.NoError(err)
+	r.Equal(4, source.historyLookups, "incomplete history of today is cached")
+
+	_, err = service.GetWeather(date, store.Location{Latitude: 85, Longitude: 20})
+	r.Equal(ErrCannotGetWeather, err)
+	_, err = service.GetWeather(date, store.Location{Latitude: 85, Longitude: 20})
+	r.Equal(ErrCannotGetWeather, err)
+	r.Equal(4, source.stationLookups, "failed lookup is cached")
+
+	r.Equal(CacheStats{StationHits: 4, StationMisses: 4, HistoryHits: 2, HistoryMisses: 4}, service.Stats())
+}
+
+func TestCachedServicePersistent(t *testing.T) {
+	r := require.New(t)
+	storage := memory.New()
+	source := &fakeStationService{}
+	date := time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC)
+	location := store.Location{Latitude: 50, Longitude: 40}
+
+	_, err := NewCachedService(source, 100, storage, log.New()).GetWeather(date, location)
+	r.NoError(err)
+
+	// another instance or the restarted one
+	service := NewCachedService(source, 100, storage, log.New())
+	weather, err := service.GetWeather(date, location)
+	r.NoError(err)
+	r.Equal(float32(20), weather.Temperature)
+	r.Equal(1, source.stationLookups)
+	r.Equal(1, source.historyLookups)
+	r.Equal(CacheStats{StationHits: 1, HistoryHits: 1}, service.Stats())
+
+	entry, err := storage.GetWeatherCacheEntry("station:fake:50.00:40.00")
+	r.NoError(err)
+	r.Equal("east", entry.StationID)
+}
@@ -0,0 +1,38 @@
+package weather
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+// Chain looks up weather by providers in order, until one of them succeeds.
+type Chain struct {
+	providers []StationService
+	logger    *log.Logger
+}
+
+func NewChain(logger *log.Logger, providers ...StationService) *Chain {
+	return &Chain{
+		providers: providers,
+		logger:    logger,
+	}
+}
+
+func (c *Chain) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	for _, provider := range c.providers {
+		weather, err := provider.GetWeather(time, location)
+		if err == nil {
+			return weather, nil
+		}
+		c.logger.
+			WithField("err", err).
+			WithField("provider", provider.Name()).
+			WithField("location", location).
+			Info("cannot get weather from provider")
+	}
+
+	return nil, ErrCannotGetWeather
+}
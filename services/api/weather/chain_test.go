@@ -0,0 +1,74 @@
+package weather
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+type fakeProvider struct {
+	name    string
+	weather *store.Weather
+	lookups int
+}
+
+func (p *fakeProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeProvider) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	p.lookups++
+	if p.weather == nil {
+		return nil, errors.New("provider is down")
+	}
+
+	return p.weather, nil
+}
+
+func (p *fakeProvider) GetNearestStationID(location store.Location) (string, error) {
+	return locationStation(location), nil
+}
+
+func (p *fakeProvider) GetDailyWeather(station string, date time.Time) (*store.Weather, error) {
+	return p.GetWeather(date, store.Location{})
+}
+
+func TestChain(t *testing.T) {
+	r := require.New(t)
+	down := &fakeProvider{name: "down"}
+	first := &fakeProvider{name: "first", weather: &store.Weather{Temperature: 1}}
+	second := &fakeProvider{name: "second", weather: &store.Weather{Temperature: 2}}
+	location := store.Location{Latitude: 50, Longitude: 8}
+
+	weather, err := NewChain(log.New(), down, first, second).GetWeather(time.Now(), location)
+	r.NoError(err)
+	r.Equal(float32(1), weather.Temperature)
+	r.Equal(1, down.lookups)
+	r.Equal(1, first.lookups)
+	r.Equal(0, second.lookups, "provider is used after successful lookup")
+
+	_, err = NewChain(log.New(), down).GetWeather(time.Now(), location)
+	r.Equal(ErrCannotGetWeather, err)
+
+	_, err = NewChain(log.New()).GetWeather(time.Now(), location)
+	r.Equal(ErrCannotGetWeather, err)
+}
+
+func TestNewProviders(t *testing.T) {
+	r := require.New(t)
+	keys := map[string]string{MeteostatProvider: "key"}
+
+	providers, err := NewProviders([]string{OpenMeteoProvider, OpenWeatherMapProvider, " meteostat"}, keys, log.New())
+	r.NoError(err)
+	r.Len(providers, 2, "provider without key isn't skipped")
+	r.Equal(OpenMeteoProvider, providers[0].Name())
+	r.Equal(MeteostatProvider, providers[1].Name())
+
+	_, err = NewProviders([]string{"unknown"}, keys, log.New())
+	r.Error(err)
+}
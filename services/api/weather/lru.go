@@ -0,0 +1,78 @@
+package weather
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type lruItem struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// lru keeps up to size recently used values, the least recently used one is
+// evicted first.
+type lru struct {
+	mu    sync.Mutex
+	size  int
+	items map[string]*list.Element
+	order *list.List
+}
+
+func newLRU(size int) *lru {
+	return &lru{
+		size:  size,
+		items: make(map[string]*list.Element, size),
+		order: list.New(),
+	}
+}
+
+func (c *lru) Get(key string, now time.Time) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	item := elem.Value.(*lruItem)
+	if !item.expiresAt.After(now) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+
+	return item.value, true
+}
+
+func (c *lru) Add(key string, value interface{}, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.size <= 0 {
+		return
+	}
+	if elem, ok := c.items[key]; ok {
+		elem.Value = &lruItem{key: key, value: value, expiresAt: expiresAt}
+		c.order.MoveToFront(elem)
+
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruItem{key: key, value: value, expiresAt: expiresAt})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (c *lru) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
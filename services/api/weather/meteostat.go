@@ -0,0 +1,92 @@
+package weather
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/boodyvo/jogging-api/lib"
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const meteostatURL = "https://api.meteostat.net/v1"
+
+// Meteostat looks up daily history of the nearest station. Its units are
+// the units of store.Weather, so values are kept as they are.
+type Meteostat struct {
+	apiKey  string
+	baseURL string
+	logger  *log.Logger
+	client  *http.Client
+}
+
+func NewMeteostat(apiKey string, logger *log.Logger) *Meteostat {
+	return &Meteostat{
+		apiKey:  apiKey,
+		baseURL: meteostatURL,
+		logger:  logger,
+		client:  newClient(),
+	}
+}
+
+func (s *Meteostat) Name() string {
+	return MeteostatProvider
+}
+
+func (s *Meteostat) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	station, err := s.GetNearestStationID(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetDailyWeather(station, time)
+}
+
+func (s *Meteostat) GetNearestStationID(location store.Location) (string, error) {
+	query := url.Values{
+		"lat":   {formatCoordinate(location.Latitude)},
+		"lon":   {formatCoordinate(location.Longitude)},
+		"limit": {"1"},
+		"key":   {s.apiKey},
+	}
+	var result StationResponse
+	if err := getJSON(s.client, s.baseURL+"/stations/nearby?"+query.Encode(), &result); err != nil {
+		return "", err
+	}
+
+	if len(result.Data) == 0 {
+		return "", ErrCannotGetWeather
+	}
+	return result.Data[0].ID, nil
+}
+
+func (s *Meteostat) GetDailyWeather(station string, time time.Time) (*store.Weather, error) {
+	day := time.UTC().Format(lib.DateFormat)
+	query := url.Values{
+		"station": {station},
+		"start":   {day},
+		"end":     {day},
+		"key":     {s.apiKey},
+	}
+	var result Response
+	if err := getJSON(s.client, s.baseURL+"/history/daily?"+query.Encode(), &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Data) == 0 || result.Data[0].Temperature == nil {
+		return nil, ErrCannotGetWeather
+	}
+	data := result.Data[0]
+
+	return &store.Weather{
+		Temperature:    value(data.Temperature),
+		TemperatureMin: value(data.TemperatureMin),
+		TemperatureMax: value(data.TemperatureMax),
+		Snowdepth:      value(data.Snowdepth),
+		Winddirection:  value(data.Winddirection),
+		Windspeed:      value(data.Windspeed),
+		Pressure:       value(data.Pressure),
+	}, nil
+}
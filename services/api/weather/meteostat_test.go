@@ -0,0 +1,70 @@
+package weather
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	meteostatStationsFixture = `{"meta":{},"data":[{"id":"10637","name":"Frankfurt Airport","active":true,"distance":"4.2"}]}`
+	meteostatHistoryFixture  = `{"meta":{"source":"DWD"},"data":[{"date":"2020-03-23","temperature":5.1,"temperature_min":-1.8,"temperature_max":12.4,"precipitation":0,"snowfall":null,"snowdepth":null,"winddirection":68,"windspeed":18.4,"peakgust":46.4,"sunshine":654,"pressure":1035.2}]}`
+)
+
+func newMeteostatServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "key", query.Get("key"))
+		switch r.URL.Path {
+		case "/stations/nearby":
+			require.Equal(t, "50.050000", query.Get("lat"))
+			require.Equal(t, "8.600000", query.Get("lon"))
+			w.Write([]byte(meteostatStationsFixture))
+		case "/history/daily":
+			require.Equal(t, "2020-03-23", query.Get("start"))
+			require.Equal(t, "2020-03-23", query.Get("end"))
+			if query.Get("station") != "10637" {
+				w.Write([]byte(`{"meta":{},"data":[]}`))
+
+				return
+			}
+			w.Write([]byte(meteostatHistoryFixture))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestMeteostat(t *testing.T) {
+	r := require.New(t)
+	server := newMeteostatServer(t)
+	defer server.Close()
+
+	service := NewMeteostat("key", log.New())
+	service.baseURL = server.URL
+	date := time.Date(2020, 3, 23, 18, 30, 0, 0, time.UTC)
+
+	weather, err := service.GetWeather(date, store.Location{Latitude: 50.05, Longitude: 8.6})
+	r.NoError(err)
+	r.Equal(&store.Weather{
+		Temperature:    5.1,
+		TemperatureMin: -1.8,
+		TemperatureMax: 12.4,
+		Winddirection:  68,
+		Windspeed:      18.4,
+		Pressure:       1035.2,
+	}, weather)
+
+	_, err = service.GetDailyWeather("10638", date)
+	r.Equal(ErrCannotGetWeather, err, "weather of station without history")
+
+	service.baseURL = server.URL + "/unknown"
+	_, err = service.GetWeather(date, store.Location{Latitude: 50.05, Longitude: 8.6})
+	r.Error(err, "response with error status")
+}
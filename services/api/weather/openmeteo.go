@@ -0,0 +1,83 @@
+package weather
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/boodyvo/jogging-api/lib"
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const openMeteoURL = "https://archive-api.open-meteo.com/v1"
+
+// OpenMeteo looks up archive of the day by coordinates. It doesn't need a
+// key. Daily values are taken as they are, pressure, wind speed and snow
+// depth are averages of hourly values.
+type OpenMeteo struct {
+	baseURL string
+	logger  *log.Logger
+	client  *http.Client
+}
+
+func NewOpenMeteo(logger *log.Logger) *OpenMeteo {
+	return &OpenMeteo{
+		baseURL: openMeteoURL,
+		logger:  logger,
+		client:  newClient(),
+	}
+}
+
+func (s *OpenMeteo) Name() string {
+	return OpenMeteoProvider
+}
+
+func (s *OpenMeteo) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	return s.GetDailyWeather(locationStation(location), time)
+}
+
+func (s *OpenMeteo) GetNearestStationID(location store.Location) (string, error) {
+	return locationStation(location), nil
+}
+
+func (s *OpenMeteo) GetDailyWeather(station string, date time.Time) (*store.Weather, error) {
+	location, err := parseLocationStation(station)
+	if err != nil {
+		return nil, err
+	}
+	day := date.UTC().Format(lib.DateFormat)
+	query := url.Values{
+		"latitude":        {formatCoordinate(location.Latitude)},
+		"longitude":       {formatCoordinate(location.Longitude)},
+		"start_date":      {day},
+		"end_date":        {day},
+		"daily":           {"temperature_2m_mean,temperature_2m_min,temperature_2m_max,wind_direction_10m_dominant"},
+		"hourly":          {"pressure_msl,snow_depth,wind_speed_10m"},
+		"wind_speed_unit": {"kmh"},
+		"timezone":        {"UTC"},
+	}
+	var result OpenMeteoResponse
+	if err := getJSON(s.client, s.baseURL+"/archive?"+query.Encode(), &result); err != nil {
+		return nil, err
+	}
+
+	daily := result.Daily
+	if len(daily.Time) == 0 || at(daily.Temperature, 0) == nil {
+		return nil, ErrCannotGetWeather
+	}
+	weather := &store.Weather{
+		Temperature:    value(at(daily.Temperature, 0)),
+		TemperatureMin: value(at(daily.TemperatureMin, 0)),
+		TemperatureMax: value(at(daily.TemperatureMax, 0)),
+		Winddirection:  value(at(daily.WindDirection, 0)),
+		Windspeed:      value(average(result.Hourly.WindSpeed)),
+		Pressure:       value(average(result.Hourly.Pressure)),
+	}
+	if snowDepth := average(result.Hourly.SnowDepth); snowDepth != nil {
+		weather.Snowdepth = float32(metersToCentimeters(*snowDepth))
+	}
+
+	return weather, nil
+}
@@ -0,0 +1,69 @@
+package weather
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const openMeteoFixture = `{
+	"latitude": 50.04,
+	"longitude": 8.59,
+	"timezone": "UTC",
+	"daily_units": {"temperature_2m_mean": "°C", "wind_direction_10m_dominant": "°"},
+	"daily": {
+		"time": ["2020-03-23"],
+		"temperature_2m_mean": [5.3],
+		"temperature_2m_min": [-1.5],
+		"temperature_2m_max": [12.1],
+		"wind_direction_10m_dominant": [71]
+	},
+	"hourly_units": {"pressure_msl": "hPa", "snow_depth": "m", "wind_speed_10m": "km/h"},
+	"hourly": {
+		"time": ["2020-03-23T00:00", "2020-03-23T06:00", "2020-03-23T12:00", "2020-03-23T18:00"],
+		"pressure_msl": [1036, 1035, 1034, null],
+		"snow_depth": [0.04, 0.03, 0.01, 0.02],
+		"wind_speed_10m": [10, 20, 30, 20]
+	}
+}`
+
+func TestOpenMeteo(t *testing.T) {
+	r := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "/archive", r.URL.Path)
+		require.Equal(t, "50.050000", query.Get("latitude"))
+		require.Equal(t, "8.600000", query.Get("longitude"))
+		require.Equal(t, query.Get("start_date"), query.Get("end_date"))
+		if query.Get("start_date") != "2020-03-23" {
+			w.Write([]byte(`{"daily":{"time":["2030-03-23"],"temperature_2m_mean":[null]},"hourly":{"time":[]}}`))
+
+			return
+		}
+		w.Write([]byte(openMeteoFixture))
+	}))
+	defer server.Close()
+
+	service := NewOpenMeteo(log.New())
+	service.baseURL = server.URL
+	location := store.Location{Latitude: 50.0512, Longitude: 8.6021}
+
+	weather, err := service.GetWeather(time.Date(2020, 3, 23, 7, 0, 0, 0, time.UTC), location)
+	r.NoError(err)
+	r.InDelta(5.3, weather.Temperature, 0.001)
+	r.InDelta(-1.5, weather.TemperatureMin, 0.001)
+	r.InDelta(12.1, weather.TemperatureMax, 0.001)
+	r.InDelta(71, weather.Winddirection, 0.001)
+	r.InDelta(20, weather.Windspeed, 0.001)
+	r.InDelta(1035, weather.Pressure, 0.001, "missing hourly values are averaged")
+	r.InDelta(2.5, weather.Snowdepth, 0.001, "snow depth isn't in centimeters")
+
+	_, err = service.GetWeather(time.Date(2030, 3, 23, 7, 0, 0, 0, time.UTC), location)
+	r.Equal(ErrCannotGetWeather, err, "weather of day without archive")
+}
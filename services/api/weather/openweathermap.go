@@ -0,0 +1,93 @@
+package weather
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const openWeatherMapURL = "https://api.openweathermap.org/data/2.5"
+
+// OpenWeatherMap looks up hourly history of the day by coordinates and
+// aggregates it. It doesn't report snow depth, so it's left zero.
+type OpenWeatherMap struct {
+	apiKey  string
+	baseURL string
+	logger  *log.Logger
+	client  *http.Client
+}
+
+func NewOpenWeatherMap(apiKey string, logger *log.Logger) *OpenWeatherMap {
+	return &OpenWeatherMap{
+		apiKey:  apiKey,
+		baseURL: openWeatherMapURL,
+		logger:  logger,
+		client:  newClient(),
+	}
+}
+
+func (s *OpenWeatherMap) Name() string {
+	return OpenWeatherMapProvider
+}
+
+func (s *OpenWeatherMap) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	return s.GetDailyWeather(locationStation(location), time)
+}
+
+func (s *OpenWeatherMap) GetNearestStationID(location store.Location) (string, error) {
+	return locationStation(location), nil
+}
+
+func (s *OpenWeatherMap) GetDailyWeather(station string, date time.Time) (*store.Weather, error) {
+	location, err := parseLocationStation(station)
+	if err != nil {
+		return nil, err
+	}
+	// history is returned for the whole day of the time
+	day := date.UTC().Truncate(24 * time.Hour).Add(12 * time.Hour)
+	query := url.Values{
+		"lat":   {formatCoordinate(location.Latitude)},
+		"lon":   {formatCoordinate(location.Longitude)},
+		"dt":    {strconv.FormatInt(day.Unix(), 10)},
+		"appid": {s.apiKey},
+	}
+	var result OpenWeatherMapResponse
+	if err := getJSON(s.client, s.baseURL+"/onecall/timemachine?"+query.Encode(), &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Hourly) == 0 {
+		return nil, ErrCannotGetWeather
+	}
+	first := result.Hourly[0]
+	minTemp, maxTemp := first.Temp, first.Temp
+	var temp, pressure, windSpeed float64
+	windDirections := make([]float64, 0, len(result.Hourly))
+	for _, hour := range result.Hourly {
+		temp += hour.Temp
+		if hour.Temp < minTemp {
+			minTemp = hour.Temp
+		}
+		if hour.Temp > maxTemp {
+			maxTemp = hour.Temp
+		}
+		pressure += hour.Pressure
+		windSpeed += hour.WindSpeed
+		windDirections = append(windDirections, hour.WindDeg)
+	}
+	hours := float64(len(result.Hourly))
+
+	return &store.Weather{
+		Temperature:    float32(kelvinToCelsius(temp / hours)),
+		TemperatureMin: float32(kelvinToCelsius(minTemp)),
+		TemperatureMax: float32(kelvinToCelsius(maxTemp)),
+		Winddirection:  float32(averageDirection(windDirections)),
+		Windspeed:      float32(metersPerSecondToKmh(windSpeed / hours)),
+		Pressure:       float32(pressure / hours),
+	}, nil
+}
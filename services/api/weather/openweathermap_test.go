@@ -0,0 +1,68 @@
+package weather
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const openWeatherMapFixture = `{
+	"lat": 50.05,
+	"lon": 8.6,
+	"timezone": "Europe/Berlin",
+	"current": {"dt": 1584964800, "temp": 283.15, "pressure": 1030, "wind_speed": 5, "wind_deg": 90},
+	"hourly": [
+		{"dt": 1584921600, "temp": 271.15, "pressure": 1034, "wind_speed": 2, "wind_deg": 350},
+		{"dt": 1584943200, "temp": 278.15, "pressure": 1032, "wind_speed": 4, "wind_deg": 10},
+		{"dt": 1584964800, "temp": 286.15, "pressure": 1030, "wind_speed": 6, "wind_deg": 20},
+		{"dt": 1584986400, "temp": 279.15, "pressure": 1028, "wind_speed": 8, "wind_deg": 340, "snow": {"1h": 0.1}}
+	]
+}`
+
+func TestOpenWeatherMap(t *testing.T) {
+	r := require.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		require.Equal(t, "/onecall/timemachine", r.URL.Path)
+		require.Equal(t, "key", query.Get("appid"))
+		require.Equal(t, "50.050000", query.Get("lat"))
+		require.Equal(t, "8.600000", query.Get("lon"))
+		if query.Get("dt") != "1584964800" {
+			w.Write([]byte(`{"hourly":[]}`))
+
+			return
+		}
+		w.Write([]byte(openWeatherMapFixture))
+	}))
+	defer server.Close()
+
+	service := NewOpenWeatherMap("key", log.New())
+	service.baseURL = server.URL
+	location := store.Location{Latitude: 50.0512, Longitude: 8.6021}
+
+	station, err := service.GetNearestStationID(location)
+	r.NoError(err)
+	r.Equal("50.05,8.60", station)
+
+	weather, err := service.GetWeather(time.Date(2020, 3, 23, 7, 0, 0, 0, time.UTC), location)
+	r.NoError(err)
+	r.InDelta(5.5, weather.Temperature, 0.001, "temperature isn't average in Celsius")
+	r.InDelta(-2, weather.TemperatureMin, 0.001)
+	r.InDelta(13, weather.TemperatureMax, 0.001)
+	r.InDelta(18, weather.Windspeed, 0.001, "wind speed isn't average in km/h")
+	r.InDelta(1031, weather.Pressure, 0.001)
+	r.InDelta(0, weather.Snowdepth, 0.001)
+	r.True(weather.Winddirection < 10 || weather.Winddirection > 350, "wind direction %f isn't around north", weather.Winddirection)
+
+	_, err = service.GetWeather(time.Date(2020, 3, 24, 7, 0, 0, 0, time.UTC), location)
+	r.Equal(ErrCannotGetWeather, err, "weather of day without history")
+
+	_, err = service.GetDailyWeather("station", time.Now())
+	r.Error(err, "weather of invalid station")
+}
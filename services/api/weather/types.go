@@ -1,7 +1,5 @@
 package weather
 
-import store "github.com/boodyvo/jogging-api/services/api/storage"
-
 type Station struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -11,6 +9,55 @@ type StationResponse struct {
 	Data []Station `json:"data"`
 }
 
+// MeteostatDay is the daily history of meteostat. Missing values are null.
+type MeteostatDay struct {
+	Date           string   `json:"date"`
+	Temperature    *float64 `json:"temperature"`
+	TemperatureMin *float64 `json:"temperature_min"`
+	TemperatureMax *float64 `json:"temperature_max"`
+	Snowdepth      *float64 `json:"snowdepth"`
+	Winddirection  *float64 `json:"winddirection"`
+	Windspeed      *float64 `json:"windspeed"`
+	Pressure       *float64 `json:"pressure"`
+}
+
 type Response struct {
-	Data []store.Weather `json:"data"`
+	Data []MeteostatDay `json:"data"`
+}
+
+// OpenWeatherMapHour is the hourly history of openweathermap in standard
+// units: temperature in kelvins and wind speed in m/s.
+type OpenWeatherMapHour struct {
+	Time      int64   `json:"dt"`
+	Temp      float64 `json:"temp"`
+	Pressure  float64 `json:"pressure"`
+	WindSpeed float64 `json:"wind_speed"`
+	WindDeg   float64 `json:"wind_deg"`
+}
+
+type OpenWeatherMapResponse struct {
+	Hourly []OpenWeatherMapHour `json:"hourly"`
+}
+
+// OpenMeteoDaily holds values of days by variables. Missing values are null.
+type OpenMeteoDaily struct {
+	Time           []string   `json:"time"`
+	Temperature    []*float64 `json:"temperature_2m_mean"`
+	TemperatureMin []*float64 `json:"temperature_2m_min"`
+	TemperatureMax []*float64 `json:"temperature_2m_max"`
+	WindDirection  []*float64 `json:"wind_direction_10m_dominant"`
+}
+
+// OpenMeteoHourly holds values of hours by variables, snow depth is in
+// meters.
+type OpenMeteoHourly struct {
+	Time      []string   `json:"time"`
+	Pressure  []*float64 `json:"pressure_msl"`
+	SnowDepth []*float64 `json:"snow_depth"`
+	WindSpeed []*float64 `json:"wind_speed_10m"`
+}
+
+type OpenMeteoResponse struct {
+	Daily  OpenMeteoDaily  `json:"daily"`
+	Hourly OpenMeteoHourly `json:"hourly"`
 }
@@ -0,0 +1,73 @@
+package weather
+
+import "math"
+
+const absoluteZero = -273.15
+
+func kelvinToCelsius(kelvin float64) float64 {
+	return kelvin + absoluteZero
+}
+
+func metersPerSecondToKmh(speed float64) float64 {
+	return speed * 3.6
+}
+
+func metersToCentimeters(length float64) float64 {
+	return length * 100
+}
+
+// value is zero for missing values.
+func value(v *float64) float32 {
+	if v == nil {
+		return 0
+	}
+
+	return float32(*v)
+}
+
+// at returns the value of the day or hour i, nil if it's missing.
+func at(values []*float64, i int) *float64 {
+	if i >= len(values) {
+		return nil
+	}
+
+	return values[i]
+}
+
+// average of values which are set, nil if there are none.
+func average(values []*float64) *float64 {
+	var sum float64
+	var count int
+	for _, v := range values {
+		if v != nil {
+			sum += *v
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	result := sum / float64(count)
+
+	return &result
+}
+
+// averageDirection is the mean of angles in degrees, so the mean of 350
+// and 10 is 0, not 180.
+func averageDirection(degrees []float64) float64 {
+	if len(degrees) == 0 {
+		return 0
+	}
+	var x, y float64
+	for _, d := range degrees {
+		radians := d * math.Pi / 180
+		x += math.Cos(radians)
+		y += math.Sin(radians)
+	}
+	direction := math.Atan2(y, x) * 180 / math.Pi
+	if direction < 0 {
+		direction += 360
+	}
+
+	return direction
+}
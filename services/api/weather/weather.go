@@ -3,115 +3,114 @@ package weather
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/boodyvo/jogging-api/lib"
-
 	log "github.com/sirupsen/logrus"
 
 	store "github.com/boodyvo/jogging-api/services/api/storage"
 )
 
+const (
+	MeteostatProvider      = "meteostat"
+	OpenWeatherMapProvider = "openweathermap"
+	OpenMeteoProvider      = "open-meteo"
+
+	requestTimeout = 10 * time.Second
+)
+
 type Service interface {
 	GetWeather(time time.Time, location store.Location) (*store.Weather, error)
 }
 
-type OpenWeatherMapService struct {
-	apiKey string
-	logger *log.Logger
-	client *http.Client
+// StationService looks up weather in two steps, so every step could be
+// cached apart. Providers without stations use the location rounded to 0.01
+// degree as the station.
+type StationService interface {
+	Service
+	// Name of the provider, it separates cached values of providers
+	Name() string
+	GetNearestStationID(location store.Location) (string, error)
+	GetDailyWeather(stationID string, date time.Time) (*store.Weather, error)
 }
 
-func NewService(apiKey string, logger *log.Logger) Service {
-	return &OpenWeatherMapService{
-		apiKey: apiKey,
-		logger: logger,
-		client: &http.Client{},
+// NewProviders creates providers by names in the given order. Keys are API
+// keys by provider names, providers which need the key are skipped without
+// it.
+func NewProviders(names []string, keys map[string]string, logger *log.Logger) ([]StationService, error) {
+	providers := make([]StationService, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		var provider StationService
+		switch name {
+		case MeteostatProvider:
+			if keys[name] != "" {
+				provider = NewMeteostat(keys[name], logger)
+			}
+		case OpenWeatherMapProvider:
+			if keys[name] != "" {
+				provider = NewOpenWeatherMap(keys[name], logger)
+			}
+		case OpenMeteoProvider:
+			provider = NewOpenMeteo(logger)
+		default:
+			return nil, fmt.Errorf("unknown weather provider %q", name)
+		}
+		if provider == nil {
+			logger.WithField("provider", name).Warn("weather provider is skipped without key")
+
+			continue
+		}
+		providers = append(providers, provider)
 	}
-}
 
-func (s *OpenWeatherMapService) GetNearestStationID(location store.Location) (string, error) {
-	URL := "https://api.meteostat.net/v1/stations/nearby?lat=%f&lon=%f&limit=1&key=%s"
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			URL,
-			location.Latitude,
-			location.Longitude,
-			s.apiKey,
-		),
-		nil,
-	)
-	if err != nil {
-		return "", err
-	}
+	return providers, nil
+}
 
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return "", err
-	}
+func newClient() *http.Client {
+	return &http.Client{Timeout: requestTimeout}
+}
 
-	body, err := ioutil.ReadAll(resp.Body)
+// getJSON requests URL and decodes the response to the result.
+func getJSON(client *http.Client, URL string, result interface{}) error {
+	resp, err := client.Get(URL)
 	if err != nil {
-		return "", err
+		return err
 	}
+	defer resp.Body.Close()
 
-	var result StationResponse
-
-	if err := json.Unmarshal(body, &result); err != nil {
-		return "", err
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status of weather provider: %s", resp.Status)
 	}
 
-	if len(result.Data) == 0 {
-		return "", ErrCannotGetWeather
-	}
-	return result.Data[0].ID, nil
+	return json.NewDecoder(resp.Body).Decode(result)
 }
 
-// TODO(boodyvo): Get historical data, not current, because it's need paid subscription https://openweathermap.org/price
-func (s *OpenWeatherMapService) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
-	// baseURL https://api.meteostat.net/v1/history/daily?station=48694&start=2020-03-23&end=2020-03-24&key=xSvXgS3B
-	station, err := s.GetNearestStationID(location)
-	if err != nil {
-		return nil, err
-	}
+// locationStation is the station of providers looking up weather by
+// coordinates.
+func locationStation(location store.Location) string {
+	return fmt.Sprintf("%.2f,%.2f", location.Latitude, location.Longitude)
+}
 
-	URL := "https://api.meteostat.net/v1/history/daily?station=%s&start=%s&end=%s&key=%s"
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			URL,
-			station,
-			time.UTC().Format(lib.DateFormat),
-			time.UTC().Format(lib.DateFormat),
-			s.apiKey,
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
+func parseLocationStation(station string) (store.Location, error) {
+	parts := strings.Split(station, ",")
+	if len(parts) != 2 {
+		return store.Location{}, fmt.Errorf("invalid station %q", station)
 	}
-
-	resp, err := s.client.Do(req)
+	latitude, err := strconv.ParseFloat(parts[0], 64)
 	if err != nil {
-		return nil, err
+		return store.Location{}, fmt.Errorf("invalid station %q: %v", station, err)
 	}
-
-	body, err := ioutil.ReadAll(resp.Body)
+	longitude, err := strconv.ParseFloat(parts[1], 64)
 	if err != nil {
-		return nil, err
+		return store.Location{}, fmt.Errorf("invalid station %q: %v", station, err)
 	}
 
-	var result Response
-
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
-	}
+	return store.Location{Latitude: latitude, Longitude: longitude}, nil
+}
 
-	if len(result.Data) == 0 {
-		return nil, ErrCannotGetWeather
-	}
-	return &result.Data[0], nil
+func formatCoordinate(coordinate float64) string {
+	return strconv.FormatFloat(coordinate, 'f', 6, 64)
 }
@@ -0,0 +1,189 @@
+package weather
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+)
+
+const (
+	// pollInterval is the wait before the next lease when no job is due.
+	pollInterval = time.Second
+	// leaseDuration is the time the job is hidden from other workers. The job
+	// of the crashed worker runs again after it.
+	leaseDuration = time.Minute
+
+	maxJobAttempts = 10
+	retryDelay     = 10 * time.Second
+	maxRetryDelay  = time.Hour
+)
+
+// Worker sets weather of trackings by jobs from the storage queue. Jobs are
+// retried with exponential backoff and become dead after maxJobAttempts.
+type Worker struct {
+	store   store.Storage
+	service Service
+	size    int
+	logger  *log.Logger
+
+	wake chan struct{}
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWorker creates worker pool with size concurrent workers.
+func NewWorker(storage store.Storage, service Service, size int, logger *log.Logger) *Worker {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Worker{
+		store:   storage,
+		service: service,
+		size:    size,
+		logger:  logger,
+		wake:    make(chan struct{}, size),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Run runs workers until Stop is called.
+func (w *Worker) Run() error {
+	w.wg.Add(w.size)
+	for i := 0; i < w.size; i++ {
+		go func() {
+			defer w.wg.Done()
+			w.work()
+		}()
+	}
+	w.wg.Wait()
+
+	return nil
+}
+
+// Stop waits for jobs in progress. Jobs aren't interrupted, their leases
+// would expire anyway.
+func (w *Worker) Stop() {
+	close(w.quit)
+	w.wg.Wait()
+}
+
+// Wake makes an idle worker lease the next job without waiting for
+// pollInterval, e.g. when a new job is saved.
+func (w *Worker) Wake() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+		// all workers are busy or already woken
+	}
+}
+
+func (w *Worker) work() {
+	for {
+		select {
+		case <-w.quit:
+			return
+		default:
+		}
+
+		now := time.Now()
+		job, err := w.store.LeaseWeatherJob(now, now.Add(leaseDuration))
+		if err != nil {
+			if err != store.ErrNotFound {
+				w.logger.WithField("err", err).Error("cannot lease weather job")
+			}
+			select {
+			case <-w.quit:
+				return
+			case <-w.wake:
+			case <-time.After(pollInterval):
+			}
+
+			continue
+		}
+		w.process(job)
+	}
+}
+
+func (w *Worker) process(job *store.WeatherJob) {
+	tracking, err := w.store.GetTracking(job.ID)
+	if err == store.ErrNotFound {
+		// tracking is deleted, nothing to do
+		w.ack(job)
+
+		return
+	}
+	if err != nil {
+		w.retry(job, err)
+
+		return
+	}
+
+	weather, err := w.service.GetWeather(tracking.Date, tracking.Location)
+	if err != nil {
+		w.retry(job, err)
+
+		return
+	}
+	if err := w.store.SetTrackingWeather(tracking.ID, weather); err != nil && err != store.ErrNotFound {
+		w.retry(job, err)
+
+		return
+	}
+	w.ack(job)
+}
+
+func (w *Worker) ack(job *store.WeatherJob) {
+	err := w.store.AckWeatherJob(job)
+	if err == store.ErrNotFound {
+		// the job is saved again while it was in progress, so it runs again
+		return
+	}
+	if err != nil {
+		w.logger.
+			WithField("err", err).
+			WithField("job", job).
+			Error("cannot ack weather job")
+	}
+}
+
+func (w *Worker) retry(job *store.WeatherJob, cause error) {
+	job.LastError = cause.Error()
+	if job.Attempts >= maxJobAttempts {
+		job.Status = store.DeadJobStatus
+		w.logger.
+			WithField("err", cause).
+			WithField("job", job).
+			Error("weather job is out of attempts")
+	} else {
+		job.RunAt = time.Now().Add(RetryDelay(job.Attempts))
+		w.logger.
+			WithField("err", cause).
+			WithField("job", job).
+			Warn("cannot get weather for tracking, retry later")
+	}
+
+	if err := w.store.UpdateWeatherJob(job); err != nil && err != store.ErrNotFound {
+		w.logger.
+			WithField("err", err).
+			WithField("job", job).
+			Error("cannot update weather job")
+	}
+}
+
+// RetryDelay is the wait after the failed attempt, it doubles with every
+// attempt up to maxRetryDelay.
+func RetryDelay(attempts int) time.Duration {
+	delay := retryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,130 @@
+package weather
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	store "github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/memory"
+)
+
+type serviceFunc func(time time.Time, location store.Location) (*store.Weather, error)
+
+func (f serviceFunc) GetWeather(time time.Time, location store.Location) (*store.Weather, error) {
+	return f(time, location)
+}
+
+func newTracking(t *testing.T, storage store.Storage) *store.Tracking {
+	tracking := &store.Tracking{
+		ID:      uuid.New(),
+		UserID:  uuid.New(),
+		Date:    time.Now(),
+		Weather: &store.Weather{},
+	}
+	require.NoError(t, storage.SaveTracking(tracking))
+	require.NoError(t, storage.SaveWeatherJob(store.NewWeatherJob(tracking.ID)))
+
+	return tracking
+}
+
+func TestRetryDelay(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(retryDelay, RetryDelay(1))
+	r.Equal(2*retryDelay, RetryDelay(2))
+	r.Equal(8*retryDelay, RetryDelay(4))
+	r.Equal(maxRetryDelay, RetryDelay(maxJobAttempts))
+}
+
+func TestWorkerRetry(t *testing.T) {
+	r := require.New(t)
+	storage := memory.New()
+	worker := NewWorker(storage, serviceFunc(func(time.Time, store.Location) (*store.Weather, error) {
+		return nil, errors.New("unavailable")
+	}), 1, log.New())
+
+	tracking := newTracking(t, storage)
+	now := time.Now()
+	job, err := storage.LeaseWeatherJob(now, now.Add(leaseDuration))
+	r.NoError(err)
+	worker.process(job)
+
+	saved, err := storage.GetWeatherJob(tracking.ID)
+	r.NoError(err)
+	r.Equal(store.PendingJobStatus, saved.Status)
+	r.Equal("unavailable", saved.LastError)
+	r.True(saved.RunAt.After(now.Add(retryDelay-time.Second)), "job is not delayed")
+
+	saved.Attempts = maxJobAttempts - 1
+	r.NoError(storage.UpdateWeatherJob(saved))
+	job, err = storage.LeaseWeatherJob(saved.RunAt, saved.RunAt.Add(leaseDuration))
+	r.NoError(err)
+	worker.process(job)
+
+	saved, err = storage.GetWeatherJob(tracking.ID)
+	r.NoError(err)
+	r.Equal(store.DeadJobStatus, saved.Status, "job is not dead after the last attempt")
+	_, err = storage.LeaseWeatherJob(now.Add(24*time.Hour), now.Add(25*time.Hour))
+	r.Equal(store.ErrNotFound, err, "dead job is leased")
+}
+
+func TestWorkerRun(t *testing.T) {
+	r := require.New(t)
+	storage := memory.New()
+	worker := NewWorker(storage, serviceFunc(func(time.Time, store.Location) (*store.Weather, error) {
+		return &store.Weather{Temperature: 15}, nil
+	}), 2, log.New())
+
+	trackings := []*store.Tracking{newTracking(t, storage), newTracking(t, storage)}
+	deleted := newTracking(t, storage)
+	r.NoError(storage.DeleteTracking(deleted.ID))
+
+	go worker.Run()
+	deadline := time.Now().Add(5 * time.Second)
+	for _, tracking := range append(trackings, deleted) {
+		for {
+			_, err := storage.GetWeatherJob(tracking.ID)
+			if err == store.ErrNotFound {
+				break
+			}
+			r.True(time.Now().Before(deadline), "job is not done")
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	worker.Stop()
+
+	for _, tracking := range trackings {
+		saved, err := storage.GetTracking(tracking.ID)
+		r.NoError(err)
+		r.Equal(float32(15), saved.Weather.Temperature)
+	}
+}
+
+func TestWorkerWake(t *testing.T) {
+	r := require.New(t)
+	storage := memory.New()
+	worker := NewWorker(storage, serviceFunc(func(time.Time, store.Location) (*store.Weather, error) {
+		return &store.Weather{Temperature: 15}, nil
+	}), 1, log.New())
+
+	go worker.Run()
+	defer worker.Stop()
+	// the worker finds no jobs and waits for pollInterval
+	time.Sleep(50 * time.Millisecond)
+	tracking := newTracking(t, storage)
+	worker.Wake()
+
+	deadline := time.Now().Add(pollInterval / 2)
+	for {
+		if _, err := storage.GetWeatherJob(tracking.ID); err == store.ErrNotFound {
+			break
+		}
+		r.True(time.Now().Before(deadline), "job waits for the poll after wake")
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,14 @@
+package broker
+
+// Handler handles the message data. Handlers of a subscription are called
+// one at a time.
+type Handler func(data []byte)
+
+type Broker interface {
+	Publish(subject string, data []byte) error
+	// Subscribe delivers every message of the subject to one subscriber of
+	// the queue group, so the group members share the load.
+	Subscribe(subject, queue string, handler Handler) error
+	// Close waits for handlers in progress and stops delivery.
+	Close() error
+}
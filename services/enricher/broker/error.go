@@ -0,0 +1,10 @@
+package broker
+
+import "errors"
+
+var (
+	ErrClosed = errors.New("broker is closed")
+	// ErrSlowConsumer is returned when the message is dropped for the
+	// subscriber, which doesn't keep up with messages.
+	ErrSlowConsumer = errors.New("slow consumer, message is dropped")
+)
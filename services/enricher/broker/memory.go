@@ -0,0 +1,103 @@
+package broker
+
+import (
+	"sync"
+)
+
+// subscriptionBuffer is the number of messages waiting for the handler.
+const subscriptionBuffer = 1024
+
+type group struct {
+	subscriptions []chan []byte
+	next          int
+}
+
+type memoryBroker struct {
+	mu     sync.Mutex
+	groups map[string]map[string]*group
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewMemory creates in-process broker, it's used by tests and by the services
+// running in a single process.
+func NewMemory() Broker {
+	return &memoryBroker{
+		groups: make(map[string]map[string]*group),
+	}
+}
+
+// Publish never waits for handlers, the message is dropped for the full
+// subscription like by NATS for slow consumers. Otherwise the slow handler
+// would stop publishers and Close.
+func (b *memoryBroker) Publish(subject string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrClosed
+	}
+	var err error
+	for _, g := range b.groups[subject] {
+		message := make([]byte, len(data))
+		copy(message, data)
+		select {
+		case g.subscriptions[g.next%len(g.subscriptions)] <- message:
+		default:
+			err = ErrSlowConsumer
+		}
+		g.next++
+	}
+
+	return err
+}
+
+func (b *memoryBroker) Subscribe(subject, queue string, handler Handler) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrClosed
+	}
+	queues, ok := b.groups[subject]
+	if !ok {
+		queues = make(map[string]*group)
+		b.groups[subject] = queues
+	}
+	g, ok := queues[queue]
+	if !ok {
+		g = &group{}
+		queues[queue] = g
+	}
+
+	// messages are buffered, so publish doesn't wait for the handler
+	messages := make(chan []byte, subscriptionBuffer)
+	g.subscriptions = append(g.subscriptions, messages)
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		for data := range messages {
+			handler(data)
+		}
+	}()
+
+	return nil
+}
+
+func (b *memoryBroker) Close() error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, queues := range b.groups {
+			for _, g := range queues {
+				for _, messages := range g.subscriptions {
+					close(messages)
+				}
+			}
+		}
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+
+	return nil
+}
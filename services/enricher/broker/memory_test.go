@@ -0,0 +1,63 @@
+package broker
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBroker(t *testing.T) {
+	r := require.New(t)
+	b := NewMemory()
+
+	var mu sync.Mutex
+	received := make(map[string]int)
+	subscribe := func(queue, name string) {
+		r.NoError(b.Subscribe("subject", queue, func(data []byte) {
+			mu.Lock()
+			defer mu.Unlock()
+			received[name]++
+		}))
+	}
+	subscribe("first", "first-1")
+	subscribe("first", "first-2")
+	subscribe("second", "second")
+
+	for i := 0; i < 10; i++ {
+		r.NoError(b.Publish("subject", []byte("message")))
+	}
+	r.NoError(b.Publish("other", []byte("message")))
+	r.NoError(b.Close())
+
+	r.Equal(5, received["first-1"], "queue group members don't share messages")
+	r.Equal(5, received["first-2"], "queue group members don't share messages")
+	r.Equal(10, received["second"], "every queue group should get the message")
+
+	r.Equal(ErrClosed, b.Publish("subject", []byte("message")))
+	r.Equal(ErrClosed, b.Subscribe("subject", "first", func([]byte) {}))
+}
+
+func TestMemoryBrokerSlowConsumer(t *testing.T) {
+	r := require.New(t)
+	b := NewMemory()
+
+	unblock := make(chan struct{})
+	r.NoError(b.Subscribe("subject", "slow", func([]byte) {
+		<-unblock
+	}))
+
+	// the handler may take the first message, the rest fill the buffer
+	var err error
+	for i := 0; i < subscriptionBuffer+2 && err == nil; i++ {
+		err = b.Publish("subject", []byte("message"))
+	}
+	r.Equal(ErrSlowConsumer, err, "publish waits for the slow handler")
+
+	closed := make(chan error)
+	go func() {
+		closed <- b.Close()
+	}()
+	close(unblock)
+	r.NoError(<-closed, "close waits for the slow handler")
+}
@@ -0,0 +1,62 @@
+package broker
+
+import (
+	"github.com/nats-io/nats.go"
+)
+
+type natsBroker struct {
+	conn   *nats.Conn
+	closed chan struct{}
+}
+
+// NewNATS connects to NATS server. Messages aren't persisted, they are lost
+// if there is no subscriber.
+func NewNATS(url string) (Broker, error) {
+	closed := make(chan struct{})
+	conn, err := nats.Connect(url,
+		nats.MaxReconnects(-1),
+		nats.ClosedHandler(func(*nats.Conn) {
+			close(closed)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsBroker{conn: conn, closed: closed}, nil
+}
+
+func (b *natsBroker) Publish(subject string, data []byte) error {
+	if err := b.conn.Publish(subject, data); err != nil {
+		if err == nats.ErrConnectionClosed {
+			return ErrClosed
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (b *natsBroker) Subscribe(subject, queue string, handler Handler) error {
+	_, err := b.conn.QueueSubscribe(subject, queue, func(msg *nats.Msg) {
+		handler(msg.Data)
+	})
+	if err == nats.ErrConnectionClosed {
+		return ErrClosed
+	}
+
+	return err
+}
+
+func (b *natsBroker) Close() error {
+	// drain delivers pending messages and closes the connection
+	if err := b.conn.Drain(); err != nil {
+		if err == nats.ErrConnectionClosed {
+			return nil
+		}
+		return err
+	}
+	<-b.closed
+
+	return nil
+}
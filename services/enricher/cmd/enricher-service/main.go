@@ -0,0 +1,115 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/oklog/run"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/mongo"
+	"github.com/boodyvo/jogging-api/services/api/storage/postgres"
+	"github.com/boodyvo/jogging-api/services/api/weather"
+	"github.com/boodyvo/jogging-api/services/enricher"
+	"github.com/boodyvo/jogging-api/services/enricher/broker"
+)
+
+func main() {
+	var group run.Group
+
+	logger := log.New()
+	logger.SetFormatter(&log.JSONFormatter{})
+
+	config, err := parseConfig()
+	if err != nil {
+		logger.Fatal("cannot parse the config", err)
+	}
+
+	var store storage.Storage
+	switch config.Storage {
+	case "postgres":
+		store, err = postgres.New(config.PostgresDSN)
+		if err != nil {
+			logger.Fatal("cannot create a storage", err)
+		}
+	default:
+		store, err = mongo.New(config.MongoUrl, config.DatabaseName, logger)
+		if err != nil {
+			logger.Fatal("cannot create a storage", err)
+		}
+	}
+
+	events, err := broker.NewNATS(config.NatsURL)
+	if err != nil {
+		logger.Fatal("cannot connect to the broker", err)
+	}
+
+	var weatherCacheStore storage.Storage
+	if config.WeatherCachePersistent {
+		weatherCacheStore = store
+	}
+	providers, err := weather.NewProviders(
+		strings.Split(config.WeatherProviders, ","),
+		map[string]string{
+			weather.MeteostatProvider:      config.MeteostatKey,
+			weather.OpenWeatherMapProvider: config.OpenWeatherMapKey,
+		},
+		logger,
+	)
+	if err != nil {
+		logger.Fatal("cannot create weather providers", err)
+	}
+	if len(providers) == 0 {
+		logger.Fatal("no weather providers")
+	}
+	cachedProviders := make([]weather.StationService, 0, len(providers))
+	for _, provider := range providers {
+		cache := weather.NewCachedService(provider, config.WeatherCacheSize, weatherCacheStore, logger)
+		cachedProviders = append(cachedProviders, cache)
+
+		statsQuit := make(chan struct{})
+		group.Add(func() error {
+			cache.LogStats(statsQuit)
+
+			return nil
+		}, func(err error) {
+			close(statsQuit)
+		})
+	}
+	weatherService := weather.NewChain(logger, cachedProviders...)
+
+	service := enricher.New(store, weatherService, events, config.Workers, logger)
+
+	logger.Infof("start enricher-service with %d workers", config.Workers)
+	group.Add(func() error {
+		err := service.Run()
+		logger.Infof("finish enricher: %v", err)
+
+		return err
+	}, func(err error) {
+		if err := service.Stop(); err != nil {
+			logger.Infof("cannot stop enricher: %v", err)
+		}
+	})
+
+	signals := make(chan os.Signal, 1)
+	quit := make(chan struct{})
+	group.Add(func() error {
+		signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+		select {
+		case sig := <-signals:
+			logger.Infof("received signal %s", sig)
+		case <-quit:
+		}
+
+		return nil
+	}, func(err error) {
+		signal.Stop(signals)
+		close(quit)
+	})
+
+	logger.Infof("enricher-service terminated: %v", group.Run())
+}
@@ -0,0 +1,74 @@
+package enricher
+
+import (
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/weather"
+	"github.com/boodyvo/jogging-api/services/enricher/broker"
+)
+
+// queueGroup is shared by all enricher instances, so every event wakes one
+// of them.
+const queueGroup = "enricher"
+
+// Enricher runs weather jobs of the storage queue apart from api. Events of
+// saved jobs only wake it up, jobs are leased, retried and acked in the
+// storage, so jobs of lost events are run after the next poll. Weather is
+// written by SetTrackingWeather rather than UpdateTracking, so edits of the
+// tracking made during the lookup aren't overwritten.
+type Enricher struct {
+	events broker.Broker
+	worker *weather.Worker
+	logger *log.Logger
+}
+
+// New creates enricher which runs up to workers jobs at once.
+func New(
+	store storage.Storage,
+	weatherService weather.Service,
+	events broker.Broker,
+	workers int,
+	logger *log.Logger,
+) *Enricher {
+	return &Enricher{
+		events: events,
+		worker: weather.NewWorker(store, weatherService, workers, logger),
+		logger: logger,
+	}
+}
+
+// Run subscribes to events and runs jobs until Stop.
+func (e *Enricher) Run() error {
+	if err := e.events.Subscribe(TrackingCreatedSubject, queueGroup, e.handle); err != nil {
+		return err
+	}
+
+	return e.worker.Run()
+}
+
+// Stop closes the broker and waits for jobs in progress.
+func (e *Enricher) Stop() error {
+	err := e.events.Close()
+	e.worker.Stop()
+
+	return err
+}
+
+func (e *Enricher) handle(data []byte) {
+	var event TrackingCreatedEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		e.logger.
+			WithField("err", err).
+			WithField("data", string(data)).
+			Error("cannot decode tracking created event")
+
+		return
+	}
+	e.logger.
+		WithField("tracking_id", event.TrackingID).
+		Debug("weather job is saved, wake up worker")
+	e.worker.Wake()
+}
@@ -0,0 +1,88 @@
+package enricher
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/boodyvo/jogging-api/services/api/storage"
+	"github.com/boodyvo/jogging-api/services/api/storage/memory"
+	"github.com/boodyvo/jogging-api/services/enricher/broker"
+)
+
+type weatherFunc func(time time.Time, location storage.Location) (*storage.Weather, error)
+
+func (f weatherFunc) GetWeather(time time.Time, location storage.Location) (*storage.Weather, error) {
+	return f(time, location)
+}
+
+func TestEnricher(t *testing.T) {
+	r := require.New(t)
+	store := memory.New()
+	events := broker.NewMemory()
+
+	service := New(store, weatherFunc(func(_ time.Time, location storage.Location) (*storage.Weather, error) {
+		if location.Latitude == 4 {
+			return nil, errors.New("unavailable")
+		}
+
+		return &storage.Weather{Temperature: float32(location.Latitude)}, nil
+	}), events, 2, log.New())
+	done := make(chan error)
+	go func() {
+		done <- service.Run()
+	}()
+
+	trackings := make([]*storage.Tracking, 0, 5)
+	for i := 0; i < 5; i++ {
+		tracking := &storage.Tracking{
+			ID:       uuid.New(),
+			UserID:   uuid.New(),
+			Date:     time.Now(),
+			Location: storage.Location{Latitude: float64(i), Longitude: 30},
+		}
+		r.NoError(store.SaveTracking(tracking))
+		r.NoError(store.SaveWeatherJob(storage.NewWeatherJob(tracking.ID)))
+		r.NoError(PublishTrackingCreated(events, tracking.ID))
+		trackings = append(trackings, tracking)
+	}
+	r.NoError(events.Publish(TrackingCreatedSubject, []byte("invalid")))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for _, tracking := range trackings[:4] {
+		for {
+			_, err := store.GetWeatherJob(tracking.ID)
+			if err == storage.ErrNotFound {
+				break
+			}
+			r.True(time.Now().Before(deadline), "job is not done")
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	for {
+		job, err := store.GetWeatherJob(trackings[4].ID)
+		r.NoError(err, "failed job is dropped")
+		if job.LastError != "" {
+			r.Equal("unavailable", job.LastError)
+			r.Equal(storage.PendingJobStatus, job.Status)
+
+			break
+		}
+		r.True(time.Now().Before(deadline), "failed job is not retried")
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	r.NoError(service.Stop())
+	r.NoError(<-done)
+
+	for i, tracking := range trackings[:4] {
+		saved, err := store.GetTracking(tracking.ID)
+		r.NoError(err)
+		r.NotNil(saved.Weather, "weather is not set")
+		r.Equal(float32(i), saved.Weather.Temperature)
+	}
+}
@@ -0,0 +1,28 @@
+package enricher
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/boodyvo/jogging-api/services/enricher/broker"
+)
+
+// TrackingCreatedSubject is the subject of events of trackings which need
+// weather. It's published after the weather job is saved on create and on
+// change of date or location, so the event is only a wake up signal.
+const TrackingCreatedSubject = "trackings.created"
+
+type TrackingCreatedEvent struct {
+	TrackingID uuid.UUID `json:"tracking_id"`
+}
+
+// PublishTrackingCreated publishes the event for the tracking to the broker.
+func PublishTrackingCreated(events broker.Broker, trackingID uuid.UUID) error {
+	data, err := json.Marshal(&TrackingCreatedEvent{TrackingID: trackingID})
+	if err != nil {
+		return err
+	}
+
+	return events.Publish(TrackingCreatedSubject, data)
+}
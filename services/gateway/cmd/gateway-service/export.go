@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportFile struct {
+	contentType string
+	extension   string
+}
+
+var exportFiles = map[pb.ExportFormat]exportFile{
+	pb.ExportFormat_EXPORT_FORMAT_CSV:    {contentType: "text/csv", extension: "csv"},
+	pb.ExportFormat_EXPORT_FORMAT_NDJSON: {contentType: "application/x-ndjson", extension: "ndjson"},
+	pb.ExportFormat_EXPORT_FORMAT_GPX:    {contentType: "application/gpx+xml", extension: "gpx"},
+}
+
+// exportHandler streams ExportTrackings as a file download. Besides enum names
+// format accepts file extensions, e.g. ?format=gpx.
+func exportHandler(grpcMux *runtime.ServeMux, marshaler runtime.Marshaler, client pb.APIServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := runtime.AnnotateContext(r.Context(), grpcMux, r)
+		if err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, err)
+			return
+		}
+
+		query := r.URL.Query()
+		if format := query.Get("format"); format != "" {
+			name := "EXPORT_FORMAT_" + strings.ToUpper(format)
+			if _, ok := pb.ExportFormat_value[name]; ok {
+				query.Set("format", name)
+			}
+		}
+		request := &pb.ExportTrackingsRequest{}
+		if err := runtime.PopulateQueryParameters(request, query, utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		file, ok := exportFiles[request.Format]
+		if !ok {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, status.Error(codes.InvalidArgument, "unknown format"))
+			return
+		}
+
+		stream, err := client.ExportTrackings(ctx, request)
+		if err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, err)
+			return
+		}
+		// Errors like permission denied come with the first message, so status
+		// code is sent only after it.
+		resp, err := stream.Recv()
+		if err != nil && err != io.EOF {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", file.contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="trackings.%s"`, file.extension))
+		flusher, _ := w.(http.Flusher)
+		for err == nil {
+			if _, err := w.Write(resp.Data); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			resp, err = stream.Recv()
+		}
+		if err != io.EOF {
+			// Headers are already sent, so client sees truncated file only.
+			log.WithField("err", err).Error("export stream failed")
+		}
+	}
+}
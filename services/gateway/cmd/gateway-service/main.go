@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/boodyvo/jogging-api/lib"
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -12,6 +13,8 @@ import (
 	"google.golang.org/grpc"
 )
 
+const apiEndpoint = "api:9090"
+
 func main() {
 	config, err := parseConfig()
 	if err != nil {
@@ -25,19 +28,41 @@ func main() {
 	marshaller := &runtime.HTTPBodyMarshaler{
 		Marshaler: &runtime.JSONPb{EmitDefaults: true, OrigName: true},
 	}
-	grpcMux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaller))
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err = pb.RegisterAPIServiceHandlerFromEndpoint(ctx, grpcMux, "api:9090", opts)
+	grpcMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, marshaller),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+	)
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallSendMsgSize(lib.MaxMessageSize),
+			grpc.MaxCallRecvMsgSize(lib.MaxMessageSize),
+		),
+	}
+	err = pb.RegisterAPIServiceHandlerFromEndpoint(ctx, grpcMux, apiEndpoint, opts)
 	if err != nil {
 		log.Fatal("cannot start api", err)
 	}
+	conn, err := grpc.DialContext(ctx, apiEndpoint, opts...)
+	if err != nil {
+		log.Fatal("cannot connect to api", err)
+	}
+	defer conn.Close()
 
 	router := mux.NewRouter()
 	router.PathPrefix("/docs/").Handler(
 		http.StripPrefix("/docs/", http.FileServer(http.Dir("/docs/"))),
 	)
+	client := pb.NewAPIServiceClient(conn)
+	router.Path("/api/v1/trackings/export").
+		Methods(http.MethodGet).
+		Handler(exportHandler(grpcMux, marshaller, client))
+	router.Path("/api/v1/trackings/import").
+		Methods(http.MethodPost).
+		HeadersRegexp("Content-Type", "^multipart/form-data").
+		Handler(importHandler(grpcMux, marshaller, client))
 	router.PathPrefix("/").Handler(grpcMux)
 
 	log.Infof("start listening gateway-service on port %d", config.Port)
-	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", config.Port), router))
+	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", config.Port), requestIDHandler(router)))
 }
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http"
+	"net/textproto"
+
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// requestIDHeader is forwarded to the api service, so its audit events could
+// be matched with requests.
+const requestIDHeader = "X-Request-Id"
+
+// headerMatcher forwards request id in addition to the default headers.
+func headerMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == requestIDHeader {
+		return "x-request-id", true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// requestIDHandler sets request id, if the client didn't, and returns it in
+// the response.
+func requestIDHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if id == "" {
+			id = uuid.New().String()
+			r.Header.Set(requestIDHeader, id)
+		}
+		w.Header().Set(requestIDHeader, id)
+
+		next.ServeHTTP(w, r)
+	})
+}
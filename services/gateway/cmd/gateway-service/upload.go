@@ -0,0 +1,76 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/boodyvo/jogging-api/lib"
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	uploadField = "file"
+	// maxUploadMemory is the part of multipart form kept in memory, the rest
+	// is stored in temporary files.
+	maxUploadMemory = 8 << 20
+)
+
+// importHandler passes all files of multipart/form-data upload to
+// ImportTrackings at once, so either all of them are imported or none, and
+// responds with ids of all created trackings.
+func importHandler(grpcMux *runtime.ServeMux, marshaler runtime.Marshaler, client pb.APIServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := runtime.AnnotateContext(r.Context(), grpcMux, r)
+		if err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, err)
+			return
+		}
+		// multipart body is bigger than files in it, so ImportTrackings request
+		// with the files fits into gRPC message
+		r.Body = http.MaxBytesReader(w, r.Body, lib.MaxMessageSize)
+		if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		headers := r.MultipartForm.File[uploadField]
+		if len(headers) == 0 {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, status.Error(codes.InvalidArgument, "no file uploaded"))
+			return
+		}
+
+		request := &pb.ImportTrackingsRequest{}
+		for _, header := range headers {
+			file, err := header.Open()
+			if err != nil {
+				runtime.HTTPError(ctx, grpcMux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+			data, err := ioutil.ReadAll(file)
+			_ = file.Close()
+			if err != nil {
+				runtime.HTTPError(ctx, grpcMux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+				return
+			}
+			request.Files = append(request.Files, data)
+		}
+
+		response, err := client.ImportTrackings(ctx, request)
+		if err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, err)
+			return
+		}
+
+		body, err := marshaler.Marshal(response)
+		if err != nil {
+			runtime.HTTPError(ctx, grpcMux, marshaler, w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", marshaler.ContentType())
+		_, _ = w.Write(body)
+	}
+}
@@ -0,0 +1,84 @@
+// +build integration
+
+package e2e
+
+import (
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/tests/common"
+	"github.com/boodyvo/jogging-api/tests/lib"
+)
+
+func TestRefreshTokenRotation(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	refreshResp, err := client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: user.RefreshToken})
+	r.NoError(err, "cannot refresh token")
+	r.NotEqual(user.RefreshToken, refreshResp.RefreshToken)
+
+	_, err = client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: user.RefreshToken})
+	r.Error(err, "rotated refresh token can be used again")
+	_, err = client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: refreshResp.RefreshToken})
+	r.Error(err, "token family is not revoked after reuse")
+}
+
+func TestLogout(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+	secondSession, err := client.SignIn(&pb.SignInRequest{Email: user.Email, Password: user.Password})
+	r.NoError(err, "cannot sign in")
+
+	_, err = client.Logout(user, &pb.LogoutRequest{RefreshToken: user.RefreshToken})
+	r.NoError(err, "cannot logout")
+	_, err = client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: user.RefreshToken})
+	r.Error(err, "refresh token is valid after logout")
+	_, err = client.GetUser(user, &empty.Empty{})
+	r.Error(err, "access token is valid after logout")
+
+	refreshResp, err := client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: secondSession.RefreshToken})
+	r.NoError(err, "refresh token of another session is revoked")
+
+	anotherUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+	_, err = client.Logout(anotherUser, &pb.LogoutRequest{RefreshToken: refreshResp.RefreshToken})
+	r.Error(err, "user can revoke token of another user")
+
+	user.AccessToken = refreshResp.AccessToken
+	_, err = client.LogoutAllSessions(user, &empty.Empty{})
+	r.NoError(err, "cannot logout all sessions")
+	_, err = client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: refreshResp.RefreshToken})
+	r.Error(err, "refresh token is valid after logout from all sessions")
+}
+
+func TestJWKS(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+	parsed, _, err := new(jwt.Parser).ParseUnverified(user.AccessToken, &jwt.StandardClaims{})
+	r.NoError(err, "cannot parse access token")
+
+	jwks, err := client.GetJWKS(&empty.Empty{})
+	r.NoError(err, "cannot get jwks")
+	r.NotEmpty(jwks.Keys)
+	kids := make([]string, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		r.Equal("EC", key.Kty)
+		r.Equal("ES384", key.Alg)
+		kids = append(kids, key.Kid)
+	}
+	r.Contains(kids, parsed.Header["kid"], "signing key is not published")
+}
@@ -0,0 +1,113 @@
+// +build integration
+
+package e2e
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/url"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/tests/common"
+	"github.com/boodyvo/jogging-api/tests/lib"
+)
+
+var linkRegexp = regexp.MustCompile(`https?://\S+`)
+
+// lastMailToken returns the token from the link in the last message to the
+// email. Messages are read from the file of api-service started with
+// --mailer file --mail_file $MAIL_FILE.
+func lastMailToken(t *testing.T, email string) string {
+	path := os.Getenv("MAIL_FILE")
+	file, err := os.Open(path)
+	require.NoError(t, err, "cannot open mail file")
+	defer file.Close()
+
+	token := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var message struct {
+			To   string `json:"to"`
+			Body string `json:"body"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &message))
+		if message.To != email {
+			continue
+		}
+		link, err := url.Parse(linkRegexp.FindString(message.Body))
+		require.NoError(t, err, "message has no link")
+		token = link.Query().Get("token")
+	}
+	require.NoError(t, scanner.Err())
+	require.NotEmpty(t, token, "no message to %s", email)
+
+	return token
+}
+
+func TestEmailVerification(t *testing.T) {
+	if os.Getenv("MAIL_FILE") == "" {
+		t.Skip("MAIL_FILE is not set")
+	}
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+	resp, err := client.GetUser(user, &empty.Empty{})
+	r.NoError(err, "cannot get user")
+	r.False(resp.User.EmailVerified)
+
+	first := lastMailToken(t, user.Email)
+	_, err = client.ResendVerification(user, &empty.Empty{})
+	r.NoError(err, "cannot resend verification")
+	second := lastMailToken(t, user.Email)
+	r.NotEqual(first, second)
+
+	_, err = client.VerifyEmail(&pb.VerifyEmailRequest{Token: first})
+	r.Error(err, "replaced token is valid")
+	_, err = client.VerifyEmail(&pb.VerifyEmailRequest{Token: second})
+	r.NoError(err, "cannot verify email")
+	_, err = client.VerifyEmail(&pb.VerifyEmailRequest{Token: second})
+	r.Error(err, "token is used twice")
+
+	resp, err = client.GetUser(user, &empty.Empty{})
+	r.NoError(err, "cannot get user")
+	r.True(resp.User.EmailVerified)
+	_, err = client.ResendVerification(user, &empty.Empty{})
+	r.Error(err, "verification is sent to verified email")
+}
+
+func TestPasswordReset(t *testing.T) {
+	if os.Getenv("MAIL_FILE") == "" {
+		t.Skip("MAIL_FILE is not set")
+	}
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	_, err := client.RequestPasswordReset(&pb.RequestPasswordResetRequest{Email: lib.CreateEmail()})
+	r.NoError(err, "unknown email is found out")
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+	_, err = client.RequestPasswordReset(&pb.RequestPasswordResetRequest{Email: user.Email})
+	r.NoError(err, "cannot request password reset")
+	token := lastMailToken(t, user.Email)
+
+	_, err = client.ResetPassword(&pb.ResetPasswordRequest{Token: token, Password: "newPassword"})
+	r.NoError(err, "cannot reset password")
+	_, err = client.ResetPassword(&pb.ResetPasswordRequest{Token: token, Password: "otherPassword"})
+	r.Error(err, "token is used twice")
+
+	_, err = client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: user.RefreshToken})
+	r.Error(err, "session is valid after password reset")
+	_, err = client.SignIn(&pb.SignInRequest{Email: user.Email, Password: user.Password})
+	r.Error(err, "old password is valid")
+	_, err = client.SignIn(&pb.SignInRequest{Email: user.Email, Password: "newPassword"})
+	r.NoError(err, "cannot sign in with new password")
+}
@@ -0,0 +1,120 @@
+// +build integration
+
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	"github.com/boodyvo/jogging-api/tests/common"
+	"github.com/boodyvo/jogging-api/tests/lib"
+)
+
+func TestTeamFlow(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	coach, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create coach")
+	athlete, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create athlete")
+	stranger, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create stranger")
+
+	tracking, err := client.CreateRandomTracking(athlete)
+	r.NoError(err, "cannot create tracking")
+
+	// create team and invite athlete
+
+	createTeamResp, err := client.CreateTeam(coach, &pb.CreateTeamRequest{Name: "team"})
+	r.NoError(err, "cannot create team")
+	teamID := createTeamResp.Id
+
+	_, err = client.InviteToTeam(stranger, &pb.InviteToTeamRequest{
+		TeamId: teamID,
+		Email:  stranger.Email,
+		Role:   pb.TeamRole_TEAM_ROLE_COACH,
+	})
+	r.Error(err, "stranger can invite to team")
+
+	_, err = client.InviteToTeam(coach, &pb.InviteToTeamRequest{
+		TeamId: teamID,
+		Email:  athlete.Email,
+		Role:   pb.TeamRole_TEAM_ROLE_MEMBER,
+	})
+	r.NoError(err, "cannot invite athlete")
+
+	_, err = client.InviteToTeam(coach, &pb.InviteToTeamRequest{
+		TeamId: teamID,
+		Email:  "unknown-" + athlete.Email,
+		Role:   pb.TeamRole_TEAM_ROLE_MEMBER,
+	})
+	r.NoError(err, "unknown email is found out by invitation")
+
+	_, err = client.GetTracking(coach, &pb.GetTrackingRequest{Id: tracking.ID})
+	r.Error(err, "coach can read tracking of invited athlete")
+
+	listTeamsResp, err := client.ListTeams(athlete, &empty.Empty{})
+	r.NoError(err, "cannot list teams")
+	r.Len(listTeamsResp.Teams, 1, "invitation is not listed")
+
+	_, err = client.AcceptTeamInvitation(stranger, &pb.AcceptTeamInvitationRequest{TeamId: teamID})
+	r.Error(err, "stranger can accept invitation")
+	_, err = client.AcceptTeamInvitation(athlete, &pb.AcceptTeamInvitationRequest{TeamId: teamID})
+	r.NoError(err, "cannot accept invitation")
+
+	getTeamResp, err := client.GetTeam(athlete, &pb.GetTeamRequest{Id: teamID})
+	r.NoError(err, "cannot get team")
+	r.Equal([]string{athlete.ID}, getTeamResp.Team.Members, "athlete is not a member")
+	_, err = client.GetTeam(stranger, &pb.GetTeamRequest{Id: teamID})
+	r.Error(err, "stranger can get team")
+
+	// coach reads trackings and reports of athlete
+
+	_, err = client.GetTracking(coach, &pb.GetTrackingRequest{Id: tracking.ID})
+	r.NoError(err, "coach cannot read tracking of athlete")
+	distance := float32(1)
+	_, err = client.UpdateTracking(coach, &lib.UpdateTrackingRequest{ID: tracking.ID, Distance: &distance})
+	r.Error(err, "coach can update tracking of athlete")
+
+	listResp, err := client.ListOwnTrackings(coach, &pb.ListTrackingsRequest{TeamId: teamID})
+	r.NoError(err, "coach cannot list team trackings")
+	r.Equal(int64(1), listResp.Total, "wrong number of team trackings")
+	r.Equal(tracking.ID, listResp.Trackings[0].Id, "wrong team tracking")
+	_, err = client.ListOwnTrackings(athlete, &pb.ListTrackingsRequest{TeamId: teamID})
+	r.Error(err, "member can list team trackings")
+
+	_, err = client.Report(coach, &lib.ReportRequest{
+		FromDate: time.Now().AddDate(0, -4, 0),
+		UserID:   athlete.ID,
+	})
+	r.NoError(err, "coach cannot get report of athlete")
+	_, err = client.GetPersonalRecords(coach, &pb.GetPersonalRecordsRequest{UserId: athlete.ID})
+	r.NoError(err, "coach cannot get records of athlete")
+	_, err = client.GetPersonalRecords(stranger, &pb.GetPersonalRecordsRequest{UserId: athlete.ID})
+	r.Error(err, "stranger can get records of athlete")
+
+	// leaving revokes access immediately
+
+	_, err = client.LeaveTeam(coach, &pb.LeaveTeamRequest{TeamId: teamID})
+	r.Error(err, "owner can leave team")
+	_, err = client.LeaveTeam(athlete, &pb.LeaveTeamRequest{TeamId: teamID})
+	r.NoError(err, "cannot leave team")
+
+	_, err = client.GetTracking(coach, &pb.GetTrackingRequest{Id: tracking.ID})
+	r.Error(err, "coach can read tracking after athlete left")
+	_, err = client.Report(coach, &lib.ReportRequest{
+		FromDate: time.Now().AddDate(0, -4, 0),
+		UserID:   athlete.ID,
+	})
+	r.Error(err, "coach can get report after athlete left")
+
+	_, err = client.DeleteTeam(coach, &pb.DeleteTeamRequest{Id: teamID})
+	r.NoError(err, "cannot delete team")
+	_, err = client.GetTeam(coach, &pb.GetTeamRequest{Id: teamID})
+	r.Error(err, "deleted team is found")
+}
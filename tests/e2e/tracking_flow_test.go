@@ -3,9 +3,12 @@
 package e2e
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/jsonpb"
+
 	lib2 "github.com/boodyvo/jogging-api/lib"
 
 	pb "github.com/boodyvo/jogging-api/proto/pb/api"
@@ -57,3 +60,150 @@ func TestTrackingFlow(t *testing.T) {
 	})
 	r.Error(err, "can get trackingResp of another user")
 }
+
+func TestTrackingUpdate(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	tracking, err := client.CreateRandomTracking(user)
+	r.NoError(err, "cannot create tracking")
+
+	distance := tracking.Distance + 1
+	_, err = client.UpdateTracking(user, &lib.UpdateTrackingRequest{
+		ID:       tracking.ID,
+		Distance: &distance,
+	})
+	r.NoError(err, "cannot update tracking")
+
+	trackingResp, err := client.GetTracking(user, &pb.GetTrackingRequest{
+		Id: tracking.ID,
+	})
+	r.NoError(err, "cannot get updated tracking")
+	r.Equal(distance, trackingResp.Tracking.Distance)
+	r.Equal(tracking.Date.Format(lib2.DateFormat), trackingResp.Tracking.Date)
+	r.Equal(tracking.Location.Longitude, trackingResp.Tracking.Location.Longitude)
+	r.Equal(tracking.Location.Latitude, trackingResp.Tracking.Location.Latitude)
+
+	location := lib.CreateLocation()
+	date := tracking.Date.AddDate(0, 0, 1)
+	_, err = client.UpdateTracking(user, &lib.UpdateTrackingRequest{
+		ID:       tracking.ID,
+		Location: &location,
+		Date:     &date,
+	})
+	r.NoError(err, "cannot update tracking")
+
+	trackingResp, err = client.GetTracking(user, &pb.GetTrackingRequest{
+		Id: tracking.ID,
+	})
+	r.NoError(err, "cannot get updated tracking")
+	r.Equal(distance, trackingResp.Tracking.Distance)
+	r.Equal(date.Format(lib2.DateFormat), trackingResp.Tracking.Date)
+	r.Equal(location.Longitude, trackingResp.Tracking.Location.Longitude)
+	r.Equal(location.Latitude, trackingResp.Tracking.Location.Latitude)
+
+	userSecond, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	_, err = client.UpdateTracking(userSecond, &lib.UpdateTrackingRequest{
+		ID:       tracking.ID,
+		Distance: &distance,
+	})
+	r.Error(err, "can update tracking of another user")
+}
+
+const importGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="e2e" xmlns="http://www.topografix.com/GPX/1/1">
+  <trk>
+    <trkseg>
+      <trkpt lat="52.5200" lon="13.4050"><time>2020-03-02T07:30:00Z</time></trkpt>
+      <trkpt lat="52.5200" lon="13.4200"><time>2020-03-02T07:35:00Z</time></trkpt>
+      <trkpt lat="52.5300" lon="13.4200"><time>2020-03-02T07:40:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestTrackingImport(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	importResp, err := client.ImportTrackings(user, "run.gpx", []byte(importGPX))
+	r.NoError(err, "cannot import trackings")
+	r.Len(importResp.Ids, 1)
+
+	trackingResp, err := client.GetTracking(user, &pb.GetTrackingRequest{
+		Id: importResp.Ids[0],
+	})
+	r.NoError(err, "cannot get imported tracking")
+	r.Equal("2020-03-02", trackingResp.Tracking.Date)
+	r.Equal(int64(10*time.Minute/time.Second), trackingResp.Tracking.Time.Seconds)
+	r.InDelta(2126, trackingResp.Tracking.Distance, 10)
+	r.Equal(52.52, trackingResp.Tracking.Location.Latitude)
+	r.Equal(13.405, trackingResp.Tracking.Location.Longitude)
+	r.Empty(trackingResp.Tracking.Route, "route should be returned only on request")
+
+	trackingResp, err = client.GetTracking(user, &pb.GetTrackingRequest{
+		Id:           importResp.Ids[0],
+		IncludeRoute: true,
+	})
+	r.NoError(err, "cannot get imported tracking with route")
+	r.Len(trackingResp.Tracking.Route, 3)
+
+	trackingResp, err = client.GetTracking(user, &pb.GetTrackingRequest{
+		Id:             importResp.Ids[0],
+		IncludeRoute:   true,
+		RouteTolerance: 2000,
+	})
+	r.NoError(err, "cannot get imported tracking with simplified route")
+	r.Len(trackingResp.Tracking.Route, 2)
+
+	_, err = client.ImportTrackings(user, "run.csv", []byte("date,distance"))
+	r.Error(err, "can import file of unknown format")
+}
+
+func TestTrackingExport(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	for i := 0; i < 3; i++ {
+		_, err := client.CreateRandomTracking(user)
+		r.NoError(err, "cannot create tracking")
+	}
+	_, err = client.ImportTrackings(user, "run.gpx", []byte(importGPX))
+	r.NoError(err, "cannot import trackings")
+
+	data, err := client.ExportTrackings(user, &pb.ExportTrackingsRequest{
+		Format: pb.ExportFormat_EXPORT_FORMAT_CSV,
+	})
+	r.NoError(err, "cannot export trackings")
+	r.Len(strings.Split(strings.TrimSpace(string(data)), "\n"), 5, "csv should have header and all trackings")
+
+	data, err = client.ExportTrackings(user, &pb.ExportTrackingsRequest{
+		Format: pb.ExportFormat_EXPORT_FORMAT_NDJSON,
+		Query:  "date eq 2020-03-02",
+	})
+	r.NoError(err, "cannot export trackings")
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	r.Len(lines, 1)
+	var tracking pb.Tracking
+	r.NoError(jsonpb.UnmarshalString(lines[0], &tracking))
+	r.Len(tracking.Route, 3)
+
+	data, err = client.ExportTrackings(user, &pb.ExportTrackingsRequest{
+		Format: pb.ExportFormat_EXPORT_FORMAT_GPX,
+	})
+	r.NoError(err, "cannot export trackings")
+	r.Equal(4, strings.Count(string(data), "<trk>"))
+
+	_, err = client.ExportTrackings(user, &pb.ExportTrackingsRequest{AllUsers: true})
+	r.Error(err, "user can export trackings of all users")
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	lib2 "github.com/boodyvo/jogging-api/lib"
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
 	"github.com/boodyvo/jogging-api/tests/common"
 	"github.com/boodyvo/jogging-api/tests/lib"
 )
@@ -115,3 +117,74 @@ func TestTrackingReportCustomDuration(t *testing.T) {
 		r.InDelta(averageSpeed, reportResp.AverageSpeed, delta, fmt.Sprintf("incorrect speed for day %d", days))
 	}
 }
+
+func TestTrackingReportDailyBuckets(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	trackingDateNow := time.Now().AddDate(0, -6, 0).UTC()
+	for days := 0; days < 10; days++ {
+		createTrackingRequest := &lib.CreateTrackingRequest{
+			Location: lib.CreateLocation(),
+			Time:     trackingReportTime.String(),
+			Distance: trackingReportDistance * float32(days+1),
+			Date:     trackingDateNow.AddDate(0, 0, days),
+		}
+		for i := 0; i < trackingReportCount; i++ {
+			_, err = client.CreateTracking(user, createTrackingRequest)
+			r.NoError(err, "cannot create tracking")
+		}
+	}
+
+	reportResp, err := client.Report(user, &lib.ReportRequest{
+		FromDate:    trackingDateNow,
+		Granularity: "GRANULARITY_DAY",
+	})
+	r.NoError(err, "cannot create report")
+	r.Len(reportResp.Buckets, 7, "report should contain bucket for every day of week")
+	for days, bucket := range reportResp.Buckets {
+		distance := trackingReportDistance * float32(days+1)
+		r.Equal(trackingDateNow.AddDate(0, 0, days).Format(lib2.DateFormat), bucket.StartDate)
+		r.Equal(int64(trackingReportCount), bucket.Count)
+		r.InDelta(distance*trackingReportCount, bucket.Distance, delta, fmt.Sprintf("incorrect distance for day %d", days))
+		r.InDelta(distance, bucket.LongestRun, delta, fmt.Sprintf("incorrect longest run for day %d", days))
+		r.InDelta(
+			distance/float32(trackingReportTime.Seconds()),
+			bucket.AverageSpeed,
+			delta,
+			fmt.Sprintf("incorrect speed for day %d", days),
+		)
+	}
+}
+
+func TestTrackingPersonalRecords(t *testing.T) {
+	r := require.New(t)
+	client := lib.NewClient(common.DefaultURL)
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+
+	trackingDateNow := time.Now().UTC()
+	ids := make([]string, 0, 3)
+	for days := 0; days < 3; days++ {
+		resp, err := client.CreateTracking(user, &lib.CreateTrackingRequest{
+			Location: lib.CreateLocation(),
+			Time:     trackingReportTime.String(),
+			Distance: trackingReportDistance * float32(days+1),
+			Date:     trackingDateNow.AddDate(0, 0, -days),
+		})
+		r.NoError(err, "cannot create tracking")
+		ids = append(ids, resp.Id)
+	}
+
+	recordsResp, err := client.GetPersonalRecords(user, &pb.GetPersonalRecordsRequest{})
+	r.NoError(err, "cannot get personal records")
+	r.Equal(ids[2], recordsResp.LongestDistance.TrackingId)
+	r.Equal(ids[2], recordsResp.FastestPace.TrackingId)
+	r.Equal(int64(3), recordsResp.CurrentStreak.Days)
+	r.Equal(int64(3), recordsResp.LongestStreak.Days)
+	r.Equal(trackingDateNow.Format(lib2.DateFormat), recordsResp.CurrentStreak.EndDate)
+}
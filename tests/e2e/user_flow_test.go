@@ -379,3 +379,429 @@ func TestAdminUserListQuery(t *testing.T) {
 	r.Equal(commonUser.Email, listUsersResponse.Users[0].Email, "wrong query response")
 
 }
+
+func TestCustomRoles(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	client := lib.NewClient(common.DefaultURL)
+	grpcClient, err := pbclient.New(ctx, common.DefaultGRPCURL)
+	r.NoError(err, "cannot create grpc client")
+
+	// create admin user
+	adminUser := &lib.User{
+		Email:    lib.CreateEmail(),
+		Password: common.DefaultPassword,
+	}
+	_, err = grpcClient.CreateAdmin(ctx, &pb.CreateAdminRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot create admin user")
+
+	signInResp, err := client.SignIn(&pb.SignInRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot sign in admin user")
+	adminUser.RefreshToken = signInResp.RefreshToken
+	adminUser.AccessToken = signInResp.AccessToken
+
+	commonUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create common user")
+
+	// create role
+
+	name := fmt.Sprintf("reader-%d", rand.Int())
+	readTrackings := &pb.Permission{
+		Action: pb.Action_ACTION_READ,
+		Scope:  pb.Scope_SCOPE_TRACKINGS,
+		Item:   "*",
+	}
+	_, err = client.CreateRole(commonUser, &pb.CreateRoleRequest{
+		Name:        name,
+		Permissions: []*pb.Permission{readTrackings},
+	})
+	r.Error(err, "common user can create roles")
+
+	createRoleResp, err := client.CreateRole(adminUser, &pb.CreateRoleRequest{
+		Name:        name,
+		Permissions: []*pb.Permission{readTrackings},
+	})
+	r.NoError(err, "admin user cannot create role")
+	_, err = client.CreateRole(adminUser, &pb.CreateRoleRequest{Name: name})
+	r.Error(err, "can create role with the same name")
+
+	getRoleResp, err := client.GetRole(adminUser, &pb.GetRoleRequest{Id: createRoleResp.Id})
+	r.NoError(err, "cannot get role")
+	r.Equal(name, getRoleResp.Role.Name, "wrong role name")
+	r.False(getRoleResp.Role.BuiltIn, "custom role is built in")
+
+	listRolesResp, err := client.ListRoles(adminUser, &empty.Empty{})
+	r.NoError(err, "cannot list roles")
+	r.LessOrEqual(4, len(listRolesResp.Roles), "roles are missing in the list")
+
+	// assign role
+
+	_, err = client.ListTrackings(commonUser, &pb.ListTrackingsRequest{})
+	r.Error(err, "common user can list all trackings")
+
+	_, err = client.AddRole(adminUser, &pb.AddRoleRequest{
+		UserId: commonUser.ID,
+		RoleId: createRoleResp.Id,
+	})
+	r.NoError(err, "cannot add custom role")
+
+	_, err = client.ListTrackings(commonUser, &pb.ListTrackingsRequest{})
+	r.NoError(err, "common user cannot list all trackings with custom role")
+
+	// update role applies immediately
+
+	_, err = client.UpdateRole(adminUser, &pb.UpdateRoleRequest{
+		Id:   createRoleResp.Id,
+		Name: name,
+	})
+	r.NoError(err, "cannot update role")
+
+	_, err = client.ListTrackings(commonUser, &pb.ListTrackingsRequest{})
+	r.Error(err, "common user can list all trackings after role update")
+
+	// built in roles are read only
+
+	for _, role := range listRolesResp.Roles {
+		if role.BuiltIn {
+			_, err = client.DeleteRole(adminUser, &pb.DeleteRoleRequest{Id: role.Id})
+			r.Error(err, "can delete built in role")
+		}
+	}
+
+	_, err = client.DeleteRole(adminUser, &pb.DeleteRoleRequest{Id: createRoleResp.Id})
+	r.NoError(err, "cannot delete role")
+	_, err = client.GetRole(adminUser, &pb.GetRoleRequest{Id: createRoleResp.Id})
+	r.Error(err, "deleted role is found")
+}
+
+func TestPermissionManagement(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	client := lib.NewClient(common.DefaultURL)
+	grpcClient, err := pbclient.New(ctx, common.DefaultGRPCURL)
+	r.NoError(err, "cannot create grpc client")
+
+	// create admin user
+	adminUser := &lib.User{
+		Email:    lib.CreateEmail(),
+		Password: common.DefaultPassword,
+	}
+	_, err = grpcClient.CreateAdmin(ctx, &pb.CreateAdminRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot create admin user")
+
+	signInResp, err := client.SignIn(&pb.SignInRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot sign in admin user")
+	adminUser.RefreshToken = signInResp.RefreshToken
+	adminUser.AccessToken = signInResp.AccessToken
+
+	commonUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create common user")
+
+	// grant permission
+
+	_, err = client.AddPermission(adminUser, &pb.AddPermissionRequest{
+		UserId: commonUser.ID,
+		Scope:  pb.Scope_SCOPE_TRACKINGS,
+		Action: pb.Action_ACTION_READ,
+		Item:   "*",
+	})
+	r.NoError(err, "admin user cannot add permissions the user")
+
+	_, err = client.ListEffectivePermissions(commonUser, &pb.ListEffectivePermissionsRequest{
+		UserId: commonUser.ID,
+	})
+	r.Error(err, "common user can list effective permissions")
+
+	permissionsResp, err := client.ListEffectivePermissions(adminUser, &pb.ListEffectivePermissionsRequest{
+		UserId: commonUser.ID,
+	})
+	r.NoError(err, "admin user cannot list effective permissions")
+	var aclPermissions, rolePermissions int
+	for _, permission := range permissionsResp.Permissions {
+		switch permission.Source {
+		case pb.PermissionSource_PERMISSION_SOURCE_ACL:
+			aclPermissions++
+			r.Equal(pb.Scope_SCOPE_TRACKINGS, permission.Permission.Scope, "wrong ACL permission")
+			r.Equal(pb.Action_ACTION_READ, permission.Permission.Action, "wrong ACL permission")
+		case pb.PermissionSource_PERMISSION_SOURCE_ROLE:
+			rolePermissions++
+			r.NotEmpty(permission.RoleId, "role permission without role")
+		}
+	}
+	r.Equal(1, aclPermissions, "wrong number of ACL permissions")
+	r.Equal(len(permissionsResp.Permissions)-1, rolePermissions, "permission without source")
+
+	// remove permission
+
+	_, err = client.RemovePermission(commonUser, &pb.RemovePermissionRequest{
+		UserId: commonUser.ID,
+		Scope:  pb.Scope_SCOPE_TRACKINGS,
+		Action: pb.Action_ACTION_READ,
+		Item:   "*",
+	})
+	r.Error(err, "common user can remove permissions")
+
+	_, err = client.RemovePermission(adminUser, &pb.RemovePermissionRequest{
+		UserId: commonUser.ID,
+		Scope:  pb.Scope_SCOPE_TRACKINGS,
+		Action: pb.Action_ACTION_READ,
+		Item:   "*",
+	})
+	r.NoError(err, "admin user cannot remove permissions")
+
+	_, err = client.RemovePermission(adminUser, &pb.RemovePermissionRequest{
+		UserId: commonUser.ID,
+		Scope:  pb.Scope_SCOPE_TRACKINGS,
+		Action: pb.Action_ACTION_READ,
+		Item:   "*",
+	})
+	r.Error(err, "can remove missing permission")
+
+	permissionsResp, err = client.ListEffectivePermissions(adminUser, &pb.ListEffectivePermissionsRequest{
+		UserId: commonUser.ID,
+	})
+	r.NoError(err, "admin user cannot list effective permissions")
+	for _, permission := range permissionsResp.Permissions {
+		r.Equal(pb.PermissionSource_PERMISSION_SOURCE_ROLE, permission.Source, "removed permission is listed")
+	}
+}
+
+func TestAuditEvents(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	client := lib.NewClient(common.DefaultURL)
+	grpcClient, err := pbclient.New(ctx, common.DefaultGRPCURL)
+	r.NoError(err, "cannot create grpc client")
+
+	// create admin user
+	adminUser := &lib.User{
+		Email:    lib.CreateEmail(),
+		Password: common.DefaultPassword,
+	}
+	createAdminResp, err := grpcClient.CreateAdmin(ctx, &pb.CreateAdminRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot create admin user")
+	adminUser.ID = createAdminResp.Id
+
+	signInResp, err := client.SignIn(&pb.SignInRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot sign in admin user")
+	adminUser.RefreshToken = signInResp.RefreshToken
+	adminUser.AccessToken = signInResp.AccessToken
+
+	commonUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create common user")
+
+	// privileged actions
+
+	_, err = client.AddRole(adminUser, &pb.AddRoleRequest{
+		UserId: commonUser.ID,
+		Role:   pb.Role_ROLE_MANAGER,
+	})
+	r.NoError(err, "admin user cannot add roles the user")
+	_, err = client.ListTrackings(adminUser, &pb.ListTrackingsRequest{})
+	r.NoError(err, "admin user cannot list all trackings")
+	_, err = client.DeleteUserByID(adminUser, &pb.DeleteUserRequest{Id: commonUser.ID})
+	r.NoError(err, "admin user cannot delete user")
+
+	// audit events
+
+	_, err = client.ListAuditEvents(commonUser, &pb.ListAuditEventsRequest{})
+	r.Error(err, "common user can list audit events")
+
+	eventsResp, err := client.ListAuditEvents(adminUser, &pb.ListAuditEventsRequest{ActorId: adminUser.ID})
+	r.NoError(err, "admin user cannot list audit events")
+	r.Equal(int64(3), eventsResp.Total, "wrong number of audit events")
+
+	addRole := eventsResp.Events[0]
+	r.Equal("add_role", addRole.Action)
+	r.Equal(commonUser.ID, addRole.Target.Item)
+	r.Contains(addRole.Before, "roles")
+	r.Contains(addRole.After, "roles")
+	r.NotEmpty(addRole.RequestId, "request id is not set")
+	r.NotEmpty(addRole.ClientIp, "client ip is not set")
+	r.NotEmpty(addRole.PeerIp, "peer ip is not set")
+	r.Equal("list_all_trackings", eventsResp.Events[1].Action)
+	r.Equal("delete_user", eventsResp.Events[2].Action)
+	r.Contains(eventsResp.Events[2].Before, commonUser.Email)
+
+	pageResp, err := client.ListAuditEvents(adminUser, &pb.ListAuditEventsRequest{
+		ActorId: adminUser.ID,
+		PerReq:  2,
+	})
+	r.NoError(err, "admin user cannot list audit events")
+	r.Len(pageResp.Events, 2)
+	pageResp, err = client.ListAuditEvents(adminUser, &pb.ListAuditEventsRequest{
+		ActorId: adminUser.ID,
+		Cursor:  pageResp.Cursor,
+	})
+	r.NoError(err, "admin user cannot list audit events by cursor")
+	r.Len(pageResp.Events, 1)
+	r.Equal("delete_user", pageResp.Events[0].Action)
+}
+
+func TestChangeCredentials(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	client := lib.NewClient(common.DefaultURL)
+	grpcClient, err := pbclient.New(ctx, common.DefaultGRPCURL)
+	r.NoError(err, "cannot create grpc client")
+
+	// create admin user
+	adminUser := &lib.User{
+		Email:    lib.CreateEmail(),
+		Password: common.DefaultPassword,
+	}
+	createAdminResp, err := grpcClient.CreateAdmin(ctx, &pb.CreateAdminRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot create admin user")
+	adminUser.ID = createAdminResp.Id
+
+	signInResp, err := client.SignIn(&pb.SignInRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot sign in admin user")
+	adminUser.RefreshToken = signInResp.RefreshToken
+	adminUser.AccessToken = signInResp.AccessToken
+
+	commonUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create common user")
+	otherSession, err := client.SignIn(&pb.SignInRequest{Email: commonUser.Email, Password: commonUser.Password})
+	r.NoError(err, "cannot sign in common user")
+
+	// change password
+
+	_, err = client.ChangePassword(commonUser, &pb.ChangePasswordRequest{
+		CurrentPassword: "wrong",
+		NewPassword:     "newPassword",
+	})
+	r.Error(err, "password is changed without the current one")
+	_, err = client.ChangePassword(commonUser, &pb.ChangePasswordRequest{
+		CurrentPassword: commonUser.Password,
+		NewPassword:     "newPassword",
+	})
+	r.NoError(err, "cannot change password")
+	_, err = client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: otherSession.RefreshToken})
+	r.Error(err, "other session is valid after password change")
+	refreshResp, err := client.RefreshToken(&pb.RefreshTokenRequest{RefreshToken: commonUser.RefreshToken})
+	r.NoError(err, "current session is revoked after password change")
+	commonUser.AccessToken = refreshResp.AccessToken
+	commonUser.Password = "newPassword"
+
+	// change email
+
+	otherUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create other user")
+	_, err = client.ChangeEmail(commonUser, &pb.ChangeEmailRequest{
+		Email:           otherUser.Email,
+		CurrentPassword: commonUser.Password,
+	})
+	r.Error(err, "email of another user is taken")
+
+	newEmail := lib.CreateEmail()
+	_, err = client.ChangeEmail(commonUser, &pb.ChangeEmailRequest{
+		Email:           newEmail,
+		CurrentPassword: commonUser.Password,
+	})
+	r.NoError(err, "cannot change email")
+	userResp, err := client.GetUser(commonUser, &empty.Empty{})
+	r.NoError(err, "cannot get user")
+	r.Equal(newEmail, userResp.User.Email)
+	r.False(userResp.User.EmailVerified, "new email is verified")
+
+	// admin variants
+
+	_, err = client.ChangeUserPassword(commonUser, &pb.ChangeUserPasswordRequest{
+		UserId:      commonUser.ID,
+		NewPassword: "otherPassword",
+	})
+	r.Error(err, "common user can change password without the current one")
+	_, err = client.ChangeUserPassword(adminUser, &pb.ChangeUserPasswordRequest{
+		UserId:      commonUser.ID,
+		NewPassword: "adminPassword",
+	})
+	r.NoError(err, "admin user cannot change password of the user")
+	_, err = client.SignIn(&pb.SignInRequest{Email: newEmail, Password: "adminPassword"})
+	r.NoError(err, "cannot sign in with password set by admin")
+
+	adminEmail := lib.CreateEmail()
+	_, err = client.ChangeUserEmail(adminUser, &pb.ChangeUserEmailRequest{
+		UserId: commonUser.ID,
+		Email:  adminEmail,
+	})
+	r.NoError(err, "admin user cannot change email of the user")
+	_, err = client.SignIn(&pb.SignInRequest{Email: adminEmail, Password: "adminPassword"})
+	r.NoError(err, "cannot sign in with email set by admin")
+}
+
+func TestSignInLockout(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	client := lib.NewClient(common.DefaultURL)
+	grpcClient, err := pbclient.New(ctx, common.DefaultGRPCURL)
+	r.NoError(err, "cannot create grpc client")
+
+	// create admin user
+	adminUser := &lib.User{
+		Email:    lib.CreateEmail(),
+		Password: common.DefaultPassword,
+	}
+	_, err = grpcClient.CreateAdmin(ctx, &pb.CreateAdminRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot create admin user")
+
+	signInResp, err := client.SignIn(&pb.SignInRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot sign in admin user")
+	adminUser.RefreshToken = signInResp.RefreshToken
+	adminUser.AccessToken = signInResp.AccessToken
+
+	commonUser, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create common user")
+
+	// responses are uniform
+
+	_, err = client.SignIn(&pb.SignInRequest{Email: lib.CreateEmail(), Password: "wrong"})
+	r.EqualError(err, "wrong status code: 401", "unknown email is found out")
+	_, err = client.SignIn(&pb.SignInRequest{Email: commonUser.Email, Password: "wrong"})
+	r.EqualError(err, "wrong status code: 401", "unknown email is found out")
+
+	// lockout
+
+	for i := 0; i < 4; i++ {
+		_, err = client.SignIn(&pb.SignInRequest{Email: commonUser.Email, Password: "wrong"})
+		r.Error(err)
+	}
+	_, err = client.SignIn(&pb.SignInRequest{Email: commonUser.Email, Password: commonUser.Password})
+	r.EqualError(err, "wrong status code: 429", "account is not locked after failures")
+
+	_, err = client.UnlockSignIn(commonUser, &pb.UnlockSignInRequest{UserId: commonUser.ID})
+	r.Error(err, "common user can unlock sign in")
+	_, err = client.UnlockSignIn(adminUser, &pb.UnlockSignInRequest{UserId: commonUser.ID})
+	r.NoError(err, "admin user cannot unlock sign in")
+	_, err = client.SignIn(&pb.SignInRequest{Email: commonUser.Email, Password: commonUser.Password})
+	r.NoError(err, "account is locked after unlock")
+}
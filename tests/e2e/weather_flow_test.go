@@ -0,0 +1,64 @@
+// +build integration
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/boodyvo/jogging-api/proto/pb/api"
+	pbclient "github.com/boodyvo/jogging-api/services/api/client"
+	"github.com/boodyvo/jogging-api/tests/common"
+	"github.com/boodyvo/jogging-api/tests/lib"
+)
+
+func TestWeatherBackfill(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	client := lib.NewClient(common.DefaultURL)
+	grpcClient, err := pbclient.New(ctx, common.DefaultGRPCURL)
+	r.NoError(err, "cannot create grpc client")
+
+	adminUser := &lib.User{
+		Email:    lib.CreateEmail(),
+		Password: common.DefaultPassword,
+	}
+	_, err = grpcClient.CreateAdmin(ctx, &pb.CreateAdminRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot create admin user")
+	signInResp, err := client.SignIn(&pb.SignInRequest{
+		Email:    adminUser.Email,
+		Password: adminUser.Password,
+	})
+	r.NoError(err, "cannot sign in admin user")
+	adminUser.AccessToken = signInResp.AccessToken
+
+	user, err := client.CreateRandomAuthorizedUser()
+	r.NoError(err, "cannot create user")
+	_, err = client.CreateTracking(user, &lib.CreateTrackingRequest{
+		Location: lib.Location{Longitude: 20.2181231, Latitude: 50.6243121},
+		Time:     time.Hour.String(),
+		Distance: 5.5,
+		Date:     time.Date(1971, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	r.NoError(err, "cannot create tracking")
+
+	request := &pb.BackfillWeatherRequest{Query: "date eq 1971-01-01", Rate: 50, Concurrency: 4}
+	_, err = client.BackfillWeather(user, request)
+	r.Error(err, "user can backfill weather")
+
+	// weather of the tracking could be already set, then nothing is backfilled
+	progress, err := client.BackfillWeather(adminUser, request)
+	r.NoError(err, "cannot backfill weather")
+	r.NotEmpty(progress)
+	last := progress[len(progress)-1]
+	r.Equal(last.Total, last.Done+last.Failed, "not every tracking is backfilled")
+
+	_, err = client.BackfillWeather(adminUser, &pb.BackfillWeatherRequest{Concurrency: 100})
+	r.Error(err, "backfill with invalid concurrency")
+}
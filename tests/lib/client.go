@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"mime/multipart"
 	"net/http"
 	"strconv"
 	"time"
@@ -105,15 +106,882 @@ func (c *client) SignIn(request *pb.SignInRequest) (*pb.SignInResponse, error) {
 	return &result, nil
 }
 
-func (c *client) AddPermission(user *User, request *pb.AddPermissionRequest) (*empty.Empty, error) {
+func (c *client) RefreshToken(request *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/refresh", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.RefreshTokenResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) Logout(user *User, request *pb.LogoutRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/logout", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) LogoutAllSessions(user *User, _ *empty.Empty) (*empty.Empty, error) {
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/logout/all", c.url),
+		bytes.NewBufferString("{}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) RequestPasswordReset(request *pb.RequestPasswordResetRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/password/reset/request", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ResetPassword(request *pb.ResetPasswordRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/password/reset", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) VerifyEmail(request *pb.VerifyEmailRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/email/verify", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ResendVerification(user *User, _ *empty.Empty) (*empty.Empty, error) {
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/email/resend", c.url),
+		bytes.NewBufferString("{}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) UnlockSignIn(user *User, request *pb.UnlockSignInRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/auth/unlock", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) GetJWKS(_ *empty.Empty) (*pb.GetJWKSResponse, error) {
+	resp, err := c.client.Get(fmt.Sprintf("%s/.well-known/jwks.json", c.url))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.GetJWKSResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) ChangePassword(user *User, request *pb.ChangePasswordRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf("%s/api/v1/user/password", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ChangeEmail(user *User, request *pb.ChangeEmailRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf("%s/api/v1/user/email", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ChangeUserPassword(user *User, request *pb.ChangeUserPasswordRequest) (*empty.Empty, error) {
 	buf, err := json.Marshal(request)
 	if err != nil {
 		return nil, err
 	}
 
 	req, err := http.NewRequest(
-		"PUT",
-		fmt.Sprintf("%s/api/v1/user/permissions", c.url),
+		"PUT",
+		fmt.Sprintf("%s/api/v1/user/%s/password", c.url, request.UserId),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ChangeUserEmail(user *User, request *pb.ChangeUserEmailRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf("%s/api/v1/user/%s/email", c.url, request.UserId),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) AddPermission(user *User, request *pb.AddPermissionRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf("%s/api/v1/user/permissions", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) RemovePermission(user *User, request *pb.RemovePermissionRequest) (*empty.Empty, error) {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf("%s/api/v1/user/%s/permissions", c.url, request.UserId),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("scope", request.Scope.String())
+	q.Add("action", request.Action.String())
+	q.Add("item", request.Item)
+	req.URL.RawQuery = q.Encode()
+
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ListEffectivePermissions(
+	user *User,
+	request *pb.ListEffectivePermissionsRequest,
+) (*pb.ListEffectivePermissionsResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/user/%s/permissions", c.url, request.UserId),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.ListEffectivePermissionsResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) AddRole(user *User, request *pb.AddRoleRequest) (*empty.Empty, error) {
+	url := fmt.Sprintf("%s/api/v1/user/%s/roles/%s", c.url, request.UserId, request.Role)
+	if request.RoleId != "" {
+		url = fmt.Sprintf("%s/api/v1/roles/%s/users/%s", c.url, request.RoleId, request.UserId)
+	}
+	req, err := http.NewRequest("PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) RemoveRole(user *User, request *pb.RemoveRoleRequest) (*empty.Empty, error) {
+	url := fmt.Sprintf("%s/api/v1/user/%s/roles/%s", c.url, request.UserId, request.Role)
+	if request.RoleId != "" {
+		url = fmt.Sprintf("%s/api/v1/roles/%s/users/%s", c.url, request.RoleId, request.UserId)
+	}
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) CreateRole(user *User, request *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/roles", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("wrong status code: %d, details: %s", resp.StatusCode, string(body))
+	}
+
+	var result pb.CreateRoleResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) UpdateRole(user *User, request *pb.UpdateRoleRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf("%s/api/v1/roles/%s", c.url, request.Id),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("wrong status code: %d, details: %s", resp.StatusCode, string(body))
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) DeleteRole(user *User, request *pb.DeleteRoleRequest) (*empty.Empty, error) {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf("%s/api/v1/roles/%s", c.url, request.Id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) GetRole(user *User, request *pb.GetRoleRequest) (*pb.GetRoleResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/roles/%s", c.url, request.Id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.GetRoleResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) ListRoles(user *User, _ *empty.Empty) (*pb.ListRolesResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/roles", c.url),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.ListRolesResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) GetUser(user *User, _ *empty.Empty) (*pb.GetUserResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/user", c.url),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.GetUserResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) GetUserByID(user *User, request *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/user/%s", c.url, request.Id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.GetUserResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) DeleteUser(user *User, _ *empty.Empty) (*empty.Empty, error) {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf("%s/api/v1/user", c.url),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) DeleteUserByID(user *User, request *pb.DeleteUserRequest) (*empty.Empty, error) {
+	req, err := http.NewRequest(
+		"DELETE",
+		fmt.Sprintf("%s/api/v1/user/%s", c.url, request.Id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) ListUsers(user *User, request *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/users", c.url),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	if request.Cursor != "" {
+		q.Add("cursor", request.Cursor)
+	}
+	if request.Query != "" {
+		q.Add("query", request.Query)
+	}
+	if request.PerReq != 0 {
+		q.Add("per_req", strconv.FormatInt(request.PerReq, 10))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	var result pb.ListUsersResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) CreateTracking(user *User, request *CreateTrackingRequest) (*pb.CreateTrackingResponse, error) {
+	buf, err := json.Marshal(createTrackingRequestSerialized{
+		Date:     request.Date.Format(lib.DateFormat),
+		Time:     request.Time,
+		Distance: request.Distance,
+		Location: request.Location,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/tracking", c.url),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("wrong status code: %d, details: %s", resp.StatusCode, string(body))
+	}
+
+	var result pb.CreateTrackingResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) ImportTrackings(user *User, filename string, file []byte) (*pb.ImportTrackingsResponse, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(file); err != nil {
+		return nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/trackings/import", c.url),
+		&body,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+	req.Header.Add("Content-Type", form.FormDataContentType())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+	var result pb.ImportTrackingsResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) GetTracking(user *User, request *pb.GetTrackingRequest) (*pb.GetTrackingResponse, error) {
+	req, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/api/v1/tracking/%s", c.url, request.Id),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	if request.IncludeRoute {
+		q.Add("include_route", "true")
+	}
+	if request.RouteTolerance != 0 {
+		q.Add("route_tolerance", strconv.FormatFloat(request.RouteTolerance, 'f', -1, 64))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+	var result pb.GetTrackingResponse
+
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *client) UpdateTracking(user *User, request *UpdateTrackingRequest) (*empty.Empty, error) {
+	serialized := updateTrackingRequestSerialized{
+		Location: request.Location,
+		Distance: request.Distance,
+	}
+	if request.Date != nil {
+		serialized.Date = request.Date.Format(lib.DateFormat)
+	}
+	buf, err := json.Marshal(serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(
+		"PATCH",
+		fmt.Sprintf("%s/api/v1/tracking/%s", c.url, request.ID),
 		bytes.NewBuffer(buf),
 	)
 	if err != nil {
@@ -126,21 +994,37 @@ func (c *client) AddPermission(user *User, request *pb.AddPermissionRequest) (*e
 		return nil, err
 	}
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("wrong status code: %d, details: %s", resp.StatusCode, string(body))
 	}
 
 	return &empty.Empty{}, nil
 }
 
-func (c *client) AddRole(user *User, request *pb.AddRoleRequest) (*empty.Empty, error) {
+func (c *client) ListOwnTrackings(user *User, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error) {
 	req, err := http.NewRequest(
-		"PUT",
-		fmt.Sprintf("%s/api/v1/user/%s/roles/%s", c.url, request.UserId, request.Role),
+		"GET",
+		fmt.Sprintf("%s/api/v1/trackings", c.url),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
+	q := req.URL.Query()
+	if request.Cursor != "" {
+		q.Add("cursor", request.Cursor)
+	}
+	if request.Query != "" {
+		q.Add("query", request.Query)
+	}
+	if request.PerReq != 0 {
+		q.Add("per_req", strconv.FormatInt(request.PerReq, 10))
+	}
+	if request.TeamId != "" {
+		q.Add("team_id", request.TeamId)
+	}
+	req.URL.RawQuery = q.Encode()
+
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -150,19 +1034,36 @@ func (c *client) AddRole(user *User, request *pb.AddRoleRequest) (*empty.Empty,
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
+	var result pb.ListTrackingsResponse
 
-	return &empty.Empty{}, nil
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
-func (c *client) RemoveRole(user *User, request *pb.RemoveRoleRequest) (*empty.Empty, error) {
+func (c *client) ListTrackings(user *User, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error) {
 	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf("%s/api/v1/user/%s/roles/%s", c.url, request.UserId, request.Role),
+		"GET",
+		fmt.Sprintf("%s/api/v1/trackings/all", c.url),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
+	q := req.URL.Query()
+	if request.Cursor != "" {
+		q.Add("cursor", request.Cursor)
+	}
+	if request.Query != "" {
+		q.Add("query", request.Query)
+	}
+	if request.PerReq != 0 {
+		q.Add("per_req", strconv.FormatInt(request.PerReq, 10))
+	}
+	req.URL.RawQuery = q.Encode()
+
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -172,43 +1073,58 @@ func (c *client) RemoveRole(user *User, request *pb.RemoveRoleRequest) (*empty.E
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
+	var result pb.ListTrackingsResponse
 
-	return &empty.Empty{}, nil
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
-func (c *client) GetUser(user *User, _ *empty.Empty) (*pb.GetUserResponse, error) {
+func (c *client) ExportTrackings(user *User, request *pb.ExportTrackingsRequest) ([]byte, error) {
 	req, err := http.NewRequest(
 		"GET",
-		fmt.Sprintf("%s/api/v1/user", c.url),
+		fmt.Sprintf("%s/api/v1/trackings/export", c.url),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
+	q := req.URL.Query()
+	q.Add("format", request.Format.String())
+	if request.Query != "" {
+		q.Add("query", request.Query)
+	}
+	if request.AllUsers {
+		q.Add("all_users", "true")
+	}
+	req.URL.RawQuery = q.Encode()
+
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
 
-	var result pb.GetUserResponse
+	return ioutil.ReadAll(resp.Body)
+}
 
-	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+func (c *client) BackfillWeather(user *User, request *pb.BackfillWeatherRequest) ([]*pb.BackfillWeatherResponse, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
 		return nil, err
 	}
 
-	return &result, nil
-}
-
-func (c *client) GetUserByID(user *User, request *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf("%s/api/v1/user/%s", c.url, request.Id),
-		nil,
+		"POST",
+		fmt.Sprintf("%s/api/v1/trackings/weather/backfill", c.url),
+		bytes.NewBuffer(buf),
 	)
 	if err != nil {
 		return nil, err
@@ -219,28 +1135,59 @@ func (c *client) GetUserByID(user *User, request *pb.GetUserRequest) (*pb.GetUse
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
 
-	var result pb.GetUserResponse
-
-	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	// gateway streams every message as a separate json object
+	var result []*pb.BackfillWeatherResponse
+	decoder := json.NewDecoder(resp.Body)
+	for decoder.More() {
+		var message struct {
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		}
+		if err := decoder.Decode(&message); err != nil {
+			return nil, err
+		}
+		if message.Error != nil {
+			return nil, fmt.Errorf("stream error: %s", message.Error)
+		}
+		var response pb.BackfillWeatherResponse
+		if err := jsonpb.Unmarshal(bytes.NewReader(message.Result), &response); err != nil {
+			return nil, err
+		}
+		result = append(result, &response)
+	}
+
+	return result, nil
 }
 
-func (c *client) DeleteUser(user *User, _ *empty.Empty) (*empty.Empty, error) {
+func (c *client) Report(user *User, request *ReportRequest) (*pb.ReportResponse, error) {
 	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf("%s/api/v1/user", c.url),
+		"GET",
+		fmt.Sprintf("%s/api/v1/trackings/report", c.url),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
+	q := req.URL.Query()
+	if request.Duration != "" {
+		q.Add("duration", request.Duration)
+	}
+	if !request.FromDate.IsZero() {
+		q.Add("from_date", request.FromDate.Format(lib.DateFormat))
+	}
+	if request.Granularity != "" {
+		q.Add("granularity", request.Granularity)
+	}
+	if request.UserID != "" {
+		q.Add("user_id", request.UserID)
+	}
+	req.URL.RawQuery = q.Encode()
+
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -250,19 +1197,32 @@ func (c *client) DeleteUser(user *User, _ *empty.Empty) (*empty.Empty, error) {
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
+	var result pb.ReportResponse
 
-	return &empty.Empty{}, nil
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
-func (c *client) DeleteUserByID(user *User, request *pb.DeleteUserRequest) (*empty.Empty, error) {
+func (c *client) GetPersonalRecords(
+	user *User,
+	request *pb.GetPersonalRecordsRequest,
+) (*pb.GetPersonalRecordsResponse, error) {
 	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf("%s/api/v1/user/%s", c.url, request.Id),
+		"GET",
+		fmt.Sprintf("%s/api/v1/trackings/records", c.url),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if request.UserId != "" {
+		q := req.URL.Query()
+		q.Add("user_id", request.UserId)
+		req.URL.RawQuery = q.Encode()
+	}
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -272,14 +1232,22 @@ func (c *client) DeleteUserByID(user *User, request *pb.DeleteUserRequest) (*emp
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
+	var result pb.GetPersonalRecordsResponse
 
-	return &empty.Empty{}, nil
+	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
-func (c *client) ListUsers(user *User, request *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+func (c *client) ListAuditEvents(
+	user *User,
+	request *pb.ListAuditEventsRequest,
+) (*pb.ListAuditEventsResponse, error) {
 	req, err := http.NewRequest(
 		"GET",
-		fmt.Sprintf("%s/api/v1/users", c.url),
+		fmt.Sprintf("%s/api/v1/audit/events", c.url),
 		nil,
 	)
 	if err != nil {
@@ -289,12 +1257,15 @@ func (c *client) ListUsers(user *User, request *pb.ListUsersRequest) (*pb.ListUs
 	if request.Cursor != "" {
 		q.Add("cursor", request.Cursor)
 	}
-	if request.Query != "" {
-		q.Add("query", request.Query)
-	}
 	if request.PerReq != 0 {
 		q.Add("per_req", strconv.FormatInt(request.PerReq, 10))
 	}
+	if request.ActorId != "" {
+		q.Add("actor_id", request.ActorId)
+	}
+	if request.TargetId != "" {
+		q.Add("target_id", request.TargetId)
+	}
 	req.URL.RawQuery = q.Encode()
 
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
@@ -307,7 +1278,7 @@ func (c *client) ListUsers(user *User, request *pb.ListUsersRequest) (*pb.ListUs
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
 
-	var result pb.ListUsersResponse
+	var result pb.ListAuditEventsResponse
 
 	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
 		return nil, err
@@ -316,20 +1287,15 @@ func (c *client) ListUsers(user *User, request *pb.ListUsersRequest) (*pb.ListUs
 	return &result, nil
 }
 
-func (c *client) CreateTracking(user *User, request *CreateTrackingRequest) (*pb.CreateTrackingResponse, error) {
-	buf, err := json.Marshal(createTrackingRequestSerialized{
-		Date:     request.Date.Format(lib.DateFormat),
-		Time:     request.Time,
-		Distance: request.Distance,
-		Location: request.Location,
-	})
+func (c *client) CreateTeam(user *User, request *pb.CreateTeamRequest) (*pb.CreateTeamResponse, error) {
+	buf, err := json.Marshal(request)
 	if err != nil {
 		return nil, err
 	}
 
 	req, err := http.NewRequest(
 		"POST",
-		fmt.Sprintf("%s/api/v1/tracking", c.url),
+		fmt.Sprintf("%s/api/v1/teams", c.url),
 		bytes.NewBuffer(buf),
 	)
 	if err != nil {
@@ -342,11 +1308,10 @@ func (c *client) CreateTracking(user *User, request *CreateTrackingRequest) (*pb
 		return nil, err
 	}
 	if resp.StatusCode != 200 {
-		body, _ := ioutil.ReadAll(resp.Body)
-		return nil, fmt.Errorf("wrong status code: %d, details: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
 
-	var result pb.CreateTrackingResponse
+	var result pb.CreateTeamResponse
 
 	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
 		return nil, err
@@ -355,10 +1320,10 @@ func (c *client) CreateTracking(user *User, request *CreateTrackingRequest) (*pb
 	return &result, nil
 }
 
-func (c *client) GetTracking(user *User, request *pb.GetTrackingRequest) (*pb.GetTrackingResponse, error) {
+func (c *client) GetTeam(user *User, request *pb.GetTeamRequest) (*pb.GetTeamResponse, error) {
 	req, err := http.NewRequest(
 		"GET",
-		fmt.Sprintf("%s/api/v1/tracking/%s", c.url, request.Id),
+		fmt.Sprintf("%s/api/v1/teams/%s", c.url, request.Id),
 		nil,
 	)
 	if err != nil {
@@ -373,7 +1338,8 @@ func (c *client) GetTracking(user *User, request *pb.GetTrackingRequest) (*pb.Ge
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
-	var result pb.GetTrackingResponse
+
+	var result pb.GetTeamResponse
 
 	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
 		return nil, err
@@ -382,27 +1348,15 @@ func (c *client) GetTracking(user *User, request *pb.GetTrackingRequest) (*pb.Ge
 	return &result, nil
 }
 
-func (c *client) ListOwnTrackings(user *User, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error) {
+func (c *client) ListTeams(user *User, _ *empty.Empty) (*pb.ListTeamsResponse, error) {
 	req, err := http.NewRequest(
 		"GET",
-		fmt.Sprintf("%s/api/v1/trackings", c.url),
+		fmt.Sprintf("%s/api/v1/teams", c.url),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
-	q := req.URL.Query()
-	if request.Cursor != "" {
-		q.Add("cursor", request.Cursor)
-	}
-	if request.Query != "" {
-		q.Add("query", request.Query)
-	}
-	if request.PerReq != 0 {
-		q.Add("per_req", strconv.FormatInt(request.PerReq, 10))
-	}
-	req.URL.RawQuery = q.Encode()
-
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -412,7 +1366,8 @@ func (c *client) ListOwnTrackings(user *User, request *pb.ListTrackingsRequest)
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
-	var result pb.ListTrackingsResponse
+
+	var result pb.ListTeamsResponse
 
 	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
 		return nil, err
@@ -421,27 +1376,42 @@ func (c *client) ListOwnTrackings(user *User, request *pb.ListTrackingsRequest)
 	return &result, nil
 }
 
-func (c *client) ListTrackings(user *User, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error) {
+func (c *client) DeleteTeam(user *User, request *pb.DeleteTeamRequest) (*empty.Empty, error) {
 	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf("%s/api/v1/trackings/all", c.url),
+		"DELETE",
+		fmt.Sprintf("%s/api/v1/teams/%s", c.url, request.Id),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
-	q := req.URL.Query()
-	if request.Cursor != "" {
-		q.Add("cursor", request.Cursor)
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
 	}
-	if request.Query != "" {
-		q.Add("query", request.Query)
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
-	if request.PerReq != 0 {
-		q.Add("per_req", strconv.FormatInt(request.PerReq, 10))
+
+	return &empty.Empty{}, nil
+}
+
+func (c *client) InviteToTeam(user *User, request *pb.InviteToTeamRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
 	}
-	req.URL.RawQuery = q.Encode()
 
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/teams/%s/invitations", c.url, request.TeamId),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -451,33 +1421,24 @@ func (c *client) ListTrackings(user *User, request *pb.ListTrackingsRequest) (*p
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
-	var result pb.ListTrackingsResponse
 
-	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+	return &empty.Empty{}, nil
+}
+
+func (c *client) AcceptTeamInvitation(user *User, request *pb.AcceptTeamInvitationRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
 		return nil, err
 	}
 
-	return &result, nil
-}
-
-func (c *client) Report(user *User, request *ReportRequest) (*pb.ReportResponse, error) {
 	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf("%s/api/v1/trackings/report", c.url),
-		nil,
+		"POST",
+		fmt.Sprintf("%s/api/v1/teams/%s/accept", c.url, request.TeamId),
+		bytes.NewBuffer(buf),
 	)
 	if err != nil {
 		return nil, err
 	}
-	q := req.URL.Query()
-	if request.Duration != "" {
-		q.Add("duration", request.Duration)
-	}
-	if !request.FromDate.IsZero() {
-		q.Add("from_date", request.FromDate.Format(lib.DateFormat))
-	}
-	req.URL.RawQuery = q.Encode()
-
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
 
 	resp, err := c.client.Do(req)
@@ -487,13 +1448,35 @@ func (c *client) Report(user *User, request *ReportRequest) (*pb.ReportResponse,
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
 	}
-	var result pb.ReportResponse
 
-	if err := jsonpb.Unmarshal(resp.Body, &result); err != nil {
+	return &empty.Empty{}, nil
+}
+
+func (c *client) LeaveTeam(user *User, request *pb.LeaveTeamRequest) (*empty.Empty, error) {
+	buf, err := json.Marshal(request)
+	if err != nil {
 		return nil, err
 	}
 
-	return &result, nil
+	req, err := http.NewRequest(
+		"POST",
+		fmt.Sprintf("%s/api/v1/teams/%s/leave", c.url, request.TeamId),
+		bytes.NewBuffer(buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", user.AccessToken))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("wrong status code: %d", resp.StatusCode)
+	}
+
+	return &empty.Empty{}, nil
 }
 
 func (c *client) CreateRandomTracking(user *User) (*Tracking, error) {
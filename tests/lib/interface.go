@@ -9,12 +9,36 @@ type HttpClient interface {
 	// auth
 	SignUp(request *pb.SignUpRequest) (*pb.SignUpResponse, error)
 	SignIn(request *pb.SignInRequest) (*pb.SignInResponse, error)
+	RefreshToken(request *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error)
+	Logout(user *User, request *pb.LogoutRequest) (*empty.Empty, error)
+	LogoutAllSessions(user *User, _ *empty.Empty) (*empty.Empty, error)
+	GetJWKS(_ *empty.Empty) (*pb.GetJWKSResponse, error)
+	RequestPasswordReset(request *pb.RequestPasswordResetRequest) (*empty.Empty, error)
+	ResetPassword(request *pb.ResetPasswordRequest) (*empty.Empty, error)
+	VerifyEmail(request *pb.VerifyEmailRequest) (*empty.Empty, error)
+	ResendVerification(user *User, _ *empty.Empty) (*empty.Empty, error)
+	UnlockSignIn(user *User, request *pb.UnlockSignInRequest) (*empty.Empty, error)
+
+	// account
+	ChangePassword(user *User, request *pb.ChangePasswordRequest) (*empty.Empty, error)
+	ChangeEmail(user *User, request *pb.ChangeEmailRequest) (*empty.Empty, error)
+	ChangeUserPassword(user *User, request *pb.ChangeUserPasswordRequest) (*empty.Empty, error)
+	ChangeUserEmail(user *User, request *pb.ChangeUserEmailRequest) (*empty.Empty, error)
 
 	// managing
 	AddPermission(user *User, request *pb.AddPermissionRequest) (*empty.Empty, error)
+	RemovePermission(user *User, request *pb.RemovePermissionRequest) (*empty.Empty, error)
+	ListEffectivePermissions(user *User, request *pb.ListEffectivePermissionsRequest) (*pb.ListEffectivePermissionsResponse, error)
 	AddRole(user *User, request *pb.AddRoleRequest) (*empty.Empty, error)
 	RemoveRole(user *User, request *pb.RemoveRoleRequest) (*empty.Empty, error)
 
+	// roles
+	CreateRole(user *User, request *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error)
+	UpdateRole(user *User, request *pb.UpdateRoleRequest) (*empty.Empty, error)
+	DeleteRole(user *User, request *pb.DeleteRoleRequest) (*empty.Empty, error)
+	GetRole(user *User, request *pb.GetRoleRequest) (*pb.GetRoleResponse, error)
+	ListRoles(user *User, _ *empty.Empty) (*pb.ListRolesResponse, error)
+
 	// users
 	GetUser(user *User, _ *empty.Empty) (*pb.GetUserResponse, error)
 	GetUserByID(user *User, request *pb.GetUserRequest) (*pb.GetUserResponse, error)
@@ -24,10 +48,27 @@ type HttpClient interface {
 
 	// trackings
 	CreateTracking(user *User, request *CreateTrackingRequest) (*pb.CreateTrackingResponse, error)
+	ImportTrackings(user *User, filename string, file []byte) (*pb.ImportTrackingsResponse, error)
 	GetTracking(user *User, request *pb.GetTrackingRequest) (*pb.GetTrackingResponse, error)
+	UpdateTracking(user *User, request *UpdateTrackingRequest) (*empty.Empty, error)
 	ListOwnTrackings(user *User, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error)
 	ListTrackings(user *User, request *pb.ListTrackingsRequest) (*pb.ListTrackingsResponse, error)
+	ExportTrackings(user *User, request *pb.ExportTrackingsRequest) ([]byte, error)
+	BackfillWeather(user *User, request *pb.BackfillWeatherRequest) ([]*pb.BackfillWeatherResponse, error)
 	Report(user *User, request *ReportRequest) (*pb.ReportResponse, error)
+	GetPersonalRecords(user *User, request *pb.GetPersonalRecordsRequest) (*pb.GetPersonalRecordsResponse, error)
+
+	// audit
+	ListAuditEvents(user *User, request *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error)
+
+	// teams
+	CreateTeam(user *User, request *pb.CreateTeamRequest) (*pb.CreateTeamResponse, error)
+	GetTeam(user *User, request *pb.GetTeamRequest) (*pb.GetTeamResponse, error)
+	ListTeams(user *User, _ *empty.Empty) (*pb.ListTeamsResponse, error)
+	DeleteTeam(user *User, request *pb.DeleteTeamRequest) (*empty.Empty, error)
+	InviteToTeam(user *User, request *pb.InviteToTeamRequest) (*empty.Empty, error)
+	AcceptTeamInvitation(user *User, request *pb.AcceptTeamInvitationRequest) (*empty.Empty, error)
+	LeaveTeam(user *User, request *pb.LeaveTeamRequest) (*empty.Empty, error)
 
 	// util methods
 	CreateRandomTracking(user *User) (*Tracking, error)
@@ -30,6 +30,19 @@ type createTrackingRequestSerialized struct {
 	Distance float32  `json:"distance" bson:"distance"`
 }
 
+// UpdateTrackingRequest sends only non-nil fields, so only they are updated.
+type UpdateTrackingRequest struct {
+	ID       string
+	Location *Location
+	Date     *time.Time
+	Distance *float32
+}
+type updateTrackingRequestSerialized struct {
+	Location *Location `json:"location,omitempty"`
+	Date     string    `json:"date,omitempty"`
+	Distance *float32  `json:"distance,omitempty"`
+}
+
 type Tracking struct {
 	ID       string    `json:"id" bson:"_id"`
 	UserID   string    `json:"user_id" bson:"user_id"`
@@ -40,6 +53,8 @@ type Tracking struct {
 }
 
 type ReportRequest struct {
-	FromDate time.Time `json:"from_date" bson:"from_date"`
-	Duration string    `json:"duration" bson:"duration"`
+	FromDate    time.Time `json:"from_date" bson:"from_date"`
+	Duration    string    `json:"duration" bson:"duration"`
+	Granularity string    `json:"granularity" bson:"granularity"`
+	UserID      string    `json:"user_id" bson:"user_id"`
 }